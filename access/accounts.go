@@ -0,0 +1,53 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// AccountAtBlockIDClient is implemented by the clients able to get accounts at the block with a given ID,
+// such as the HTTP and gRPC clients.
+type AccountAtBlockIDClient interface {
+	GetAccountAtBlockID(ctx context.Context, address flow.Address, blockID flow.Identifier) (*flow.Account, error)
+}
+
+// GetAccountAtBlockID gets an account by address at the block with the given ID.
+//
+// The account is fetched with GetAccountAtBlockID if the client implements AccountAtBlockIDClient,
+// and at the height of the block otherwise.
+func GetAccountAtBlockID(
+	ctx context.Context,
+	client Client,
+	address flow.Address,
+	blockID flow.Identifier,
+) (*flow.Account, error) {
+	if accountClient, ok := client.(AccountAtBlockIDClient); ok {
+		return accountClient.GetAccountAtBlockID(ctx, address, blockID)
+	}
+
+	header, err := client.GetBlockHeaderByID(ctx, blockID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block header %s: %w", blockID, err)
+	}
+	return client.GetAccountAtBlockHeight(ctx, address, header.Height)
+}
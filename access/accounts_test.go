@@ -0,0 +1,81 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// heightAccountClient is a client stub serving the accounts by block height only.
+type heightAccountClient struct {
+	Client
+	header *flow.BlockHeader
+	height uint64
+}
+
+func (c *heightAccountClient) GetBlockHeaderByID(context.Context, flow.Identifier) (*flow.BlockHeader, error) {
+	return c.header, nil
+}
+
+func (c *heightAccountClient) GetAccountAtBlockHeight(_ context.Context, address flow.Address, height uint64) (*flow.Account, error) {
+	c.height = height
+	return &flow.Account{Address: address}, nil
+}
+
+// blockIDAccountClient is a client stub serving the accounts by block ID.
+type blockIDAccountClient struct {
+	heightAccountClient
+	blockID flow.Identifier
+}
+
+func (c *blockIDAccountClient) GetAccountAtBlockID(_ context.Context, address flow.Address, blockID flow.Identifier) (*flow.Account, error) {
+	c.blockID = blockID
+	return &flow.Account{Address: address}, nil
+}
+
+func TestGetAccountAtBlockID(t *testing.T) {
+	ctx := context.Background()
+	address := test.AddressGenerator().New()
+	header := test.BlockHeaderGenerator().New()
+
+	t.Run("Block ID client", func(t *testing.T) {
+		client := &blockIDAccountClient{}
+
+		account, err := GetAccountAtBlockID(ctx, client, address, header.ID)
+		require.NoError(t, err)
+		assert.Equal(t, address, account.Address)
+		assert.Equal(t, header.ID, client.blockID)
+	})
+
+	t.Run("Block height fallback", func(t *testing.T) {
+		client := &heightAccountClient{header: &header}
+
+		account, err := GetAccountAtBlockID(ctx, client, address, header.ID)
+		require.NoError(t, err)
+		assert.Equal(t, address, account.Address)
+		assert.Equal(t, header.Height, client.height)
+	})
+}
@@ -0,0 +1,115 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// EventBackfiller fetches the events of a type over a height range of any size, delivering them
+// in strict height order.
+//
+// The range is split into chunks the access node accepts, which are fetched by a bounded pool of
+// workers ahead of the consumer and optionally rate limited to stay within the access node limits.
+// The chunks are fetched like GetEventsForHeightRangeChunked does, but the events are delivered as
+// the chunks complete instead of being merged in memory.
+type EventBackfiller struct {
+	Client Client
+	// ChunkSize is the number of blocks requested at once, it defaults to EventHeightRangeLimit.
+	ChunkSize uint64
+	// Workers is the maximum number of chunks requested in parallel, it defaults to 1.
+	Workers int
+	// RequestsPerSecond limits the rate of the requests, there is no limit if it is zero.
+	RequestsPerSecond float64
+}
+
+// Backfill fetches the events with the given type for the blocks between the start and end heights
+// (inclusive), calling handle with the events of every block in order of height.
+//
+// The backfill stops on the first failed request or when handle returns an error, which is returned.
+func (b EventBackfiller) Backfill(
+	ctx context.Context,
+	eventType string,
+	startHeight uint64,
+	endHeight uint64,
+	handle func(events flow.BlockEvents) error,
+) error {
+	ranges, err := chunkHeightRange(startHeight, endHeight, b.ChunkSize)
+	if err != nil {
+		return err
+	}
+
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the chunk results are queued in height order as the requests start,
+	// the queue capacity bounds the number of chunks fetched ahead of the consumer
+	pending := make(chan chan eventChunk, maxInFlight(b.Workers)-1)
+	go fetchEventChunks(fetchCtx, b.Client, eventType, ranges, b.RequestsPerSecond, pending)
+
+	for result := range pending {
+		chunk := <-result
+		if chunk.err != nil {
+			return chunk.err
+		}
+
+		for _, events := range chunk.events {
+			if err := handle(events); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ctx.Err()
+}
+
+// Stream fetches the events like Backfill and delivers them on the returned channel.
+//
+// The backfill error, if any, is delivered on the error channel. Both channels are closed when the
+// backfill ends, the next chunks are not fetched while the consumer doesn't receive the events.
+func (b EventBackfiller) Stream(
+	ctx context.Context,
+	eventType string,
+	startHeight uint64,
+	endHeight uint64,
+) (<-chan flow.BlockEvents, <-chan error) {
+	eventsChan := make(chan flow.BlockEvents)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(errChan)
+		defer close(eventsChan)
+
+		err := b.Backfill(ctx, eventType, startHeight, endHeight, func(events flow.BlockEvents) error {
+			select {
+			case eventsChan <- events:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errChan <- err
+		}
+	}()
+
+	return eventsChan, errChan
+}
@@ -0,0 +1,151 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// reversedEventsClient is an events client stub answering the requests for lower heights slower,
+// so the chunks complete in reverse height order.
+type reversedEventsClient struct {
+	eventsClient
+	endHeight uint64
+}
+
+func (c *reversedEventsClient) GetEventsForHeightRange(
+	ctx context.Context,
+	eventType string,
+	startHeight uint64,
+	endHeight uint64,
+) ([]flow.BlockEvents, error) {
+	time.Sleep(time.Duration(c.endHeight-startHeight) * 10 * time.Microsecond)
+	return c.eventsClient.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+}
+
+func TestEventBackfiller_Backfill(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Height order", func(t *testing.T) {
+		client := &reversedEventsClient{endHeight: 1000}
+		backfiller := EventBackfiller{Client: client, ChunkSize: 10, Workers: 8}
+
+		var heights []uint64
+		err := backfiller.Backfill(ctx, "A.Foo.Bar", 1, 1000, func(events flow.BlockEvents) error {
+			heights = append(heights, events.Height)
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.Len(t, heights, 1000)
+		for i, height := range heights {
+			assert.Equal(t, uint64(i+1), height)
+		}
+		assert.Len(t, client.requests, 100)
+	})
+
+	t.Run("Request error", func(t *testing.T) {
+		client := &eventsClient{failAt: 55}
+		backfiller := EventBackfiller{Client: client, ChunkSize: 10, Workers: 4}
+
+		var last uint64
+		err := backfiller.Backfill(ctx, "A.Foo.Bar", 1, 100, func(events flow.BlockEvents) error {
+			last = events.Height
+			return nil
+		})
+		assert.EqualError(t, err, "get events for height range 51-60 failed: failed")
+		assert.Equal(t, uint64(50), last)
+	})
+
+	t.Run("Handler error", func(t *testing.T) {
+		client := &eventsClient{}
+		backfiller := EventBackfiller{Client: client, ChunkSize: 10}
+
+		handlerErr := errors.New("handler failed")
+		err := backfiller.Backfill(ctx, "A.Foo.Bar", 1, 100, func(events flow.BlockEvents) error {
+			if events.Height == 15 {
+				return handlerErr
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, handlerErr)
+
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		assert.Less(t, len(client.requests), 10)
+	})
+
+	t.Run("Rate limit", func(t *testing.T) {
+		client := &eventsClient{}
+		backfiller := EventBackfiller{Client: client, ChunkSize: 10, Workers: 4, RequestsPerSecond: 200}
+
+		start := time.Now()
+		err := backfiller.Backfill(ctx, "A.Foo.Bar", 1, 50, func(flow.BlockEvents) error { return nil })
+		require.NoError(t, err)
+
+		// the first request is immediate and the 4 following ones are spaced by 5ms
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		backfiller := EventBackfiller{Client: &eventsClient{}}
+
+		err := backfiller.Backfill(ctx, "A.Foo.Bar", 10, 1, func(flow.BlockEvents) error { return nil })
+		assert.Error(t, err)
+	})
+}
+
+func TestEventBackfiller_Stream(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		backfiller := EventBackfiller{Client: &eventsClient{}, ChunkSize: 7, Workers: 3}
+
+		events, errs := backfiller.Stream(ctx, "A.Foo.Bar", 1, 30)
+
+		height := uint64(1)
+		for e := range events {
+			assert.Equal(t, height, e.Height)
+			height++
+		}
+		assert.Equal(t, uint64(31), height)
+		assert.NoError(t, <-errs)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		backfiller := EventBackfiller{Client: &eventsClient{failAt: 3}, ChunkSize: 2}
+
+		events, errs := backfiller.Stream(ctx, "A.Foo.Bar", 1, 10)
+
+		var received int
+		for range events {
+			received++
+		}
+		assert.Equal(t, 2, received)
+		assert.Error(t, <-errs)
+	})
+}
@@ -0,0 +1,190 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// DefaultBatchConcurrency is the number of requests in flight used by the batch helpers
+// when no concurrency is specified.
+const DefaultBatchConcurrency = 8
+
+// BatchError is returned by the batch helpers when requests for some of the items failed.
+//
+// The results of the successful items are still returned, the results of the failed items are nil.
+type BatchError struct {
+	// Errors holds the error of each failed item, indexed by the position of the item in the input.
+	Errors map[int]error
+}
+
+func (e *BatchError) Error() string {
+	first := -1
+	for i := range e.Errors {
+		if first == -1 || i < first {
+			first = i
+		}
+	}
+
+	return fmt.Sprintf("%d batch requests failed, first failed item at index %d: %s", len(e.Errors), first, e.Errors[first])
+}
+
+// Unwrap returns the errors of the failed items in input order, so errors.Is and errors.As
+// match the error of any failed item.
+func (e *BatchError) Unwrap() []error {
+	indices := make([]int, 0, len(e.Errors))
+	for i := range e.Errors {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+
+	errs := make([]error, 0, len(indices))
+	for _, i := range indices {
+		errs = append(errs, e.Errors[i])
+	}
+	return errs
+}
+
+// batch calls the request function for each of the n items with up to concurrency requests in flight.
+func batch(ctx context.Context, n int, concurrency int, request func(i int) error) error {
+	if concurrency < 1 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := make(map[int]error)
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := request(i); err != nil {
+				mu.Lock()
+				errs[i] = err
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &BatchError{Errors: errs}
+	}
+
+	return nil
+}
+
+// GetTransactionResults gets the results of the transactions with the given IDs, with up to
+// concurrency requests in flight. Results are returned in the order of the provided IDs.
+//
+// If any of the requests fails, a BatchError holding the error of each failed transaction is returned
+// along with the results of the other transactions.
+func GetTransactionResults(
+	ctx context.Context,
+	client Client,
+	txIDs []flow.Identifier,
+	concurrency int,
+) ([]*flow.TransactionResult, error) {
+	results := make([]*flow.TransactionResult, len(txIDs))
+	err := batch(ctx, len(txIDs), concurrency, func(i int) (err error) {
+		results[i], err = client.GetTransactionResult(ctx, txIDs[i])
+		return err
+	})
+
+	return results, err
+}
+
+// GetTransactions gets the transactions with the given IDs, with up to concurrency requests in flight.
+// Transactions are returned in the order of the provided IDs.
+//
+// If any of the requests fails, a BatchError holding the error of each failed transaction is returned
+// along with the other transactions.
+func GetTransactions(
+	ctx context.Context,
+	client Client,
+	txIDs []flow.Identifier,
+	concurrency int,
+) ([]*flow.Transaction, error) {
+	txs := make([]*flow.Transaction, len(txIDs))
+	err := batch(ctx, len(txIDs), concurrency, func(i int) (err error) {
+		txs[i], err = client.GetTransaction(ctx, txIDs[i])
+		return err
+	})
+
+	return txs, err
+}
+
+// GetCollections gets the collections with the given IDs, with up to concurrency requests in flight.
+// Collections are returned in the order of the provided IDs.
+//
+// If any of the requests fails, a BatchError holding the error of each failed collection is returned
+// along with the other collections.
+func GetCollections(
+	ctx context.Context,
+	client Client,
+	colIDs []flow.Identifier,
+	concurrency int,
+) ([]*flow.Collection, error) {
+	collections := make([]*flow.Collection, len(colIDs))
+	err := batch(ctx, len(colIDs), concurrency, func(i int) (err error) {
+		collections[i], err = client.GetCollection(ctx, colIDs[i])
+		return err
+	})
+
+	return collections, err
+}
+
+// GetAccounts gets the accounts with the given addresses at the latest sealed block, with up to
+// concurrency requests in flight. Accounts are returned in the order of the provided addresses.
+//
+// If any of the requests fails, a BatchError holding the error of each failed account is returned
+// along with the other accounts.
+func GetAccounts(
+	ctx context.Context,
+	client Client,
+	addresses []flow.Address,
+	concurrency int,
+) ([]*flow.Account, error) {
+	accounts := make([]*flow.Account, len(addresses))
+	err := batch(ctx, len(addresses), concurrency, func(i int) (err error) {
+		accounts[i], err = client.GetAccountAtLatestBlock(ctx, addresses[i])
+		return err
+	})
+
+	return accounts, err
+}
@@ -0,0 +1,97 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// accountsClient is a client stub returning accounts, failing for the configured address.
+type accountsClient struct {
+	Client
+	inFlight    int32
+	maxInFlight int32
+	failFor     flow.Address
+}
+
+func (c *accountsClient) GetAccountAtLatestBlock(_ context.Context, address flow.Address) (*flow.Account, error) {
+	n := atomic.AddInt32(&c.inFlight, 1)
+	defer atomic.AddInt32(&c.inFlight, -1)
+	for {
+		max := atomic.LoadInt32(&c.maxInFlight)
+		if n <= max || atomic.CompareAndSwapInt32(&c.maxInFlight, max, n) {
+			break
+		}
+	}
+
+	if address == c.failFor {
+		return nil, errAccountNotFound
+	}
+	return &flow.Account{Address: address}, nil
+}
+
+var errAccountNotFound = errors.New("account not found")
+
+func TestGetAccounts(t *testing.T) {
+	ctx := context.Background()
+	addresses := test.AddressGenerator()
+
+	addrs := make([]flow.Address, 20)
+	for i := range addrs {
+		addrs[i] = addresses.New()
+	}
+
+	t.Run("Results In Input Order", func(t *testing.T) {
+		client := &accountsClient{}
+
+		accounts, err := GetAccounts(ctx, client, addrs, 3)
+		require.NoError(t, err)
+		require.Len(t, accounts, len(addrs))
+
+		for i, account := range accounts {
+			assert.Equal(t, addrs[i], account.Address)
+		}
+		assert.LessOrEqual(t, atomic.LoadInt32(&client.maxInFlight), int32(3))
+	})
+
+	t.Run("Aggregates Errors", func(t *testing.T) {
+		client := &accountsClient{failFor: addrs[5]}
+
+		accounts, err := GetAccounts(ctx, client, addrs, 0)
+
+		var batchErr *BatchError
+		require.True(t, errors.As(err, &batchErr))
+		assert.Len(t, batchErr.Errors, 1)
+		assert.EqualError(t, batchErr.Errors[5], "account not found")
+		assert.EqualError(t, err, "1 batch requests failed, first failed item at index 5: account not found")
+		assert.ErrorIs(t, err, errAccountNotFound)
+
+		assert.Nil(t, accounts[5])
+		assert.Equal(t, addrs[6], accounts[6].Address)
+	})
+}
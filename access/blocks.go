@@ -0,0 +1,183 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// DefaultBlockPrefetch is the default number of blocks fetched ahead by a block iterator.
+const DefaultBlockPrefetch = 10
+
+// BlockIteratorOption configures a block iterator.
+type BlockIteratorOption func(c *blockIteratorConfig)
+
+type blockIteratorConfig struct {
+	prefetch        int
+	tipPollInterval time.Duration
+}
+
+// WithPrefetch sets the number of blocks fetched ahead of the consumer, concurrently.
+func WithPrefetch(blocks int) BlockIteratorOption {
+	return func(c *blockIteratorConfig) {
+		c.prefetch = blocks
+	}
+}
+
+// WithTipPollInterval sets the interval between the requests for the latest sealed block
+// once the iterator reached the tip of the chain, 1 second by default.
+func WithTipPollInterval(interval time.Duration) BlockIteratorOption {
+	return func(c *blockIteratorConfig) {
+		c.tipPollInterval = interval
+	}
+}
+
+type blockResult struct {
+	block *flow.Block
+	err   error
+}
+
+// BlockIterator iterates over the sealed blocks in order of height, see BlocksFrom.
+type BlockIterator struct {
+	client  Client
+	config  blockIteratorConfig
+	cancel  context.CancelFunc
+	pending chan chan blockResult
+	current chan blockResult
+	err     error
+}
+
+// BlocksFrom returns an iterator over the sealed blocks starting at the given height.
+//
+// The iterator fetches the next blocks in the background, up to the prefetch limit ahead of the
+// consumer. Once the iterator reaches the latest sealed block it waits for new blocks to be sealed,
+// so it can be used both to backfill past blocks and to follow the chain. The iterator stops on the
+// first failed request, and when the context is done or Close is called.
+func BlocksFrom(ctx context.Context, client Client, startHeight uint64, opts ...BlockIteratorOption) *BlockIterator {
+	config := blockIteratorConfig{
+		prefetch:        DefaultBlockPrefetch,
+		tipPollInterval: time.Second,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.prefetch < 1 {
+		config.prefetch = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	it := &BlockIterator{
+		client:  client,
+		config:  config,
+		cancel:  cancel,
+		pending: make(chan chan blockResult, config.prefetch),
+	}
+
+	go it.prefetch(ctx, startHeight)
+
+	return it
+}
+
+// Next returns the next block, waiting for it to be fetched or sealed.
+//
+// If the context is done before the block is available Next returns the context error and
+// the block is returned by the next call. Any other error ends the iteration and is returned
+// by all the following calls.
+func (it *BlockIterator) Next(ctx context.Context) (*flow.Block, error) {
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if it.current == nil {
+		select {
+		case result, ok := <-it.pending:
+			if !ok {
+				it.err = context.Canceled
+				return nil, it.err
+			}
+			it.current = result
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	select {
+	case result := <-it.current:
+		it.current = nil
+		if result.err != nil {
+			it.err = result.err
+			it.Close()
+			return nil, result.err
+		}
+		return result.block, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Close stops fetching blocks.
+func (it *BlockIterator) Close() {
+	it.cancel()
+}
+
+// prefetch starts fetching the blocks in order of height, blocking while the prefetch limit is reached.
+func (it *BlockIterator) prefetch(ctx context.Context, height uint64) {
+	defer close(it.pending)
+
+	var sealedHeight uint64
+	for ; ; height++ {
+		result := make(chan blockResult, 1)
+
+		select {
+		case it.pending <- result:
+		case <-ctx.Done():
+			return
+		}
+
+		// wait for the block to be sealed once the tip is reached
+		for height > sealedHeight {
+			header, err := it.client.GetLatestBlockHeader(ctx, true)
+			if err != nil {
+				result <- blockResult{err: err}
+				return
+			}
+			sealedHeight = header.Height
+
+			if height <= sealedHeight {
+				break
+			}
+
+			select {
+			case <-time.After(it.config.tipPollInterval):
+			case <-ctx.Done():
+				result <- blockResult{err: ctx.Err()}
+				return
+			}
+		}
+
+		go func(height uint64) {
+			block, err := it.client.GetBlockByHeight(ctx, height)
+			result <- blockResult{block: block, err: err}
+		}(height)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// blockClient is a client stub serving the blocks up to the sealed height.
+type blockClient struct {
+	Client
+
+	mu         sync.Mutex
+	sealed     uint64
+	failHeight uint64
+	requested  map[uint64]bool
+}
+
+func (c *blockClient) GetLatestBlockHeader(context.Context, bool) (*flow.BlockHeader, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &flow.BlockHeader{Height: c.sealed}, nil
+}
+
+func (c *blockClient) GetBlockByHeight(_ context.Context, height uint64) (*flow.Block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.requested == nil {
+		c.requested = make(map[uint64]bool)
+	}
+	c.requested[height] = true
+
+	if height == c.failHeight {
+		return nil, errors.New("block not found")
+	}
+	return &flow.Block{BlockHeader: flow.BlockHeader{Height: height}}, nil
+}
+
+func (c *blockClient) seal(height uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sealed = height
+}
+
+func (c *blockClient) requestCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.requested)
+}
+
+func TestBlocksFrom(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Backfill", func(t *testing.T) {
+		client := &blockClient{sealed: 50}
+		it := BlocksFrom(ctx, client, 10, WithPrefetch(4))
+		defer it.Close()
+
+		for height := uint64(10); height <= 50; height++ {
+			block, err := it.Next(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, height, block.Height)
+		}
+	})
+
+	t.Run("Prefetch limit", func(t *testing.T) {
+		client := &blockClient{sealed: 50}
+		it := BlocksFrom(ctx, client, 1, WithPrefetch(4))
+		defer it.Close()
+
+		require.Eventually(t, func() bool { return client.requestCount() >= 4 }, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+
+		// no more blocks are requested until the consumer takes one
+		assert.Equal(t, 4, client.requestCount())
+	})
+
+	t.Run("Follow tip", func(t *testing.T) {
+		client := &blockClient{sealed: 2}
+		it := BlocksFrom(ctx, client, 1, WithTipPollInterval(time.Millisecond))
+		defer it.Close()
+
+		for height := uint64(1); height <= 2; height++ {
+			block, err := it.Next(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, height, block.Height)
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		_, err := it.Next(timeoutCtx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		client.seal(3)
+
+		block, err := it.Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), block.Height)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		client := &blockClient{sealed: 10, failHeight: 3}
+		it := BlocksFrom(ctx, client, 1)
+
+		for height := uint64(1); height <= 2; height++ {
+			block, err := it.Next(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, height, block.Height)
+		}
+
+		_, err := it.Next(ctx)
+		assert.EqualError(t, err, "block not found")
+
+		_, err = it.Next(ctx)
+		assert.EqualError(t, err, "block not found")
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		client := &blockClient{sealed: 1}
+		it := BlocksFrom(ctx, client, 5, WithTipPollInterval(time.Millisecond))
+
+		it.Close()
+
+		_, err := it.Next(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
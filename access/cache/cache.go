@@ -0,0 +1,145 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cache provides a caching layer for the access API clients.
+//
+// The caching client wraps any access.Client, HTTP or gRPC, and caches the chain data that can't
+// change once it's available: blocks, block headers, collections and transactions looked up by ID,
+// and sealed transaction results. All the other calls are passed to the wrapped client:
+//
+//	flowClient, err := http.NewClient(http.MainnetHost)
+//	cachedClient := cache.NewClient(flowClient, cache.NewLRU(50_000))
+//
+// Cached values are shared between callers and must not be modified.
+package cache
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+const (
+	blockPrefix       = "block/"
+	blockHeaderPrefix = "header/"
+	collectionPrefix  = "collection/"
+	transactionPrefix = "transaction/"
+	resultPrefix      = "result/"
+)
+
+// Client is an access.Client caching immutable chain data returned by the wrapped client.
+type Client struct {
+	access.Client
+	store Store
+}
+
+var _ access.Client = (*Client)(nil)
+
+// NewClient creates a caching client wrapping the provided client and storing values in the store.
+//
+// If store is nil, an in-memory LRU store of DefaultSize entries is used.
+func NewClient(client access.Client, store Store) *Client {
+	if store == nil {
+		store = NewLRU(DefaultSize)
+	}
+
+	return &Client{
+		Client: client,
+		store:  store,
+	}
+}
+
+func (c *Client) GetBlockHeaderByID(ctx context.Context, id flow.Identifier) (*flow.BlockHeader, error) {
+	key := blockHeaderPrefix + id.String()
+	if cached, ok := c.store.Get(key); ok {
+		return cached.(*flow.BlockHeader), nil
+	}
+
+	header, err := c.Client.GetBlockHeaderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store.Set(key, header)
+	return header, nil
+}
+
+func (c *Client) GetBlockByID(ctx context.Context, id flow.Identifier) (*flow.Block, error) {
+	key := blockPrefix + id.String()
+	if cached, ok := c.store.Get(key); ok {
+		return cached.(*flow.Block), nil
+	}
+
+	block, err := c.Client.GetBlockByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store.Set(key, block)
+	return block, nil
+}
+
+func (c *Client) GetCollection(ctx context.Context, colID flow.Identifier) (*flow.Collection, error) {
+	key := collectionPrefix + colID.String()
+	if cached, ok := c.store.Get(key); ok {
+		return cached.(*flow.Collection), nil
+	}
+
+	collection, err := c.Client.GetCollection(ctx, colID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store.Set(key, collection)
+	return collection, nil
+}
+
+func (c *Client) GetTransaction(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+	key := transactionPrefix + txID.String()
+	if cached, ok := c.store.Get(key); ok {
+		return cached.(*flow.Transaction), nil
+	}
+
+	tx, err := c.Client.GetTransaction(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store.Set(key, tx)
+	return tx, nil
+}
+
+// GetTransactionResult returns the transaction result, only sealed results are cached
+// since the result of a transaction can change until it is sealed.
+func (c *Client) GetTransactionResult(ctx context.Context, txID flow.Identifier) (*flow.TransactionResult, error) {
+	key := resultPrefix + txID.String()
+	if cached, ok := c.store.Get(key); ok {
+		return cached.(*flow.TransactionResult), nil
+	}
+
+	result, err := c.Client.GetTransactionResult(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status == flow.TransactionStatusSealed {
+		c.store.Set(key, result)
+	}
+	return result, nil
+}
@@ -0,0 +1,101 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+type stubClient struct {
+	access.Client
+	block  *flow.Block
+	result *flow.TransactionResult
+	calls  int
+}
+
+func (c *stubClient) GetBlockByID(context.Context, flow.Identifier) (*flow.Block, error) {
+	c.calls++
+	return c.block, nil
+}
+
+func (c *stubClient) GetTransactionResult(context.Context, flow.Identifier) (*flow.TransactionResult, error) {
+	c.calls++
+	return c.result, nil
+}
+
+func TestClient_GetBlockByID(t *testing.T) {
+	block := test.BlockGenerator().New()
+	stub := &stubClient{block: block}
+	client := NewClient(stub, nil)
+
+	for i := 0; i < 3; i++ {
+		cached, err := client.GetBlockByID(context.Background(), block.ID)
+		require.NoError(t, err)
+		assert.Equal(t, block, cached)
+	}
+	assert.Equal(t, 1, stub.calls)
+}
+
+func TestClient_GetTransactionResult(t *testing.T) {
+	result := test.TransactionResultGenerator().New()
+	result.Status = flow.TransactionStatusExecuted
+	stub := &stubClient{result: &result}
+	client := NewClient(stub, nil)
+	txID := test.IdentifierGenerator().New()
+
+	_, err := client.GetTransactionResult(context.Background(), txID)
+	require.NoError(t, err)
+
+	result.Status = flow.TransactionStatusSealed
+	for i := 0; i < 2; i++ {
+		cached, err := client.GetTransactionResult(context.Background(), txID)
+		require.NoError(t, err)
+		assert.Equal(t, flow.TransactionStatusSealed, cached.Status)
+	}
+
+	// unsealed results are fetched again
+	assert.Equal(t, 2, stub.calls)
+}
+
+func TestLRU(t *testing.T) {
+	lru := NewLRU(2)
+	lru.Set("a", 1)
+	lru.Set("b", 2)
+
+	_, ok := lru.Get("a")
+	assert.True(t, ok)
+
+	lru.Set("c", 3)
+	assert.Equal(t, 2, lru.Len())
+
+	_, ok = lru.Get("b")
+	assert.False(t, ok, "least recently used entry is evicted")
+
+	value, ok := lru.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+}
@@ -0,0 +1,106 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Store stores cached values by key.
+//
+// Stores are used concurrently and must be safe for concurrent use.
+type Store interface {
+	// Get returns the value stored for the key and whether it was found.
+	Get(key string) (interface{}, bool)
+
+	// Set stores the value for the key.
+	Set(key string, value interface{})
+}
+
+// DefaultSize is the number of entries kept by the default in-memory store.
+const DefaultSize = 10_000
+
+type lruEntry struct {
+	key   string
+	value interface{}
+}
+
+// LRU is an in-memory store keeping a limited number of entries,
+// evicting the least recently used entries first.
+type LRU struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+var _ Store = (*LRU)(nil)
+
+// NewLRU creates an in-memory store keeping up to size entries.
+func NewLRU(size int) *LRU {
+	if size < 1 {
+		size = 1
+	}
+
+	return &LRU{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (l *LRU) Get(key string) (interface{}, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	l.order.MoveToFront(element)
+	return element.Value.(*lruEntry).value, true
+}
+
+func (l *LRU) Set(key string, value interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.entries[key]; ok {
+		element.Value.(*lruEntry).value = value
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, value: value})
+
+	if l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of stored entries.
+func (l *LRU) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.order.Len()
+}
@@ -0,0 +1,98 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+// NodeVersionInfoClient is implemented by the clients able to request the version of the access node,
+// such as the HTTP client.
+type NodeVersionInfoClient interface {
+	GetNodeVersionInfo(ctx context.Context) (*flow.NodeVersionInfo, error)
+}
+
+// crescendoNodeVersion is the major and minor version of the first node release running Cadence 1.0.
+var crescendoNodeVersion = [2]int{0, 37}
+
+// The scripts executed to detect the Cadence version: the pub access modifier was removed by Cadence 1.0,
+// while access(all) is valid in all versions.
+const (
+	cadenceV0Probe = "pub fun main(): Bool { return true }"
+	cadenceV1Probe = "access(all) fun main(): Bool { return true }"
+)
+
+// CadenceVersionForNode returns the Cadence version run by access nodes with the software version,
+// such as "v0.37.10", or cadence.VersionUnknown if the version can't be parsed.
+func CadenceVersionForNode(semver string) sdkcadence.Version {
+	parts := strings.SplitN(strings.TrimPrefix(semver, "v"), ".", 3)
+	if len(parts) < 2 {
+		return sdkcadence.VersionUnknown
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return sdkcadence.VersionUnknown
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return sdkcadence.VersionUnknown
+	}
+
+	if major > crescendoNodeVersion[0] || major == crescendoNodeVersion[0] && minor >= crescendoNodeVersion[1] {
+		return sdkcadence.Version1
+	}
+	return sdkcadence.Version0
+}
+
+// DetectCadenceVersion returns the Cadence version run by the network the client is connected to,
+// to select the scripts and templates compatible with the network around the Crescendo upgrade.
+//
+// The version is derived from the version of the access node if the client implements
+// NodeVersionInfoClient and the node reports a valid version. Otherwise the version is detected
+// by executing scripts only valid in Cadence 0.x, so it also works with the emulator and with
+// access nodes not reporting their version.
+func DetectCadenceVersion(ctx context.Context, client Client) (sdkcadence.Version, error) {
+	if versionClient, ok := client.(NodeVersionInfoClient); ok {
+		info, err := versionClient.GetNodeVersionInfo(ctx)
+		if err == nil {
+			if version := CadenceVersionForNode(info.Semver); version != sdkcadence.VersionUnknown {
+				return version, nil
+			}
+		}
+	}
+
+	_, err := client.ExecuteScriptAtLatestBlock(ctx, []byte(cadenceV0Probe), nil)
+	if err == nil {
+		return sdkcadence.Version0, nil
+	}
+
+	_, err = client.ExecuteScriptAtLatestBlock(ctx, []byte(cadenceV1Probe), nil)
+	if err != nil {
+		return sdkcadence.VersionUnknown, fmt.Errorf("failed to detect Cadence version: %w", err)
+	}
+
+	return sdkcadence.Version1, nil
+}
@@ -0,0 +1,129 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+// cadenceClient is a client stub executing the scripts valid in a Cadence version.
+type cadenceClient struct {
+	Client
+	version sdkcadence.Version
+	err     error
+	scripts int
+}
+
+func (c *cadenceClient) ExecuteScriptAtLatestBlock(_ context.Context, script []byte, _ []cadence.Value) (cadence.Value, error) {
+	c.scripts++
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.version == sdkcadence.Version1 && strings.HasPrefix(string(script), "pub ") {
+		return nil, errors.New("`pub` is no longer a valid access keyword")
+	}
+	return cadence.NewBool(true), nil
+}
+
+// versionClient is a cadenceClient stub also reporting the node version.
+type versionClient struct {
+	cadenceClient
+	semver string
+	err    error
+}
+
+func (c *versionClient) GetNodeVersionInfo(context.Context) (*flow.NodeVersionInfo, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &flow.NodeVersionInfo{Semver: c.semver}, nil
+}
+
+func TestCadenceVersionForNode(t *testing.T) {
+	versions := map[string]sdkcadence.Version{
+		"v0.33.1":        sdkcadence.Version0,
+		"v0.36.9-rc.2":   sdkcadence.Version0,
+		"v0.37.0":        sdkcadence.Version1,
+		"0.38.3":         sdkcadence.Version1,
+		"v1.0.0":         sdkcadence.Version1,
+		"undefined":      sdkcadence.VersionUnknown,
+		"":               sdkcadence.VersionUnknown,
+		"vX.37.0-crypto": sdkcadence.VersionUnknown,
+	}
+
+	for semver, expected := range versions {
+		assert.Equal(t, expected, CadenceVersionForNode(semver), semver)
+	}
+}
+
+func TestDetectCadenceVersion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Node version", func(t *testing.T) {
+		client := &versionClient{semver: "v0.37.10"}
+
+		version, err := DetectCadenceVersion(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, sdkcadence.Version1, version)
+		assert.Zero(t, client.scripts)
+	})
+
+	t.Run("Unknown node version", func(t *testing.T) {
+		client := &versionClient{cadenceClient: cadenceClient{version: sdkcadence.Version0}, semver: "undefined"}
+
+		version, err := DetectCadenceVersion(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, sdkcadence.Version0, version)
+	})
+
+	t.Run("Node version not available", func(t *testing.T) {
+		client := &versionClient{cadenceClient: cadenceClient{version: sdkcadence.Version1}, err: errors.New("not found")}
+
+		version, err := DetectCadenceVersion(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, sdkcadence.Version1, version)
+		assert.Equal(t, 2, client.scripts)
+	})
+
+	t.Run("Scripts", func(t *testing.T) {
+		for _, expected := range []sdkcadence.Version{sdkcadence.Version0, sdkcadence.Version1} {
+			version, err := DetectCadenceVersion(ctx, &cadenceClient{version: expected})
+			require.NoError(t, err)
+			assert.Equal(t, expected, version)
+		}
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		unavailable := errors.New("unavailable")
+
+		version, err := DetectCadenceVersion(ctx, &cadenceClient{err: unavailable})
+		assert.True(t, errors.Is(err, unavailable))
+		assert.Equal(t, sdkcadence.VersionUnknown, version)
+	})
+}
@@ -30,6 +30,10 @@ import (
 	"github.com/onflow/flow-go-sdk"
 )
 
+// Client is the Access API client interface implemented by both the HTTP and the gRPC clients.
+//
+// Code depending on Client can be used with either transport, as well as with the client
+// decorators in the subpackages and with mocks in tests.
 type Client interface {
 	// Ping is used to check if the access node is alive and healthy.
 	Ping(ctx context.Context) error
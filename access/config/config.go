@@ -0,0 +1,363 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package config creates access API clients from configuration.
+//
+// The configuration selects the network, the transport and the access node endpoints, so services
+// can switch networks and transports without code changes. It can be loaded from a JSON file
+// and from environment variables, which take precedence over the file:
+//
+//	cfg, err := config.Load("flow.json")
+//	flowClient, err := config.NewClient(cfg)
+//
+// The package is separate from the access package since it depends on the HTTP and gRPC clients,
+// which implement the access.Client interface.
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	grpcapi "google.golang.org/grpc"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/failover"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/access/http"
+)
+
+// Environment variables overriding the configuration.
+const (
+	EnvNetwork       = "FLOW_NETWORK"
+	EnvTransport     = "FLOW_ACCESS_TRANSPORT"
+	EnvEndpoints     = "FLOW_ACCESS_ENDPOINTS" // comma separated list of endpoints
+	EnvTimeout       = "FLOW_ACCESS_TIMEOUT"   // duration, for example "10s"
+	EnvTLS           = "FLOW_ACCESS_TLS"       // boolean
+	EnvTLSCAFile     = "FLOW_ACCESS_TLS_CA_FILE"
+	EnvTLSCertFile   = "FLOW_ACCESS_TLS_CERT_FILE"
+	EnvTLSKeyFile    = "FLOW_ACCESS_TLS_KEY_FILE"
+	EnvVerifyNetwork = "FLOW_ACCESS_VERIFY_NETWORK" // boolean
+)
+
+// Transport is the protocol used to communicate with the access nodes.
+type Transport string
+
+const (
+	TransportGRPC Transport = "grpc"
+	TransportHTTP Transport = "http"
+)
+
+// Duration is a time.Duration encoded in JSON as a string, for example "1m30s".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(duration)
+	return nil
+}
+
+// TLSConfig configures the TLS connections to the access nodes.
+//
+// When TLS is enabled the system root certificates are used to verify the access nodes unless a CA
+// file is provided. The certificate and key files are the client certificate used for mutual TLS.
+type TLSConfig struct {
+	Enabled  bool   `json:"enabled"`
+	CAFile   string `json:"caFile,omitempty"`
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+}
+
+// Config is the configuration of an access API client.
+type Config struct {
+	// Network is the network name (mainnet, testnet, canary or emulator) or its chain ID.
+	Network string `json:"network"`
+
+	// Transport is the protocol used to connect to the access nodes, gRPC if empty.
+	Transport Transport `json:"transport,omitempty"`
+
+	// Endpoints are the access node endpoints, the predefined host of the network is used if empty.
+	// Calls fail over to the next endpoints when more than one endpoint is provided.
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// Timeout is the default timeout of the requests which have no deadline.
+	Timeout Duration `json:"timeout,omitempty"`
+
+	TLS TLSConfig `json:"tls"`
+
+	// VerifyNetwork makes the clients fail if the access nodes serve another network than the
+	// configured network, which is checked by Ping and before sending the first transaction.
+	VerifyNetwork bool `json:"verifyNetwork,omitempty"`
+}
+
+// Load loads the configuration from the JSON file at the given path and applies the environment
+// variables overrides. The file is optional, if the path is empty only the environment is used.
+func Load(path string) (Config, error) {
+	var cfg Config
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to read config file: %w", err)
+		}
+
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("failed to decode config file %s: %w", path, err)
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// FromEnv loads the configuration from the environment variables.
+func FromEnv() (Config, error) {
+	return Load("")
+}
+
+func (c *Config) applyEnv() error {
+	if v, ok := os.LookupEnv(EnvNetwork); ok {
+		c.Network = v
+	}
+	if v, ok := os.LookupEnv(EnvTransport); ok {
+		c.Transport = Transport(v)
+	}
+	if v, ok := os.LookupEnv(EnvEndpoints); ok {
+		c.Endpoints = nil
+		for _, endpoint := range strings.Split(v, ",") {
+			if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+				c.Endpoints = append(c.Endpoints, endpoint)
+			}
+		}
+	}
+	if v, ok := os.LookupEnv(EnvTimeout); ok {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvTimeout, err)
+		}
+		c.Timeout = Duration(timeout)
+	}
+	if v, ok := os.LookupEnv(EnvTLS); ok {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvTLS, err)
+		}
+		c.TLS.Enabled = enabled
+	}
+	if v, ok := os.LookupEnv(EnvTLSCAFile); ok {
+		c.TLS.CAFile = v
+	}
+	if v, ok := os.LookupEnv(EnvTLSCertFile); ok {
+		c.TLS.CertFile = v
+	}
+	if v, ok := os.LookupEnv(EnvTLSKeyFile); ok {
+		c.TLS.KeyFile = v
+	}
+	if v, ok := os.LookupEnv(EnvVerifyNetwork); ok {
+		verify, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvVerifyNetwork, err)
+		}
+		c.VerifyNetwork = verify
+	}
+
+	return nil
+}
+
+// ChainID returns the chain ID of the configured network.
+func (c Config) ChainID() (flow.ChainID, error) {
+	switch strings.ToLower(c.Network) {
+	case "mainnet", string(flow.Mainnet):
+		return flow.Mainnet, nil
+	case "testnet", string(flow.Testnet):
+		return flow.Testnet, nil
+	case "canary", string(flow.Canary):
+		return flow.Canary, nil
+	case "emulator", string(flow.Emulator):
+		return flow.Emulator, nil
+	default:
+		return "", fmt.Errorf("unknown network %q", c.Network)
+	}
+}
+
+// NewClient creates an access API client from the configuration.
+func NewClient(cfg Config) (access.Client, error) {
+	var tlsConfig *tls.Config
+	if cfg.TLS.Enabled {
+		var err error
+		tlsConfig, err = cfg.TLS.load()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var expectedChainID flow.ChainID
+	if cfg.VerifyNetwork {
+		var err error
+		expectedChainID, err = cfg.ChainID()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	endpoints := cfg.Endpoints
+	if len(endpoints) == 0 {
+		chainID, err := cfg.ChainID()
+		if err != nil {
+			return nil, err
+		}
+
+		var host string
+		switch cfg.Transport {
+		case TransportHTTP:
+			host, err = http.ChainHost(chainID)
+		default:
+			host, err = grpc.ChainHost(chainID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints = []string{host}
+	}
+
+	clients := make([]access.Client, len(endpoints))
+	for i, endpoint := range endpoints {
+		var client access.Client
+		var err error
+
+		switch cfg.Transport {
+		case TransportGRPC, "":
+			client, err = newGRPCClient(endpoint, time.Duration(cfg.Timeout), tlsConfig, expectedChainID)
+		case TransportHTTP:
+			client, err = newHTTPClient(endpoint, time.Duration(cfg.Timeout), tlsConfig, expectedChainID)
+		default:
+			return nil, fmt.Errorf("unknown transport %q", cfg.Transport)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for %s: %w", endpoint, err)
+		}
+
+		clients[i] = client
+	}
+
+	if len(clients) == 1 {
+		return clients[0], nil
+	}
+
+	return failover.NewClient(clients)
+}
+
+func newGRPCClient(
+	endpoint string,
+	timeout time.Duration,
+	tlsConfig *tls.Config,
+	expectedChainID flow.ChainID,
+) (access.Client, error) {
+	var opts []grpcapi.DialOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.WithTLS(tlsConfig))
+	}
+
+	if timeout > 0 {
+		opts = append(opts, grpcapi.WithUnaryInterceptor(timeoutInterceptor(timeout)))
+	}
+
+	client, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetExpectedChainID(expectedChainID)
+	return client, nil
+}
+
+// timeoutInterceptor sets the timeout of the calls made without a deadline.
+func timeoutInterceptor(timeout time.Duration) grpcapi.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpcapi.ClientConn,
+		invoker grpcapi.UnaryInvoker,
+		opts ...grpcapi.CallOption,
+	) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func newHTTPClient(
+	endpoint string,
+	timeout time.Duration,
+	tlsConfig *tls.Config,
+	expectedChainID flow.ChainID,
+) (access.Client, error) {
+	var opts []http.ClientOption
+
+	if timeout > 0 {
+		opts = append(opts, http.WithTimeout(timeout))
+	}
+
+	if tlsConfig != nil {
+		transport := nethttp.DefaultTransport.(*nethttp.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		opts = append(opts, http.WithHTTPClient(&nethttp.Client{Transport: transport}))
+	}
+
+	client, err := http.NewClient(endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client.SetExpectedChainID(expectedChainID)
+	return client, nil
+}
+
+// load creates the TLS configuration from the configured files.
+func (c TLSConfig) load() (*tls.Config, error) {
+	return grpc.NewTLSConfig(c.CAFile, c.CertFile, c.KeyFile)
+}
@@ -0,0 +1,163 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/failover"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/access/http"
+)
+
+func TestLoad(t *testing.T) {
+
+	t.Run("File", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "flow.json")
+		err := os.WriteFile(path, []byte(`{
+			"network": "testnet",
+			"transport": "http",
+			"endpoints": ["https://rest-testnet.example.com/v1"],
+			"timeout": "15s",
+			"tls": {"enabled": true}
+		}`), 0600)
+		require.NoError(t, err)
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, Config{
+			Network:   "testnet",
+			Transport: TransportHTTP,
+			Endpoints: []string{"https://rest-testnet.example.com/v1"},
+			Timeout:   Duration(15 * time.Second),
+			TLS:       TLSConfig{Enabled: true},
+		}, cfg)
+	})
+
+	t.Run("Environment overrides", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "flow.json")
+		err := os.WriteFile(path, []byte(`{"network": "testnet", "transport": "http"}`), 0600)
+		require.NoError(t, err)
+
+		t.Setenv(EnvNetwork, "mainnet")
+		t.Setenv(EnvEndpoints, "a.example.com:9000, b.example.com:9000")
+		t.Setenv(EnvTimeout, "2s")
+		t.Setenv(EnvVerifyNetwork, "true")
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, "mainnet", cfg.Network)
+		assert.Equal(t, TransportHTTP, cfg.Transport)
+		assert.Equal(t, []string{"a.example.com:9000", "b.example.com:9000"}, cfg.Endpoints)
+		assert.Equal(t, Duration(2*time.Second), cfg.Timeout)
+		assert.True(t, cfg.VerifyNetwork)
+	})
+
+	t.Run("Invalid environment", func(t *testing.T) {
+		t.Setenv(EnvTimeout, "soon")
+
+		_, err := FromEnv()
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+	})
+}
+
+func TestConfig_ChainID(t *testing.T) {
+	for network, expected := range map[string]flow.ChainID{
+		"mainnet":       flow.Mainnet,
+		"Testnet":       flow.Testnet,
+		"canary":        flow.Canary,
+		"flow-emulator": flow.Emulator,
+	} {
+		chainID, err := Config{Network: network}.ChainID()
+		require.NoError(t, err)
+		assert.Equal(t, expected, chainID)
+	}
+
+	_, err := Config{Network: "moonnet"}.ChainID()
+	assert.Error(t, err)
+}
+
+func TestNewClient(t *testing.T) {
+
+	t.Run("gRPC", func(t *testing.T) {
+		client, err := NewClient(Config{Network: "emulator", Timeout: Duration(time.Second)})
+		require.NoError(t, err)
+		assert.IsType(t, &grpc.Client{}, client)
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		client, err := NewClient(Config{
+			Network:   "testnet",
+			Transport: TransportHTTP,
+			TLS:       TLSConfig{Enabled: true},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &http.Client{}, client)
+	})
+
+	t.Run("Failover", func(t *testing.T) {
+		client, err := NewClient(Config{
+			Transport: TransportHTTP,
+			Endpoints: []string{"https://a.example.com/v1", "https://b.example.com/v1"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &failover.Client{}, client)
+	})
+
+	t.Run("Unknown transport", func(t *testing.T) {
+		_, err := NewClient(Config{Network: "mainnet", Transport: "carrier-pigeon"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown network", func(t *testing.T) {
+		_, err := NewClient(Config{Network: "moonnet"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Verify unknown network", func(t *testing.T) {
+		_, err := NewClient(Config{
+			Network:       "moonnet",
+			Endpoints:     []string{"access.example.com:9000"},
+			VerifyNetwork: true,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing CA file", func(t *testing.T) {
+		_, err := NewClient(Config{
+			Network: "mainnet",
+			TLS:     TLSConfig{Enabled: true, CAFile: filepath.Join(t.TempDir(), "ca.pem")},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,73 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+)
+
+// Errors returned by the access node, matched with errors.Is regardless of the client transport.
+//
+// The errors returned by the HTTP and gRPC clients match these errors based on the HTTP status code
+// or gRPC status code of the response, and still unwrap to the transport specific errors.
+var (
+	// ErrNotFound is returned when the requested entity doesn't exist, or isn't indexed by the node.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidArgument is returned when the request is malformed or invalid.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrRateLimited is returned when the node rejected the request because of rate limits.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnavailable is returned when the node, or a node it depends on, is unavailable.
+	ErrUnavailable = errors.New("unavailable")
+	// ErrOutOfRange is returned when the requested block height is outside of the range available on the node.
+	ErrOutOfRange = errors.New("out of range")
+)
+
+// IsRetryable returns true if the request failing with the error may succeed when retried, because
+// the node rate limited the request, was unavailable or couldn't be reached.
+//
+// Errors caused by the cancellation or the deadline of the request context are not retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUnavailable) || RetryAfter(err) > 0 {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// RetryAfter returns how long the node asked to wait before retrying the request failing with the
+// error, using the Retry-After header of HTTP responses or the retry info of gRPC statuses.
+//
+// RetryAfter returns 0 if the node didn't specify a delay.
+func RetryAfter(err error) time.Duration {
+	var retryErr interface{ RetryAfter() time.Duration }
+	if errors.As(err, &retryErr) {
+		return retryErr.RetryAfter()
+	}
+
+	return 0
+}
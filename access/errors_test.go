@@ -0,0 +1,72 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// statusError is a transport error stub matching a sentinel error and carrying a retry delay.
+type statusError struct {
+	sentinel   error
+	retryAfter time.Duration
+}
+
+func (e statusError) Error() string             { return "request failed" }
+func (e statusError) Is(target error) bool      { return target == e.sentinel }
+func (e statusError) RetryAfter() time.Duration { return e.retryAfter }
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"nil", nil, false},
+		{"rate limited", statusError{sentinel: ErrRateLimited}, true},
+		{"unavailable", fmt.Errorf("wrapped: %w", statusError{sentinel: ErrUnavailable}), true},
+		{"not found", statusError{sentinel: ErrNotFound}, false},
+		{"invalid argument", statusError{sentinel: ErrInvalidArgument}, false},
+		{"retry after", statusError{retryAfter: time.Second}, true},
+		{"network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"canceled", fmt.Errorf("wrapped: %w", context.Canceled), false},
+		{"deadline exceeded", context.DeadlineExceeded, false},
+		{"other", errors.New("failed"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.retryable, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	assert.Equal(t, 3*time.Second, RetryAfter(fmt.Errorf("wrapped: %w", statusError{retryAfter: 3 * time.Second})))
+	assert.Zero(t, RetryAfter(statusError{}))
+	assert.Zero(t, RetryAfter(errors.New("failed")))
+	assert.Zero(t, RetryAfter(nil))
+}
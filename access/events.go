@@ -0,0 +1,192 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// EventHeightRangeLimit is the maximum number of blocks an access node allows
+// to be queried for events in a single request.
+const EventHeightRangeLimit uint64 = 250
+
+// ChunkedEventRangeQuery defines a query for Flow events over a height range of any size.
+type ChunkedEventRangeQuery struct {
+	// The event type to search for.
+	Type string
+	// The block height to begin looking for events (inclusive).
+	StartHeight uint64
+	// The block height to end looking for events (inclusive).
+	EndHeight uint64
+	// ChunkSize is the number of blocks requested at once, it defaults to EventHeightRangeLimit.
+	ChunkSize uint64
+	// Concurrency is the maximum number of chunks requested in parallel, it defaults to 1.
+	Concurrency int
+}
+
+// heightRange is an inclusive range of block heights.
+type heightRange struct {
+	start uint64
+	end   uint64
+}
+
+// splitHeightRange splits the inclusive height range into consecutive ranges of at most size blocks.
+func splitHeightRange(start uint64, end uint64, size uint64) []heightRange {
+	var ranges []heightRange
+	for from := start; from <= end; from += size {
+		to := from + size - 1
+		if to > end || to < from { // guard against overflow at the top of the height space
+			to = end
+		}
+
+		ranges = append(ranges, heightRange{start: from, end: to})
+
+		if to == end {
+			break
+		}
+	}
+
+	return ranges
+}
+
+// GetEventsForHeightRangeChunked retrieves events for all sealed blocks between the start and end
+// block heights (inclusive) with the given type, splitting the range into chunks the access node accepts.
+//
+// Chunks are requested with up to query.Concurrency requests in flight and the results are merged in
+// height order. The first failing chunk cancels the remaining requests and its error is returned.
+func GetEventsForHeightRangeChunked(
+	ctx context.Context,
+	client Client,
+	query ChunkedEventRangeQuery,
+) ([]flow.BlockEvents, error) {
+	ranges, err := chunkHeightRange(query.StartHeight, query.EndHeight, query.ChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pending := make(chan chan eventChunk, maxInFlight(query.Concurrency)-1)
+	go fetchEventChunks(ctx, client, query.Type, ranges, 0, pending)
+
+	merged := make([]flow.BlockEvents, 0)
+	for result := range pending {
+		chunk := <-result
+		if chunk.err != nil {
+			return nil, chunk.err
+		}
+		merged = append(merged, chunk.events...)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+// chunkHeightRange splits the inclusive height range into chunks of the given size, which defaults
+// to and is capped at EventHeightRangeLimit.
+func chunkHeightRange(start uint64, end uint64, chunkSize uint64) ([]heightRange, error) {
+	if start > end {
+		return nil, fmt.Errorf("start height (%d) must be smaller than end height (%d)", start, end)
+	}
+
+	if chunkSize == 0 || chunkSize > EventHeightRangeLimit {
+		chunkSize = EventHeightRangeLimit
+	}
+
+	return splitHeightRange(start, end, chunkSize), nil
+}
+
+// maxInFlight returns the number of chunks requested in parallel for the configured concurrency,
+// which defaults to 1.
+func maxInFlight(concurrency int) int {
+	if concurrency < 1 {
+		return 1
+	}
+	return concurrency
+}
+
+// eventChunk is the result of the request for the events of a chunk.
+type eventChunk struct {
+	events []flow.BlockEvents
+	err    error
+}
+
+// fetchEventChunks starts the chunk requests in height order, queueing a channel receiving the result of
+// every request on pending, which is closed once all the requests are started or the context is done.
+//
+// A request is started once its result is queued, so the pending capacity plus one bounds the number of
+// requests in flight ahead of the consumer. The requests are spaced to requestsPerSecond if it is positive.
+func fetchEventChunks(
+	ctx context.Context,
+	client Client,
+	eventType string,
+	ranges []heightRange,
+	requestsPerSecond float64,
+	pending chan<- chan eventChunk,
+) {
+	defer close(pending)
+
+	var limit <-chan time.Time
+	if requestsPerSecond > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / requestsPerSecond))
+		defer ticker.Stop()
+		limit = ticker.C
+	}
+
+	for i, r := range ranges {
+		result := make(chan eventChunk, 1)
+
+		select {
+		case pending <- result:
+		case <-ctx.Done():
+			return
+		}
+
+		if limit != nil && i > 0 {
+			select {
+			case <-limit:
+			case <-ctx.Done():
+				result <- eventChunk{err: ctx.Err()}
+				return
+			}
+		}
+
+		go func(r heightRange) {
+			events, err := client.GetEventsForHeightRange(ctx, eventType, r.start, r.end)
+			if err != nil {
+				result <- eventChunk{err: fmt.Errorf("get events for height range %d-%d failed: %w", r.start, r.end, err)}
+				return
+			}
+
+			sort.SliceStable(events, func(i, j int) bool {
+				return events[i].Height < events[j].Height
+			})
+			result <- eventChunk{events: events}
+		}(r)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// eventsClient is a client stub that returns one block events entry per height in the requested range.
+type eventsClient struct {
+	Client
+	mu       sync.Mutex
+	requests []heightRange
+	failAt   uint64
+}
+
+func (c *eventsClient) GetEventsForHeightRange(
+	_ context.Context,
+	_ string,
+	startHeight uint64,
+	endHeight uint64,
+) ([]flow.BlockEvents, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, heightRange{start: startHeight, end: endHeight})
+	c.mu.Unlock()
+
+	if c.failAt != 0 && c.failAt >= startHeight && c.failAt <= endHeight {
+		return nil, fmt.Errorf("failed")
+	}
+
+	events := make([]flow.BlockEvents, 0)
+	for h := startHeight; h <= endHeight; h++ {
+		events = append(events, flow.BlockEvents{Height: h})
+	}
+	return events, nil
+}
+
+func TestSplitHeightRange(t *testing.T) {
+	assert.Equal(t, []heightRange{{0, 249}, {250, 499}, {500, 500}}, splitHeightRange(0, 500, 250))
+	assert.Equal(t, []heightRange{{10, 10}}, splitHeightRange(10, 10, 250))
+	assert.Equal(t, []heightRange{{1, 2}, {3, 4}}, splitHeightRange(1, 4, 2))
+}
+
+func TestGetEventsForHeightRangeChunked(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Merges In Height Order", func(t *testing.T) {
+		client := &eventsClient{}
+
+		events, err := GetEventsForHeightRangeChunked(ctx, client, ChunkedEventRangeQuery{
+			Type:        "A.Foo.Bar",
+			StartHeight: 100,
+			EndHeight:   1099,
+			Concurrency: 4,
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1000)
+		assert.Len(t, client.requests, 4)
+
+		for i, e := range events {
+			assert.Equal(t, uint64(100+i), e.Height)
+		}
+	})
+
+	t.Run("Custom Chunk Size", func(t *testing.T) {
+		client := &eventsClient{}
+
+		events, err := GetEventsForHeightRangeChunked(ctx, client, ChunkedEventRangeQuery{
+			StartHeight: 0,
+			EndHeight:   9,
+			ChunkSize:   5,
+		})
+		require.NoError(t, err)
+		assert.Len(t, events, 10)
+		assert.Equal(t, []heightRange{{0, 4}, {5, 9}}, client.requests)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		client := &eventsClient{failAt: 600}
+
+		events, err := GetEventsForHeightRangeChunked(ctx, client, ChunkedEventRangeQuery{
+			StartHeight: 0,
+			EndHeight:   999,
+			Concurrency: 2,
+		})
+		assert.EqualError(t, err, "get events for height range 500-749 failed: failed")
+		assert.Nil(t, events)
+	})
+
+	t.Run("Invalid Range", func(t *testing.T) {
+		events, err := GetEventsForHeightRangeChunked(ctx, &eventsClient{}, ChunkedEventRangeQuery{
+			StartHeight: 5,
+			EndHeight:   0,
+		})
+		assert.EqualError(t, err, "start height (5) must be smaller than end height (0)")
+		assert.Nil(t, events)
+	})
+}
@@ -0,0 +1,400 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package failover provides an access API client spreading calls over multiple access nodes.
+//
+// The failover client wraps a list of access.Client, HTTP or gRPC, connected to different access
+// nodes. Calls are made to the first healthy node, and on connection errors or server errors the
+// call is retried on the next node. Failing nodes are skipped for a cooldown period:
+//
+//	primary, err := http.NewClient(http.MainnetHost)
+//	secondary, err := http.NewClient("https://rest-mainnet.example.com/v1")
+//	flowClient, err := failover.NewClient([]access.Client{primary, secondary})
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/onflow/cadence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http"
+)
+
+// DefaultCooldown is the time a failing node is skipped for.
+const DefaultCooldown = 30 * time.Second
+
+// Option configures the failover client.
+type Option func(c *Client)
+
+// WithCooldown sets the time a failing node is skipped for.
+func WithCooldown(cooldown time.Duration) Option {
+	return func(c *Client) {
+		c.cooldown = cooldown
+	}
+}
+
+// WithFailoverCheck sets the function deciding whether an error returned by a node is
+// a node failure, in which case the call is retried on the next node.
+//
+// By default calls fail over on connection errors, HTTP 5xx responses and unavailable
+// or internal gRPC errors.
+func WithFailoverCheck(check func(err error) bool) Option {
+	return func(c *Client) {
+		c.shouldFailover = check
+	}
+}
+
+// IsNodeError returns true if the error is caused by the access node being unreachable or failing,
+// as opposed to an error caused by the request.
+func IsNodeError(err error) bool {
+	var httpErr http.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code >= 500
+	}
+
+	if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
+		return s.Code() == codes.Unavailable || s.Code() == codes.Internal
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// node is an access node client with its health state.
+type node struct {
+	client    access.Client
+	downUntil time.Time
+}
+
+// Client is an access.Client making calls to the first healthy access node and
+// failing over to the next ones when a node fails.
+type Client struct {
+	mu             sync.Mutex
+	nodes          []*node
+	cooldown       time.Duration
+	shouldFailover func(err error) bool
+}
+
+var _ access.Client = (*Client)(nil)
+var _ access.AccountAtBlockIDClient = (*Client)(nil)
+
+// NewClient creates a failover client over the provided clients, in order of preference.
+func NewClient(clients []access.Client, opts ...Option) (*Client, error) {
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("at least one client must be provided")
+	}
+
+	c := &Client{
+		nodes:          make([]*node, len(clients)),
+		cooldown:       DefaultCooldown,
+		shouldFailover: IsNodeError,
+	}
+	for i, client := range clients {
+		c.nodes[i] = &node{client: client}
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// candidates returns the nodes in the order they should be tried,
+// healthy nodes first followed by the nodes in cooldown.
+func (c *Client) candidates() []*node {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]*node, 0, len(c.nodes))
+	var down []*node
+	for _, n := range c.nodes {
+		if now.Before(n.downUntil) {
+			down = append(down, n)
+		} else {
+			healthy = append(healthy, n)
+		}
+	}
+
+	return append(healthy, down...)
+}
+
+func (c *Client) markDown(n *node) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n.downUntil = time.Now().Add(c.cooldown)
+}
+
+func (c *Client) markUp(n *node) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n.downUntil = time.Time{}
+}
+
+// call makes the call on the nodes in order until one of them succeeds or fails with a non-node error.
+func (c *Client) call(ctx context.Context, call func(client access.Client) error) error {
+	var err error
+	for _, n := range c.candidates() {
+		err = call(n.client)
+		if err == nil {
+			c.markUp(n)
+			return nil
+		}
+
+		if ctx.Err() != nil || !c.shouldFailover(err) {
+			return err
+		}
+
+		c.markDown(n)
+	}
+
+	return err
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	return c.call(ctx, func(client access.Client) error {
+		return client.Ping(ctx)
+	})
+}
+
+func (c *Client) GetLatestBlockHeader(ctx context.Context, isSealed bool) (header *flow.BlockHeader, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		header, err = client.GetLatestBlockHeader(ctx, isSealed)
+		return err
+	})
+	return header, err
+}
+
+func (c *Client) GetBlockHeaderByID(ctx context.Context, id flow.Identifier) (header *flow.BlockHeader, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		header, err = client.GetBlockHeaderByID(ctx, id)
+		return err
+	})
+	return header, err
+}
+
+func (c *Client) GetBlockHeaderByHeight(ctx context.Context, height uint64) (header *flow.BlockHeader, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		header, err = client.GetBlockHeaderByHeight(ctx, height)
+		return err
+	})
+	return header, err
+}
+
+func (c *Client) GetLatestBlock(ctx context.Context, isSealed bool) (block *flow.Block, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		block, err = client.GetLatestBlock(ctx, isSealed)
+		return err
+	})
+	return block, err
+}
+
+func (c *Client) GetBlockByID(ctx context.Context, id flow.Identifier) (block *flow.Block, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		block, err = client.GetBlockByID(ctx, id)
+		return err
+	})
+	return block, err
+}
+
+func (c *Client) GetBlockByHeight(ctx context.Context, height uint64) (block *flow.Block, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		block, err = client.GetBlockByHeight(ctx, height)
+		return err
+	})
+	return block, err
+}
+
+func (c *Client) GetCollection(ctx context.Context, colID flow.Identifier) (collection *flow.Collection, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		collection, err = client.GetCollection(ctx, colID)
+		return err
+	})
+	return collection, err
+}
+
+// SendTransaction sends the transaction to the first healthy node, failing over to the next nodes.
+//
+// Sending the same transaction to multiple nodes is safe, since a transaction is only executed once.
+func (c *Client) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	return c.call(ctx, func(client access.Client) error {
+		return client.SendTransaction(ctx, tx)
+	})
+}
+
+func (c *Client) GetTransaction(ctx context.Context, txID flow.Identifier) (tx *flow.Transaction, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		tx, err = client.GetTransaction(ctx, txID)
+		return err
+	})
+	return tx, err
+}
+
+func (c *Client) GetTransactionResult(
+	ctx context.Context,
+	txID flow.Identifier,
+) (result *flow.TransactionResult, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		result, err = client.GetTransactionResult(ctx, txID)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) GetAccount(ctx context.Context, address flow.Address) (account *flow.Account, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		account, err = client.GetAccount(ctx, address)
+		return err
+	})
+	return account, err
+}
+
+func (c *Client) GetAccountAtLatestBlock(ctx context.Context, address flow.Address) (account *flow.Account, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		account, err = client.GetAccountAtLatestBlock(ctx, address)
+		return err
+	})
+	return account, err
+}
+
+func (c *Client) GetAccountAtBlockHeight(
+	ctx context.Context,
+	address flow.Address,
+	height uint64,
+) (account *flow.Account, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		account, err = client.GetAccountAtBlockHeight(ctx, address, height)
+		return err
+	})
+	return account, err
+}
+
+func (c *Client) GetAccountAtBlockID(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+) (account *flow.Account, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		account, err = access.GetAccountAtBlockID(ctx, client, address, blockID)
+		return err
+	})
+	return account, err
+}
+
+func (c *Client) ExecuteScriptAtLatestBlock(
+	ctx context.Context,
+	script []byte,
+	arguments []cadence.Value,
+) (value cadence.Value, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		value, err = client.ExecuteScriptAtLatestBlock(ctx, script, arguments)
+		return err
+	})
+	return value, err
+}
+
+func (c *Client) ExecuteScriptAtBlockID(
+	ctx context.Context,
+	id flow.Identifier,
+	script []byte,
+	arguments []cadence.Value,
+) (value cadence.Value, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		value, err = client.ExecuteScriptAtBlockID(ctx, id, script, arguments)
+		return err
+	})
+	return value, err
+}
+
+func (c *Client) ExecuteScriptAtBlockHeight(
+	ctx context.Context,
+	height uint64,
+	script []byte,
+	arguments []cadence.Value,
+) (value cadence.Value, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		value, err = client.ExecuteScriptAtBlockHeight(ctx, height, script, arguments)
+		return err
+	})
+	return value, err
+}
+
+func (c *Client) GetEventsForHeightRange(
+	ctx context.Context,
+	eventType string,
+	startHeight uint64,
+	endHeight uint64,
+) (events []flow.BlockEvents, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		events, err = client.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+		return err
+	})
+	return events, err
+}
+
+func (c *Client) GetEventsForBlockIDs(
+	ctx context.Context,
+	eventType string,
+	blockIDs []flow.Identifier,
+) (events []flow.BlockEvents, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		events, err = client.GetEventsForBlockIDs(ctx, eventType, blockIDs)
+		return err
+	})
+	return events, err
+}
+
+func (c *Client) GetLatestProtocolStateSnapshot(ctx context.Context) (snapshot []byte, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		snapshot, err = client.GetLatestProtocolStateSnapshot(ctx)
+		return err
+	})
+	return snapshot, err
+}
+
+func (c *Client) GetExecutionResultForBlockID(
+	ctx context.Context,
+	id flow.Identifier,
+) (result *flow.ExecutionResult, err error) {
+	err = c.call(ctx, func(client access.Client) (err error) {
+		result, err = client.GetExecutionResultForBlockID(ctx, id)
+		return err
+	})
+	return result, err
+}
+
+// Close closes the clients of all the nodes, returning the first error encountered.
+func (c *Client) Close() error {
+	var err error
+	for _, n := range c.nodes {
+		if closeErr := n.client.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
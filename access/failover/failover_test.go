@@ -0,0 +1,128 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package failover
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http"
+)
+
+type stubClient struct {
+	access.Client
+	err   error
+	calls int
+}
+
+func (c *stubClient) GetBlockByHeight(_ context.Context, height uint64) (*flow.Block, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &flow.Block{BlockHeader: flow.BlockHeader{Height: height}}, nil
+}
+
+func TestIsNodeError(t *testing.T) {
+	assert.True(t, IsNodeError(http.HTTPError{Code: 503}))
+	assert.False(t, IsNodeError(http.HTTPError{Code: 400}))
+	assert.True(t, IsNodeError(status.Error(codes.Unavailable, "down")))
+	assert.False(t, IsNodeError(status.Error(codes.NotFound, "missing")))
+	assert.False(t, IsNodeError(fmt.Errorf("invalid argument")))
+}
+
+func TestClient_Failover(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Fails Over To Next Node", func(t *testing.T) {
+		primary := &stubClient{err: http.HTTPError{Code: 502}}
+		secondary := &stubClient{}
+		client, err := NewClient([]access.Client{primary, secondary})
+		require.NoError(t, err)
+
+		block, err := client.GetBlockByHeight(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), block.Height)
+
+		// primary is in cooldown and skipped
+		_, err = client.GetBlockByHeight(ctx, 11)
+		require.NoError(t, err)
+		assert.Equal(t, 1, primary.calls)
+		assert.Equal(t, 2, secondary.calls)
+	})
+
+	t.Run("Retries Node After Cooldown", func(t *testing.T) {
+		primary := &stubClient{err: status.Error(codes.Unavailable, "down")}
+		secondary := &stubClient{}
+		client, err := NewClient([]access.Client{primary, secondary}, WithCooldown(time.Millisecond))
+		require.NoError(t, err)
+
+		_, err = client.GetBlockByHeight(ctx, 10)
+		require.NoError(t, err)
+
+		time.Sleep(2 * time.Millisecond)
+		primary.err = nil
+
+		_, err = client.GetBlockByHeight(ctx, 11)
+		require.NoError(t, err)
+		assert.Equal(t, 2, primary.calls)
+		assert.Equal(t, 1, secondary.calls)
+	})
+
+	t.Run("Request Errors Are Not Failed Over", func(t *testing.T) {
+		primary := &stubClient{err: http.HTTPError{Code: 404}}
+		secondary := &stubClient{}
+		client, err := NewClient([]access.Client{primary, secondary})
+		require.NoError(t, err)
+
+		_, err = client.GetBlockByHeight(ctx, 10)
+		assert.Equal(t, primary.err, err)
+		assert.Equal(t, 0, secondary.calls)
+	})
+
+	t.Run("All Nodes Failing", func(t *testing.T) {
+		primary := &stubClient{err: http.HTTPError{Code: 500}}
+		secondary := &stubClient{err: http.HTTPError{Code: 503}}
+		client, err := NewClient([]access.Client{primary, secondary})
+		require.NoError(t, err)
+
+		_, err = client.GetBlockByHeight(ctx, 10)
+		assert.Equal(t, secondary.err, err)
+
+		// nodes in cooldown are still tried when no node is healthy
+		_, err = client.GetBlockByHeight(ctx, 10)
+		assert.Error(t, err)
+		assert.Equal(t, 2, primary.calls)
+		assert.Equal(t, 2, secondary.calls)
+	})
+
+	t.Run("No Clients", func(t *testing.T) {
+		_, err := NewClient(nil)
+		assert.Error(t, err)
+	})
+}
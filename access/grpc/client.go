@@ -27,12 +27,15 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/flow-go-sdk"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/onflow/flow-go-sdk/access"
 )
 
 const EmulatorHost = "127.0.0.1:3569"
@@ -40,30 +43,114 @@ const TestnetHost = "access.devnet.nodes.onflow.org:9000"
 const CanarynetHost = "access.canary.nodes.onflow.org:9000"
 const MainnetHost = "access.mainnet.nodes.onflow.org:9000"
 
+// ChainHost returns the predefined host of the network with the provided chain ID.
+func ChainHost(chainID flow.ChainID) (string, error) {
+	switch chainID {
+	case flow.Mainnet:
+		return MainnetHost, nil
+	case flow.Testnet:
+		return TestnetHost, nil
+	case flow.Canary:
+		return CanarynetHost, nil
+	case flow.Emulator:
+		return EmulatorHost, nil
+	default:
+		return "", fmt.Errorf("no predefined host for chain ID %s", chainID)
+	}
+}
+
+// NewClientForChain creates a gRPC client connected to the predefined host of the network with the provided chain ID.
+func NewClientForChain(chainID flow.ChainID, opts ...grpc.DialOption) (*Client, error) {
+	host, err := ChainHost(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(host, opts...)
+}
+
 // NewClient creates an gRPC client exposing all the common access APIs.
 // Client will use provided host for connection.
+//
+// The connection is insecure and accepts messages up to DefaultMaxMessageSize by default,
+// the provided dial options are applied after the defaults and can override them.
 func NewClient(host string, opts ...grpc.DialOption) (*Client, error) {
-	var client *BaseClient
-	var err error
-	if len(opts) > 0 {
-		client, err = NewBaseClient(host, opts...)
-	} else {
-		client, err = NewBaseClient(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	}
+	client, err := NewBaseClient(host, append(defaultDialOptions(), opts...)...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Client{client}, nil
+	return &Client{grpc: client}, nil
 }
 
 // Client implements all common gRPC methods providing a network agnostic API.
 type Client struct {
 	grpc *BaseClient
+
+	chainIDMu       sync.Mutex
+	chainID         flow.ChainID
+	expectedChainID flow.ChainID
+
+	blockTimestampsOnce sync.Once
+	blockTimestamps     *access.BlockTimestampIndex
+}
+
+var _ access.Client = (*Client)(nil)
+var _ access.FinalizedScriptClient = (*Client)(nil)
+var _ access.AccountAtBlockIDClient = (*Client)(nil)
+
+// SetExpectedChainID makes the client fail with an access.ChainIDMismatchError when the access node
+// serves another network than the expected chain ID.
+//
+// The chain ID of the access node is checked by Ping and before sending the first transaction, so
+// transactions signed for a network are never sent to an access node of another network.
+func (c *Client) SetExpectedChainID(chainID flow.ChainID) {
+	c.chainIDMu.Lock()
+	defer c.chainIDMu.Unlock()
+	c.expectedChainID = chainID
+}
+
+// GetChainID returns the chain ID of the network served by the access node.
+//
+// The chain ID is requested on first use and cached afterwards.
+func (c *Client) GetChainID(ctx context.Context) (flow.ChainID, error) {
+	c.chainIDMu.Lock()
+	defer c.chainIDMu.Unlock()
+
+	if c.chainID == "" {
+		chainID, err := c.grpc.GetChainID(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.chainID = chainID
+	}
+
+	return c.chainID, nil
+}
+
+// checkChainID checks the access node serves the expected network, if any.
+func (c *Client) checkChainID(ctx context.Context) error {
+	c.chainIDMu.Lock()
+	expected := c.expectedChainID
+	c.chainIDMu.Unlock()
+
+	if expected == "" {
+		return nil
+	}
+
+	chainID, err := c.GetChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	return access.CheckChainID(expected, chainID)
 }
 
 func (c *Client) Ping(ctx context.Context) error {
-	return c.grpc.Ping(ctx)
+	if err := c.grpc.Ping(ctx); err != nil {
+		return err
+	}
+
+	return c.checkChainID(ctx)
 }
 
 func (c *Client) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
@@ -95,6 +182,10 @@ func (c *Client) GetCollection(ctx context.Context, colID flow.Identifier) (*flo
 }
 
 func (c *Client) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	if err := c.checkChainID(ctx); err != nil {
+		return err
+	}
+
 	return c.grpc.SendTransaction(ctx, tx)
 }
 
@@ -106,6 +197,24 @@ func (c *Client) GetTransactionResult(ctx context.Context, txID flow.Identifier)
 	return c.grpc.GetTransactionResult(ctx, txID)
 }
 
+func (c *Client) GetTransactionsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*flow.Transaction, error) {
+	return c.grpc.GetTransactionsByBlockID(ctx, blockID)
+}
+
+func (c *Client) GetTransactionResultsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*flow.TransactionResult, error) {
+	return c.grpc.GetTransactionResultsByBlockID(ctx, blockID)
+}
+
+// GetSystemTransaction gets the system chunk transaction of the block with the given ID.
+func (c *Client) GetSystemTransaction(ctx context.Context, blockID flow.Identifier) (*flow.Transaction, error) {
+	return c.grpc.GetSystemTransaction(ctx, blockID)
+}
+
+// GetSystemTransactionResult gets the result of the system chunk transaction of the block with the given ID.
+func (c *Client) GetSystemTransactionResult(ctx context.Context, blockID flow.Identifier) (*flow.TransactionResult, error) {
+	return c.grpc.GetSystemTransactionResult(ctx, blockID)
+}
+
 func (c *Client) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
 	return c.grpc.GetAccount(ctx, address)
 }
@@ -118,10 +227,29 @@ func (c *Client) GetAccountAtBlockHeight(ctx context.Context, address flow.Addre
 	return c.grpc.GetAccountAtBlockHeight(ctx, address, blockHeight)
 }
 
+func (c *Client) GetAccountAtBlockID(ctx context.Context, address flow.Address, blockID flow.Identifier) (*flow.Account, error) {
+	return c.grpc.GetAccountAtBlockID(ctx, address, blockID)
+}
+
 func (c *Client) ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
 	return c.grpc.ExecuteScriptAtLatestBlock(ctx, script, arguments)
 }
 
+// ExecuteScriptAtLatestFinalizedBlock executes the script against the state at the latest finalized block,
+// trading the guarantee of executing against sealed state for lower latency.
+func (c *Client) ExecuteScriptAtLatestFinalizedBlock(
+	ctx context.Context,
+	script []byte,
+	arguments []cadence.Value,
+) (cadence.Value, error) {
+	header, err := c.grpc.GetLatestBlockHeader(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.grpc.ExecuteScriptAtBlockID(ctx, header.ID, script, arguments)
+}
+
 func (c *Client) ExecuteScriptAtBlockID(ctx context.Context, blockID flow.Identifier, script []byte, arguments []cadence.Value) (cadence.Value, error) {
 	return c.grpc.ExecuteScriptAtBlockID(ctx, blockID, script, arguments)
 }
@@ -150,6 +278,40 @@ func (c *Client) GetExecutionResultForBlockID(ctx context.Context, blockID flow.
 	return c.grpc.GetExecutionResultForBlockID(ctx, blockID)
 }
 
+// SendAndSubscribeTransactionStatuses sends the transaction and delivers its result on the returned
+// channel every time its status changes, until the transaction is sealed or expired.
+func (c *Client) SendAndSubscribeTransactionStatuses(
+	ctx context.Context,
+	tx flow.Transaction,
+	opts ...SubscribeOption,
+) (<-chan *flow.TransactionResult, <-chan error, error) {
+	return c.grpc.SendAndSubscribeTransactionStatuses(ctx, tx, opts...)
+}
+
+// GetAccountStorage reports the storage used by the account, the values it stores and the capabilities
+// it publishes, as access.GetAccountStorage.
+func (c *Client) GetAccountStorage(ctx context.Context, address flow.Address) (*access.AccountStorage, error) {
+	return access.GetAccountStorage(ctx, c, address)
+}
+
+// GetBlockByTimestamp returns the last sealed block with a timestamp before or at the time.
+//
+// The block is found with a binary search over the block heights, and the timestamps fetched by the
+// searches are cached by the client, see access.BlockTimestampIndex.
+func (c *Client) GetBlockByTimestamp(ctx context.Context, t time.Time) (*flow.Block, error) {
+	return c.BlockTimestampIndex().GetBlockByTimestamp(ctx, t)
+}
+
+// BlockTimestampIndex returns the index used by GetBlockByTimestamp, which also translates time ranges
+// into height ranges.
+func (c *Client) BlockTimestampIndex() *access.BlockTimestampIndex {
+	c.blockTimestampsOnce.Do(func() {
+		c.blockTimestamps = access.NewBlockTimestampIndex(c)
+	})
+
+	return c.blockTimestamps
+}
+
 func (c *Client) Close() error {
 	return c.grpc.Close()
 }
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
 	"github.com/onflow/flow-go-sdk/crypto"
 )
 
@@ -91,8 +92,15 @@ func messageToAccountKey(m *entities.AccountKey) (*flow.AccountKey, error) {
 		return nil, errEmptyMessage
 	}
 
-	sigAlgo := crypto.SignatureAlgorithm(m.GetSignAlgo())
-	hashAlgo := crypto.HashAlgorithm(m.GetHashAlgo())
+	sigAlgo, err := messageToSignatureAlgorithm(m.GetSignAlgo())
+	if err != nil {
+		return nil, err
+	}
+
+	hashAlgo, err := messageToHashAlgorithm(m.GetHashAlgo())
+	if err != nil {
+		return nil, err
+	}
 
 	publicKey, err := crypto.DecodePublicKey(sigAlgo, m.GetPublicKey())
 	if err != nil {
@@ -110,6 +118,24 @@ func messageToAccountKey(m *entities.AccountKey) (*flow.AccountKey, error) {
 	}, nil
 }
 
+func messageToSignatureAlgorithm(m uint32) (crypto.SignatureAlgorithm, error) {
+	switch algo := crypto.SignatureAlgorithm(m); algo {
+	case crypto.ECDSA_P256, crypto.ECDSA_secp256k1:
+		return algo, nil
+	default:
+		return crypto.UnknownSignatureAlgorithm, fmt.Errorf("convert: unsupported signature algorithm %d", m)
+	}
+}
+
+func messageToHashAlgorithm(m uint32) (crypto.HashAlgorithm, error) {
+	switch algo := crypto.HashAlgorithm(m); algo {
+	case crypto.SHA2_256, crypto.SHA2_384, crypto.SHA3_256, crypto.SHA3_384, crypto.Keccak256:
+		return algo, nil
+	default:
+		return crypto.UnknownHashAlgorithm, fmt.Errorf("convert: unsupported hash algorithm %d", m)
+	}
+}
+
 func blockToMessage(b flow.Block) (*entities.Block, error) {
 
 	t := timestamppb.New(b.BlockHeader.Timestamp)
@@ -121,22 +147,45 @@ func blockToMessage(b flow.Block) (*entities.Block, error) {
 		Timestamp:            t,
 		CollectionGuarantees: collectionGuaranteesToMessages(b.BlockPayload.CollectionGuarantees),
 		BlockSeals:           blockSealsToMessages(b.BlockPayload.Seals),
+		BlockHeader: &entities.BlockHeader{
+			Id:                 b.BlockHeader.ID.Bytes(),
+			ParentId:           b.BlockHeader.ParentID.Bytes(),
+			Height:             b.BlockHeader.Height,
+			Timestamp:          t,
+			ParentVoterSigData: b.BlockHeader.ParentVoterSigData,
+		},
 	}, nil
 }
 
+// messageToTime converts a timestamp, which is the zero time when not set.
+func messageToTime(t *timestamppb.Timestamp) (time.Time, error) {
+	if t == nil {
+		return time.Time{}, nil
+	}
+
+	if err := t.CheckValid(); err != nil {
+		return time.Time{}, fmt.Errorf("convert: %w", err)
+	}
+
+	return t.AsTime(), nil
+}
+
 func messageToBlock(m *entities.Block) (flow.Block, error) {
-	var timestamp time.Time
-	var err error
+	if m == nil {
+		return flow.Block{}, errEmptyMessage
+	}
 
-	if m.GetTimestamp() != nil {
-		timestamp = m.GetTimestamp().AsTime()
+	timestamp, err := messageToTime(m.GetTimestamp())
+	if err != nil {
+		return flow.Block{}, err
 	}
 
 	header := &flow.BlockHeader{
-		ID:        flow.HashToID(m.GetId()),
-		ParentID:  flow.HashToID(m.GetParentId()),
-		Height:    m.GetHeight(),
-		Timestamp: timestamp,
+		ID:                 flow.HashToID(m.GetId()),
+		ParentID:           flow.HashToID(m.GetParentId()),
+		Height:             m.GetHeight(),
+		Timestamp:          timestamp,
+		ParentVoterSigData: m.GetBlockHeader().GetParentVoterSigData(),
 	}
 
 	guarantees, err := messagesToCollectionGuarantees(m.GetCollectionGuarantees())
@@ -164,10 +213,11 @@ func blockHeaderToMessage(b flow.BlockHeader) (*entities.BlockHeader, error) {
 	t := timestamppb.New(b.Timestamp)
 
 	return &entities.BlockHeader{
-		Id:        b.ID.Bytes(),
-		ParentId:  b.ParentID.Bytes(),
-		Height:    b.Height,
-		Timestamp: t,
+		Id:                 b.ID.Bytes(),
+		ParentId:           b.ParentID.Bytes(),
+		Height:             b.Height,
+		Timestamp:          t,
+		ParentVoterSigData: b.ParentVoterSigData,
 	}, nil
 }
 
@@ -176,22 +226,22 @@ func messageToBlockHeader(m *entities.BlockHeader) (flow.BlockHeader, error) {
 		return flow.BlockHeader{}, errEmptyMessage
 	}
 
-	var timestamp time.Time
-
-	if m.GetTimestamp() != nil {
-		timestamp = m.GetTimestamp().AsTime()
+	timestamp, err := messageToTime(m.GetTimestamp())
+	if err != nil {
+		return flow.BlockHeader{}, err
 	}
 
 	return flow.BlockHeader{
-		ID:        flow.HashToID(m.GetId()),
-		ParentID:  flow.HashToID(m.GetParentId()),
-		Height:    m.GetHeight(),
-		Timestamp: timestamp,
+		ID:                 flow.HashToID(m.GetId()),
+		ParentID:           flow.HashToID(m.GetParentId()),
+		Height:             m.GetHeight(),
+		Timestamp:          timestamp,
+		ParentVoterSigData: m.GetParentVoterSigData(),
 	}, nil
 }
 
 func cadenceValueToMessage(value cadence.Value) ([]byte, error) {
-	b, err := jsoncdc.Encode(value)
+	b, err := sdkcadence.EncodeJSONCDC(value)
 	if err != nil {
 		return nil, fmt.Errorf("convert: %w", err)
 	}
@@ -212,7 +262,7 @@ func cadenceValuesToMessages(values []cadence.Value) ([][]byte, error) {
 }
 
 func messageToCadenceValue(m []byte, options []jsoncdc.Option) (cadence.Value, error) {
-	v, err := jsoncdc.Decode(nil, m, options...)
+	v, err := sdkcadence.DecodeJSONCDC(m, options...)
 	if err != nil {
 		return nil, fmt.Errorf("convert: %w", err)
 	}
@@ -251,14 +301,57 @@ func messageToCollection(m *entities.Collection) (flow.Collection, error) {
 func collectionGuaranteeToMessage(g flow.CollectionGuarantee) *entities.CollectionGuarantee {
 	return &entities.CollectionGuarantee{
 		CollectionId: g.CollectionID.Bytes(),
+		SignerIds:    identifiersToMessages(g.SignerIDs),
+		Signature:    g.Signature,
 	}
 }
 
 func blockSealToMessage(g flow.BlockSeal) *entities.BlockSeal {
 	return &entities.BlockSeal{
-		BlockId:            g.BlockID.Bytes(),
-		ExecutionReceiptId: g.ExecutionReceiptID.Bytes(),
+		BlockId:                g.BlockID.Bytes(),
+		ExecutionReceiptId:     g.ExecutionReceiptID.Bytes(),
+		ResultId:               g.ResultID.Bytes(),
+		FinalState:             g.FinalState,
+		AggregatedApprovalSigs: aggregatedSignaturesToMessages(g.AggregatedApprovalSigs),
+	}
+}
+
+func aggregatedSignaturesToMessages(l []*flow.AggregatedSignature) []*entities.AggregatedSignature {
+	if len(l) == 0 {
+		return nil
+	}
+
+	results := make([]*entities.AggregatedSignature, len(l))
+	for i, item := range l {
+		results[i] = &entities.AggregatedSignature{
+			VerifierSignatures: item.VerifierSignatures,
+			SignerIds:          identifiersToMessages(item.SignerIDs),
+		}
+	}
+	return results
+}
+
+func messagesToAggregatedSignatures(l []*entities.AggregatedSignature) []*flow.AggregatedSignature {
+	if len(l) == 0 {
+		return nil
+	}
+
+	results := make([]*flow.AggregatedSignature, len(l))
+	for i, item := range l {
+		results[i] = &flow.AggregatedSignature{
+			VerifierSignatures: item.GetVerifierSignatures(),
+			SignerIDs:          optionalMessagesToIdentifiers(item.GetSignerIds()),
+		}
+	}
+	return results
+}
+
+// optionalMessagesToIdentifiers converts the identifiers, keeping an absent list nil.
+func optionalMessagesToIdentifiers(l [][]byte) []flow.Identifier {
+	if len(l) == 0 {
+		return nil
 	}
+	return messagesToIdentifiers(l)
 }
 
 func messageToCollectionGuarantee(m *entities.CollectionGuarantee) (flow.CollectionGuarantee, error) {
@@ -268,6 +361,8 @@ func messageToCollectionGuarantee(m *entities.CollectionGuarantee) (flow.Collect
 
 	return flow.CollectionGuarantee{
 		CollectionID: flow.HashToID(m.CollectionId),
+		SignerIDs:    optionalMessagesToIdentifiers(m.GetSignerIds()),
+		Signature:    m.GetSignature(),
 	}, nil
 }
 
@@ -277,8 +372,11 @@ func messageToBlockSeal(m *entities.BlockSeal) (flow.BlockSeal, error) {
 	}
 
 	return flow.BlockSeal{
-		BlockID:            flow.BytesToID(m.BlockId),
-		ExecutionReceiptID: flow.BytesToID(m.ExecutionReceiptId),
+		BlockID:                flow.BytesToID(m.BlockId),
+		ExecutionReceiptID:     flow.BytesToID(m.ExecutionReceiptId),
+		ResultID:               flow.BytesToID(m.GetResultId()),
+		FinalState:             m.GetFinalState(),
+		AggregatedApprovalSigs: messagesToAggregatedSignatures(m.GetAggregatedApprovalSigs()),
 	}, nil
 }
 
@@ -322,6 +420,104 @@ func messagesToBlockSeals(l []*entities.BlockSeal) ([]*flow.BlockSeal, error) {
 	return results, nil
 }
 
+func chunkToMessage(c flow.Chunk) *entities.Chunk {
+	return &entities.Chunk{
+		CollectionIndex:      uint32(c.CollectionIndex),
+		StartState:           identifierToMessage(flow.Identifier(c.StartState)),
+		EventCollection:      c.EventCollection,
+		BlockId:              identifierToMessage(c.BlockID),
+		TotalComputationUsed: c.TotalComputationUsed,
+		NumberOfTransactions: uint32(c.NumberOfTransactions),
+		Index:                c.Index,
+		EndState:             identifierToMessage(flow.Identifier(c.EndState)),
+	}
+}
+
+func messageToChunk(m *entities.Chunk) (flow.Chunk, error) {
+	if m == nil {
+		return flow.Chunk{}, errEmptyMessage
+	}
+
+	return flow.Chunk{
+		CollectionIndex:      uint(m.GetCollectionIndex()),
+		StartState:           flow.BytesToStateCommitment(m.GetStartState()),
+		EventCollection:      flow.BytesToHash(m.GetEventCollection()),
+		BlockID:              flow.BytesToID(m.GetBlockId()),
+		TotalComputationUsed: m.GetTotalComputationUsed(),
+		NumberOfTransactions: uint16(m.GetNumberOfTransactions()),
+		Index:                m.GetIndex(),
+		EndState:             flow.BytesToStateCommitment(m.GetEndState()),
+	}, nil
+}
+
+func serviceEventToMessage(e flow.ServiceEvent) *entities.ServiceEvent {
+	return &entities.ServiceEvent{
+		Type:    e.Type,
+		Payload: e.Payload,
+	}
+}
+
+func messageToServiceEvent(m *entities.ServiceEvent) (flow.ServiceEvent, error) {
+	if m == nil {
+		return flow.ServiceEvent{}, errEmptyMessage
+	}
+
+	return flow.ServiceEvent{
+		Type:    m.GetType(),
+		Payload: m.GetPayload(),
+	}, nil
+}
+
+func executionResultToMessage(r flow.ExecutionResult) *entities.ExecutionResult {
+	chunks := make([]*entities.Chunk, len(r.Chunks))
+	for i, chunk := range r.Chunks {
+		chunks[i] = chunkToMessage(*chunk)
+	}
+
+	serviceEvents := make([]*entities.ServiceEvent, len(r.ServiceEvents))
+	for i, serviceEvent := range r.ServiceEvents {
+		serviceEvents[i] = serviceEventToMessage(*serviceEvent)
+	}
+
+	return &entities.ExecutionResult{
+		PreviousResultId: identifierToMessage(r.PreviousResultID),
+		BlockId:          identifierToMessage(r.BlockID),
+		Chunks:           chunks,
+		ServiceEvents:    serviceEvents,
+	}
+}
+
+func messageToExecutionResult(m *entities.ExecutionResult) (flow.ExecutionResult, error) {
+	if m == nil {
+		return flow.ExecutionResult{}, errEmptyMessage
+	}
+
+	chunks := make([]*flow.Chunk, len(m.GetChunks()))
+	for i, chunkMsg := range m.GetChunks() {
+		chunk, err := messageToChunk(chunkMsg)
+		if err != nil {
+			return flow.ExecutionResult{}, fmt.Errorf("chunk %d: %w", i, err)
+		}
+		chunks[i] = &chunk
+	}
+
+	serviceEvents := make([]*flow.ServiceEvent, len(m.GetServiceEvents()))
+	for i, serviceEventMsg := range m.GetServiceEvents() {
+		serviceEvent, err := messageToServiceEvent(serviceEventMsg)
+		if err != nil {
+			return flow.ExecutionResult{}, fmt.Errorf("service event %d: %w", i, err)
+		}
+		serviceEvents[i] = &serviceEvent
+	}
+
+	return flow.ExecutionResult{
+		PreviousResultID: flow.BytesToID(m.GetPreviousResultId()),
+		BlockID:          flow.BytesToID(m.GetBlockId()),
+		Chunks:           chunks,
+		ServiceEvents:    serviceEvents,
+	}, nil
+}
+
 func eventToMessage(e flow.Event) (*entities.Event, error) {
 	payload, err := cadenceValueToMessage(e.Value)
 	if err != nil {
@@ -338,6 +534,10 @@ func eventToMessage(e flow.Event) (*entities.Event, error) {
 }
 
 func messageToEvent(m *entities.Event, options []jsoncdc.Option) (flow.Event, error) {
+	if m == nil {
+		return flow.Event{}, errEmptyMessage
+	}
+
 	value, err := messageToCadenceValue(m.GetPayload(), options)
 	if err != nil {
 		return flow.Event{}, err
@@ -345,7 +545,7 @@ func messageToEvent(m *entities.Event, options []jsoncdc.Option) (flow.Event, er
 
 	eventValue, isEvent := value.(cadence.Event)
 	if !isEvent {
-		return flow.Event{}, fmt.Errorf("convert: expected Event value, got %s", eventValue.Type().ID())
+		return flow.Event{}, fmt.Errorf("convert: expected Event value, got %T", value)
 	}
 
 	return flow.Event{
@@ -505,6 +705,14 @@ func transactionResultToMessage(result flow.TransactionResult) (*access.Transact
 }
 
 func messageToTransactionResult(m *access.TransactionResultResponse, options []jsoncdc.Option) (flow.TransactionResult, error) {
+	if m == nil {
+		return flow.TransactionResult{}, errEmptyMessage
+	}
+
+	if _, ok := entities.TransactionStatus_name[int32(m.GetStatus())]; !ok {
+		return flow.TransactionResult{}, fmt.Errorf("convert: unsupported transaction status %d", m.GetStatus())
+	}
+
 	eventMessages := m.GetEvents()
 
 	events := make([]flow.Event, len(eventMessages))
@@ -19,14 +19,19 @@
 package grpc
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/onflow/cadence"
+	"github.com/onflow/flow/protobuf/go/flow/access"
+	"github.com/onflow/flow/protobuf/go/flow/entities"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
 	"github.com/onflow/flow-go-sdk/test"
 )
 
@@ -50,6 +55,22 @@ func TestConvert_AccountKey(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, keyA, keyB)
+
+	t.Run("Unsupported hash algorithm", func(t *testing.T) {
+		msg := accountKeyToMessage(keyA)
+		msg.HashAlgo = 42
+
+		_, err := messageToAccountKey(msg)
+		assert.EqualError(t, err, "convert: unsupported hash algorithm 42")
+	})
+
+	t.Run("Unsupported signature algorithm", func(t *testing.T) {
+		msg := accountKeyToMessage(keyA)
+		msg.SignAlgo = 42
+
+		_, err := messageToAccountKey(msg)
+		assert.EqualError(t, err, "convert: unsupported signature algorithm 42")
+	})
 }
 
 func TestConvert_Block(t *testing.T) {
@@ -63,6 +84,16 @@ func TestConvert_Block(t *testing.T) {
 
 	assert.Equal(t, *blockA, blockB)
 
+	t.Run("Invalid timestamp", func(t *testing.T) {
+		msg, err := blockToMessage(*blockA)
+		require.NoError(t, err)
+
+		msg.Timestamp.Nanos = -1
+
+		_, err = messageToBlock(msg)
+		assert.Error(t, err)
+	})
+
 	t.Run("Without timestamp", func(t *testing.T) {
 		blockA := test.BlockGenerator().New()
 
@@ -117,6 +148,22 @@ func TestConvert_CadenceValue(t *testing.T) {
 		assert.Equal(t, valueA, valueB)
 	})
 
+	t.Run("Capability", func(t *testing.T) {
+		valueA := cadence.NewCapability(
+			cadence.Path{Domain: "public", Identifier: "flowTokenReceiver"},
+			cadence.BytesToAddress([]byte{1}),
+			cadence.ReferenceType{Type: cadence.AnyStructType{}},
+		)
+
+		msg, err := cadenceValueToMessage(valueA)
+		require.NoError(t, err)
+
+		valueB, err := messageToCadenceValue(msg, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, valueA, valueB)
+	})
+
 	t.Run("Invalid message", func(t *testing.T) {
 		msg := []byte("invalid JSON-CDC bytes")
 
@@ -124,6 +171,16 @@ func TestConvert_CadenceValue(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, value)
 	})
+
+	t.Run("Unsupported value", func(t *testing.T) {
+		msg := []byte(`{"type":"InclusiveRange","value":{"start":{"type":"Int","value":"1"},"end":{"type":"Int","value":"2"},"step":{"type":"Int","value":"1"}}}`)
+
+		_, err := messageToCadenceValue(msg, nil)
+
+		var unsupported *sdkcadence.UnsupportedValueError
+		require.True(t, errors.As(err, &unsupported))
+		assert.Equal(t, "InclusiveRange", unsupported.Kind)
+	})
 }
 
 func TestConvert_Collection(t *testing.T) {
@@ -265,4 +322,104 @@ func TestConvert_TransactionResult(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, resultA, resultB)
+
+	t.Run("Unsupported status", func(t *testing.T) {
+		msg, err := transactionResultToMessage(resultA)
+		require.NoError(t, err)
+		msg.Status = 42
+
+		_, err = messageToTransactionResult(msg, nil)
+		assert.EqualError(t, err, "convert: unsupported transaction status 42")
+	})
+}
+
+func TestConvert_ExecutionResult(t *testing.T) {
+	resultA := test.ExecutionResultGenerator().New()
+
+	msg := executionResultToMessage(*resultA)
+
+	resultB, err := messageToExecutionResult(msg)
+	require.NoError(t, err)
+
+	assert.Equal(t, *resultA, resultB)
+
+	t.Run("Empty message", func(t *testing.T) {
+		_, err := messageToExecutionResult(nil)
+		assert.ErrorIs(t, err, errEmptyMessage)
+	})
+
+	t.Run("Empty chunk", func(t *testing.T) {
+		msg := executionResultToMessage(*resultA)
+		msg.Chunks[1] = nil
+
+		_, err := messageToExecutionResult(msg)
+		assert.ErrorIs(t, err, errEmptyMessage)
+	})
+}
+
+// fuzzMessageConversion adds the encoding of the seed message to the corpus and checks that
+// converting arbitrary messages never panics.
+func fuzzMessageConversion[T proto.Message](f *testing.F, seed T, newMessage func() T, convert func(T) error) {
+	encoded, err := proto.Marshal(seed)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(encoded)
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m := newMessage()
+		if proto.Unmarshal(data, m) != nil {
+			return
+		}
+		_ = convert(m)
+	})
+}
+
+func FuzzMessageToBlock(f *testing.F) {
+	seed, err := blockToMessage(*test.BlockGenerator().New())
+	require.NoError(f, err)
+
+	fuzzMessageConversion(f, seed, func() *entities.Block { return &entities.Block{} }, func(m *entities.Block) error {
+		_, err := messageToBlock(m)
+		return err
+	})
+}
+
+func FuzzMessageToAccount(f *testing.F) {
+	seed := accountToMessage(*test.AccountGenerator().New())
+
+	fuzzMessageConversion(f, seed, func() *entities.Account { return &entities.Account{} }, func(m *entities.Account) error {
+		_, err := messageToAccount(m)
+		return err
+	})
+}
+
+func FuzzMessageToTransaction(f *testing.F) {
+	seed, err := transactionToMessage(*test.TransactionGenerator().New())
+	require.NoError(f, err)
+
+	fuzzMessageConversion(f, seed, func() *entities.Transaction { return &entities.Transaction{} }, func(m *entities.Transaction) error {
+		_, err := messageToTransaction(m)
+		return err
+	})
+}
+
+func FuzzMessageToTransactionResult(f *testing.F) {
+	seed, err := transactionResultToMessage(test.TransactionResultGenerator().New())
+	require.NoError(f, err)
+
+	fuzzMessageConversion(f, seed, func() *access.TransactionResultResponse { return &access.TransactionResultResponse{} }, func(m *access.TransactionResultResponse) error {
+		_, err := messageToTransactionResult(m, nil)
+		return err
+	})
+}
+
+func FuzzMessageToExecutionResult(f *testing.F) {
+	seed := executionResultToMessage(*test.ExecutionResultGenerator().New())
+
+	fuzzMessageConversion(f, seed, func() *entities.ExecutionResult { return &entities.ExecutionResult{} }, func(m *entities.ExecutionResult) error {
+		_, err := messageToExecutionResult(m)
+		return err
+	})
 }
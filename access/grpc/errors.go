@@ -20,8 +20,13 @@ package grpc
 
 import (
 	"fmt"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go-sdk/access"
 )
 
 const errorMessagePrefix = "client: "
@@ -49,6 +54,44 @@ func (e RPCError) Unwrap() error {
 	return e.GRPCErr
 }
 
+// errorsByCode are the transport independent errors matched by the gRPC status codes.
+var errorsByCode = map[codes.Code]error{
+	codes.NotFound:          access.ErrNotFound,
+	codes.InvalidArgument:   access.ErrInvalidArgument,
+	codes.ResourceExhausted: access.ErrRateLimited,
+	codes.Unavailable:       access.ErrUnavailable,
+	codes.OutOfRange:        access.ErrOutOfRange,
+}
+
+// Is reports whether the error matches one of the transport independent errors of the access
+// package, such as access.ErrNotFound, based on its gRPC status code.
+func (e RPCError) Is(target error) bool {
+	s, ok := status.FromError(e.GRPCErr)
+	if !ok {
+		return false
+	}
+
+	err, ok := errorsByCode[s.Code()]
+	return ok && err == target
+}
+
+// RetryAfter returns the retry delay of the retry info attached to the gRPC status,
+// or 0 if the status has no retry info.
+func (e RPCError) RetryAfter() time.Duration {
+	s, ok := status.FromError(e.GRPCErr)
+	if !ok {
+		return 0
+	}
+
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return info.GetRetryDelay().AsDuration()
+		}
+	}
+
+	return 0
+}
+
 // GRPCStatus returns the gRPC status for this error.
 //
 // This function satisfies the interface defined in the status.FromError function.
@@ -66,6 +109,7 @@ const (
 	entityAccount           = "flow.Account"
 	entityEvent             = "flow.Event"
 	entityCadenceValue      = "cadence.Value"
+	entityExecutionResult   = "flow.ExecutionResult"
 )
 
 // An EntityToMessageError indicates that an entity could not be converted to a protobuf message.
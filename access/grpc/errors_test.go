@@ -0,0 +1,94 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+func TestRPCError_Is(t *testing.T) {
+	tests := []struct {
+		code     codes.Code
+		expected error
+	}{
+		{codes.NotFound, access.ErrNotFound},
+		{codes.InvalidArgument, access.ErrInvalidArgument},
+		{codes.ResourceExhausted, access.ErrRateLimited},
+		{codes.Unavailable, access.ErrUnavailable},
+		{codes.OutOfRange, access.ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			err := newRPCError(status.Error(tt.code, "failed"))
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Equal(t, tt.code, status.Code(err))
+
+			for _, other := range tests {
+				if other.code != tt.code {
+					assert.False(t, errors.Is(err, other.expected))
+				}
+			}
+		})
+	}
+
+	t.Run("Unmapped code", func(t *testing.T) {
+		err := newRPCError(status.Error(codes.Internal, "failed"))
+
+		assert.False(t, errors.Is(err, access.ErrNotFound))
+		assert.False(t, errors.Is(err, access.ErrUnavailable))
+	})
+
+	t.Run("Wrapped", func(t *testing.T) {
+		err := newMessageToEntityError(entityBlock, newRPCError(status.Error(codes.NotFound, "failed")))
+
+		assert.ErrorIs(t, err, access.ErrNotFound)
+	})
+}
+
+func TestRPCError_RetryAfter(t *testing.T) {
+	s, err := status.New(codes.ResourceExhausted, "rate limited").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(2 * time.Second),
+	})
+	require.NoError(t, err)
+
+	rpcErr := newRPCError(s.Err())
+	assert.Equal(t, 2*time.Second, rpcErr.RetryAfter())
+	assert.Equal(t, 2*time.Second, access.RetryAfter(rpcErr))
+	assert.True(t, access.IsRetryable(rpcErr))
+
+	t.Run("Without retry info", func(t *testing.T) {
+		rpcErr := newRPCError(status.Error(codes.NotFound, "not found"))
+
+		assert.Zero(t, rpcErr.RetryAfter())
+		assert.False(t, access.IsRetryable(rpcErr))
+	})
+}
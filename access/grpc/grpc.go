@@ -22,6 +22,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/encoding/json"
@@ -84,6 +85,16 @@ func (c *BaseClient) Ping(ctx context.Context, opts ...grpc.CallOption) error {
 	return err
 }
 
+// GetChainID requests the chain ID of the network served by the access node.
+func (c *BaseClient) GetChainID(ctx context.Context, opts ...grpc.CallOption) (flow.ChainID, error) {
+	res, err := c.rpcClient.GetNetworkParameters(ctx, &access.GetNetworkParametersRequest{}, opts...)
+	if err != nil {
+		return "", newRPCError(err)
+	}
+
+	return flow.ChainID(res.GetChainId()), nil
+}
+
 func (c *BaseClient) GetLatestBlockHeader(
 	ctx context.Context,
 	isSealed bool,
@@ -293,6 +304,97 @@ func (c *BaseClient) GetTransactionResult(
 	return &result, nil
 }
 
+func (c *BaseClient) GetTransactionsByBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+	opts ...grpc.CallOption,
+) ([]*flow.Transaction, error) {
+	req := &access.GetTransactionsByBlockIDRequest{
+		BlockId: blockID.Bytes(),
+	}
+
+	res, err := c.rpcClient.GetTransactionsByBlockID(ctx, req, opts...)
+	if err != nil {
+		return nil, newRPCError(err)
+	}
+
+	transactions := make([]*flow.Transaction, len(res.GetTransactions()))
+	for i, m := range res.GetTransactions() {
+		tx, err := messageToTransaction(m)
+		if err != nil {
+			return nil, newMessageToEntityError(entityTransaction, err)
+		}
+		transactions[i] = &tx
+	}
+
+	return transactions, nil
+}
+
+func (c *BaseClient) GetTransactionResultsByBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+	opts ...grpc.CallOption,
+) ([]*flow.TransactionResult, error) {
+	req := &access.GetTransactionsByBlockIDRequest{
+		BlockId: blockID.Bytes(),
+	}
+
+	res, err := c.rpcClient.GetTransactionResultsByBlockID(ctx, req, opts...)
+	if err != nil {
+		return nil, newRPCError(err)
+	}
+
+	results := make([]*flow.TransactionResult, len(res.GetTransactionResults()))
+	for i, m := range res.GetTransactionResults() {
+		result, err := messageToTransactionResult(m, c.jsonOptions)
+		if err != nil {
+			return nil, newMessageToEntityError(entityTransactionResult, err)
+		}
+		results[i] = &result
+	}
+
+	return results, nil
+}
+
+// GetSystemTransaction gets the system chunk transaction executed at the end of the block with the given ID.
+//
+// The access node returns the system chunk transaction as the last transaction of the block.
+func (c *BaseClient) GetSystemTransaction(
+	ctx context.Context,
+	blockID flow.Identifier,
+	opts ...grpc.CallOption,
+) (*flow.Transaction, error) {
+	transactions, err := c.GetTransactionsByBlockID(ctx, blockID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transactions) == 0 {
+		return nil, fmt.Errorf("no system transaction found for block %s", blockID)
+	}
+
+	return transactions[len(transactions)-1], nil
+}
+
+// GetSystemTransactionResult gets the result of the system chunk transaction executed at the end
+// of the block with the given ID, including the service events it emitted.
+func (c *BaseClient) GetSystemTransactionResult(
+	ctx context.Context,
+	blockID flow.Identifier,
+	opts ...grpc.CallOption,
+) (*flow.TransactionResult, error) {
+	results, err := c.GetTransactionResultsByBlockID(ctx, blockID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no system transaction result found for block %s", blockID)
+	}
+
+	return results[len(results)-1], nil
+}
+
 func (c *BaseClient) GetAccount(ctx context.Context, address flow.Address, opts ...grpc.CallOption) (*flow.Account, error) {
 	return c.GetAccountAtLatestBlock(ctx, address, opts...)
 }
@@ -343,6 +445,24 @@ func (c *BaseClient) GetAccountAtBlockHeight(
 	return &account, nil
 }
 
+// GetAccountAtBlockID gets an account by address at the block with the given ID.
+//
+// The access API doesn't support querying accounts by block ID, the block height
+// is resolved with an additional request before getting the account.
+func (c *BaseClient) GetAccountAtBlockID(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+	opts ...grpc.CallOption,
+) (*flow.Account, error) {
+	header, err := c.GetBlockHeaderByID(ctx, blockID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetAccountAtBlockHeight(ctx, address, header.Height, opts...)
+}
+
 func (c *BaseClient) ExecuteScriptAtLatestBlock(
 	ctx context.Context,
 	script []byte,
@@ -497,7 +617,11 @@ func getEventsResult(res *access.EventsResponse, options []json.Option) ([]flow.
 			events[i] = evt
 		}
 
-		blockTimestamp := result.BlockTimestamp.AsTime()
+		blockTimestamp, err := messageToTime(result.GetBlockTimestamp())
+		if err != nil {
+			return nil, newMessageToEntityError(entityEvent, err)
+		}
+
 		results[i] = flow.BlockEvents{
 			BlockID:        flow.HashToID(result.GetBlockId()),
 			Height:         result.GetBlockHeight(),
@@ -526,33 +650,10 @@ func (c *BaseClient) GetExecutionResultForBlockID(ctx context.Context, blockID f
 		return nil, newRPCError(err)
 	}
 
-	chunks := make([]*flow.Chunk, len(er.ExecutionResult.Chunks))
-	serviceEvents := make([]*flow.ServiceEvent, len(er.ExecutionResult.ServiceEvents))
-
-	for i, chunk := range er.ExecutionResult.Chunks {
-		chunks[i] = &flow.Chunk{
-			CollectionIndex:      uint(chunk.CollectionIndex),
-			StartState:           flow.BytesToStateCommitment(chunk.StartState),
-			EventCollection:      flow.BytesToHash(chunk.EventCollection),
-			BlockID:              flow.BytesToID(chunk.BlockId),
-			TotalComputationUsed: chunk.TotalComputationUsed,
-			NumberOfTransactions: uint16(chunk.NumberOfTransactions),
-			Index:                chunk.Index,
-			EndState:             flow.BytesToStateCommitment(chunk.EndState),
-		}
-	}
-
-	for i, serviceEvent := range er.ExecutionResult.ServiceEvents {
-		serviceEvents[i] = &flow.ServiceEvent{
-			Type:    serviceEvent.Type,
-			Payload: serviceEvent.Payload,
-		}
+	result, err := messageToExecutionResult(er.GetExecutionResult())
+	if err != nil {
+		return nil, newMessageToEntityError(entityExecutionResult, err)
 	}
 
-	return &flow.ExecutionResult{
-		PreviousResultID: flow.BytesToID(er.ExecutionResult.PreviousResultId),
-		BlockID:          flow.BytesToID(er.ExecutionResult.BlockId),
-		Chunks:           chunks,
-		ServiceEvents:    serviceEvents,
-	}, nil
+	return &result, nil
 }
@@ -22,6 +22,7 @@ import (
 	"context"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/onflow/cadence"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
@@ -35,6 +36,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/onflow/flow-go-sdk"
+	sdkaccess "github.com/onflow/flow-go-sdk/access"
 	"github.com/onflow/flow-go-sdk/test"
 )
 
@@ -75,6 +77,56 @@ func TestClient_Ping(t *testing.T) {
 	}))
 }
 
+func TestClient_GetChainID(t *testing.T) {
+	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		response := &access.GetNetworkParametersResponse{ChainId: "flow-testnet"}
+
+		rpc.On("GetNetworkParameters", ctx, mock.Anything).Return(response, nil)
+
+		chainID, err := c.GetChainID(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, flow.Testnet, chainID)
+	}))
+
+	t.Run("Internal error", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		rpc.On("GetNetworkParameters", ctx, mock.Anything).
+			Return(nil, errInternal)
+
+		_, err := c.GetChainID(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	}))
+
+	t.Run("Cached", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		response := &access.GetNetworkParametersResponse{ChainId: "flow-testnet"}
+
+		rpc.On("GetNetworkParameters", ctx, mock.Anything).Return(response, nil).Once()
+
+		client := &Client{grpc: c}
+		for i := 0; i < 2; i++ {
+			chainID, err := client.GetChainID(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, flow.Testnet, chainID)
+		}
+	}))
+
+	t.Run("Mismatch", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		rpc.On("Ping", ctx, mock.Anything).Return(&access.PingResponse{}, nil)
+		rpc.On("GetNetworkParameters", ctx, mock.Anything).
+			Return(&access.GetNetworkParametersResponse{ChainId: "flow-mainnet"}, nil)
+
+		client := &Client{grpc: c}
+		client.SetExpectedChainID(flow.Testnet)
+
+		err := client.Ping(ctx)
+		assert.ErrorIs(t, err, sdkaccess.ErrChainIDMismatch)
+
+		err = client.SendTransaction(ctx, *test.TransactionGenerator().New())
+		assert.ErrorIs(t, err, sdkaccess.ErrChainIDMismatch)
+		rpc.AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+	}))
+}
+
 func TestClient_GetLatestBlockHeader(t *testing.T) {
 	blocks := test.BlockGenerator()
 
@@ -402,6 +454,175 @@ func TestClient_GetTransactionResult(t *testing.T) {
 	}))
 }
 
+func TestClient_GetSystemTransaction(t *testing.T) {
+	transactions := test.TransactionGenerator()
+	ids := test.IdentifierGenerator()
+
+	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		blockID := ids.New()
+		userTx := transactions.New()
+		systemTx := transactions.New()
+
+		userMsg, err := transactionToMessage(*userTx)
+		require.NoError(t, err)
+		systemMsg, err := transactionToMessage(*systemTx)
+		require.NoError(t, err)
+
+		rpc.On("GetTransactionsByBlockID", ctx, &access.GetTransactionsByBlockIDRequest{BlockId: blockID.Bytes()}).
+			Return(&access.TransactionsResponse{Transactions: []*entities.Transaction{userMsg, systemMsg}}, nil)
+
+		tx, err := c.GetSystemTransaction(ctx, blockID)
+		require.NoError(t, err)
+
+		assert.Equal(t, systemTx.ID(), tx.ID())
+	}))
+
+	t.Run("Empty block", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		blockID := ids.New()
+
+		rpc.On("GetTransactionsByBlockID", ctx, mock.Anything).
+			Return(&access.TransactionsResponse{}, nil)
+
+		tx, err := c.GetSystemTransaction(ctx, blockID)
+		assert.Error(t, err)
+		assert.Nil(t, tx)
+	}))
+
+	t.Run("Not found error", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		blockID := ids.New()
+
+		rpc.On("GetTransactionsByBlockID", ctx, mock.Anything).
+			Return(nil, errNotFound)
+
+		tx, err := c.GetSystemTransaction(ctx, blockID)
+		assert.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, tx)
+	}))
+}
+
+func TestClient_GetSystemTransactionResult(t *testing.T) {
+	results := test.TransactionResultGenerator()
+	ids := test.IdentifierGenerator()
+
+	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		blockID := ids.New()
+		userResult := results.New()
+		systemResult := results.New()
+
+		userMsg, err := transactionResultToMessage(userResult)
+		require.NoError(t, err)
+		systemMsg, err := transactionResultToMessage(systemResult)
+		require.NoError(t, err)
+
+		rpc.On("GetTransactionResultsByBlockID", ctx, &access.GetTransactionsByBlockIDRequest{BlockId: blockID.Bytes()}).
+			Return(&access.TransactionResultsResponse{
+				TransactionResults: []*access.TransactionResultResponse{userMsg, systemMsg},
+			}, nil)
+
+		result, err := c.GetSystemTransactionResult(ctx, blockID)
+		require.NoError(t, err)
+
+		assert.Equal(t, systemResult, *result)
+	}))
+
+	t.Run("Empty block", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		blockID := ids.New()
+
+		rpc.On("GetTransactionResultsByBlockID", ctx, mock.Anything).
+			Return(&access.TransactionResultsResponse{}, nil)
+
+		result, err := c.GetSystemTransactionResult(ctx, blockID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	}))
+}
+
+func TestClient_SendAndSubscribeTransactionStatuses(t *testing.T) {
+	transactions := test.TransactionGenerator()
+	results := test.TransactionResultGenerator()
+
+	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		tx := transactions.New()
+
+		rpc.On("SendTransaction", ctx, mock.Anything).
+			Return(&access.SendTransactionResponse{Id: tx.ID().Bytes()}, nil)
+
+		for _, s := range []flow.TransactionStatus{
+			flow.TransactionStatusPending,
+			flow.TransactionStatusPending,
+			flow.TransactionStatusExecuted,
+			flow.TransactionStatusSealed,
+		} {
+			result := results.New()
+			result.Status = s
+			response, err := transactionResultToMessage(result)
+			require.NoError(t, err)
+
+			rpc.On("GetTransactionResult", ctx, &access.GetTransactionRequest{Id: tx.ID().Bytes()}).
+				Return(response, nil).
+				Once()
+		}
+
+		statuses, errs, err := c.SendAndSubscribeTransactionStatuses(ctx, *tx, WithPollInterval(time.Millisecond))
+		require.NoError(t, err)
+
+		var received []flow.TransactionStatus
+		for result := range statuses {
+			received = append(received, result.Status)
+		}
+		assert.NoError(t, <-errs)
+
+		assert.Equal(t, []flow.TransactionStatus{
+			flow.TransactionStatusPending,
+			flow.TransactionStatusExecuted,
+			flow.TransactionStatusSealed,
+		}, received)
+	}))
+
+	t.Run("Not found yet", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		tx := transactions.New()
+
+		rpc.On("SendTransaction", ctx, mock.Anything).
+			Return(&access.SendTransactionResponse{Id: tx.ID().Bytes()}, nil)
+
+		rpc.On("GetTransactionResult", ctx, &access.GetTransactionRequest{Id: tx.ID().Bytes()}).
+			Return(nil, errNotFound).
+			Twice()
+
+		result := results.New()
+		result.Status = flow.TransactionStatusSealed
+		response, err := transactionResultToMessage(result)
+		require.NoError(t, err)
+
+		rpc.On("GetTransactionResult", ctx, &access.GetTransactionRequest{Id: tx.ID().Bytes()}).
+			Return(response, nil).
+			Once()
+
+		statuses, errs, err := c.SendAndSubscribeTransactionStatuses(ctx, *tx, WithPollInterval(time.Millisecond))
+		require.NoError(t, err)
+
+		var received []flow.TransactionStatus
+		for result := range statuses {
+			received = append(received, result.Status)
+		}
+		assert.NoError(t, <-errs)
+
+		assert.Equal(t, []flow.TransactionStatus{
+			flow.TransactionStatusPending,
+			flow.TransactionStatusSealed,
+		}, received)
+	}))
+
+	t.Run("Send error", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		rpc.On("SendTransaction", ctx, mock.Anything).
+			Return(nil, errInternal)
+
+		_, _, err := c.SendAndSubscribeTransactionStatuses(ctx, *transactions.New())
+		assert.Equal(t, codes.Internal, status.Code(err))
+	}))
+}
+
 func TestClient_GetAccountAtLatestBlock(t *testing.T) {
 	accounts := test.AccountGenerator()
 	addresses := test.AddressGenerator()
@@ -465,6 +686,42 @@ func TestClient_GetAccountAtBlockHeight(t *testing.T) {
 	}))
 }
 
+func TestClient_GetAccountAtBlockID(t *testing.T) {
+	accounts := test.AccountGenerator()
+	blocks := test.BlockGenerator()
+
+	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		expectedAccount := accounts.New()
+		header := blocks.New().BlockHeader
+
+		b, err := blockHeaderToMessage(header)
+		require.NoError(t, err)
+
+		rpc.On("GetBlockHeaderByID", ctx, &access.GetBlockHeaderByIDRequest{Id: header.ID.Bytes()}).
+			Return(&access.BlockHeaderResponse{Block: b}, nil)
+
+		rpc.On("GetAccountAtBlockHeight", ctx, &access.GetAccountAtBlockHeightRequest{
+			Address:     expectedAccount.Address.Bytes(),
+			BlockHeight: header.Height,
+		}).Return(&access.AccountResponse{Account: accountToMessage(*expectedAccount)}, nil)
+
+		account, err := c.GetAccountAtBlockID(ctx, expectedAccount.Address, header.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedAccount, account)
+	}))
+
+	t.Run("Block not found error", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		rpc.On("GetBlockHeaderByID", ctx, mock.Anything).
+			Return(nil, errNotFound)
+
+		account, err := c.GetAccountAtBlockID(ctx, accounts.New().Address, blocks.New().ID)
+		assert.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, account)
+	}))
+}
+
 func TestClient_ExecuteScriptAtLatestBlock(t *testing.T) {
 	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
 		expectedValue := cadence.NewInt(42)
@@ -582,6 +839,32 @@ func TestClient_ExecuteScriptAtBlockID(t *testing.T) {
 	}))
 }
 
+func TestClient_ExecuteScriptAtLatestFinalizedBlock(t *testing.T) {
+	blocks := test.BlockGenerator()
+
+	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
+		header := blocks.New().BlockHeader
+
+		b, err := blockHeaderToMessage(header)
+		require.NoError(t, err)
+
+		expectedValue := cadence.NewInt(42)
+		encodedValue, err := jsoncdc.Encode(expectedValue)
+		require.NoError(t, err)
+
+		rpc.On("GetLatestBlockHeader", ctx, &access.GetLatestBlockHeaderRequest{IsSealed: false}).
+			Return(&access.BlockHeaderResponse{Block: b}, nil)
+		rpc.On("ExecuteScriptAtBlockID", ctx, mock.MatchedBy(func(req *access.ExecuteScriptAtBlockIDRequest) bool {
+			return flow.HashToID(req.GetBlockId()) == header.ID
+		})).Return(&access.ExecuteScriptResponse{Value: encodedValue}, nil)
+
+		client := &Client{grpc: c}
+		value, err := client.ExecuteScriptAtLatestFinalizedBlock(ctx, []byte("foo"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, expectedValue, value)
+	}))
+}
+
 func TestClient_ExecuteScriptAtBlockHeight(t *testing.T) {
 	t.Run("Success", clientTest(func(t *testing.T, ctx context.Context, rpc *MockRPCClient, c *BaseClient) {
 		expectedValue := cadence.NewInt(42)
@@ -0,0 +1,74 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// AnsweringNode is the access node which answered a call, see RecordAnsweringNode.
+type AnsweringNode struct {
+	// Host is the host the call was made to, such as the host of the pool connection used for the call.
+	Host string
+	// Address is the network address of the access node.
+	Address net.Addr
+}
+
+type answeringNodeKey struct{}
+
+// RecordAnsweringNode returns a context recording the access node answering the calls made with it
+// into node, which is overwritten by each call.
+//
+// Knowing which access node answered helps debugging stale state discrepancies between access nodes,
+// for example when scripts are executed by access nodes against their local state. The node is
+// recorded by the clients created with NewClient and NewPoolClient.
+//
+//	var node grpc.AnsweringNode
+//	value, err := client.ExecuteScriptAtLatestBlock(grpc.RecordAnsweringNode(ctx, &node), script, nil)
+func RecordAnsweringNode(ctx context.Context, node *AnsweringNode) context.Context {
+	return context.WithValue(ctx, answeringNodeKey{}, node)
+}
+
+// answeringNodeInterceptor records the access node answering the calls made with a context returned by
+// RecordAnsweringNode.
+func answeringNodeInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	node, ok := ctx.Value(answeringNodeKey{}).(*AnsweringNode)
+	if !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	var p peer.Peer
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Peer(&p))...)
+
+	node.Host = cc.Target()
+	node.Address = p.Addr
+
+	return err
+}
@@ -0,0 +1,157 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// DefaultMaxMessageSize is the default maximum size of the messages sent and received by the client.
+//
+// It is larger than the gRPC default of 4 MiB since script results and full blocks can exceed it.
+const DefaultMaxMessageSize = 20 * 1024 * 1024
+
+// WithMaxMessageSize sets the maximum size in bytes of the messages sent and received by the client.
+func WithMaxMessageSize(size int) grpc.DialOption {
+	return grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(size),
+		grpc.MaxCallSendMsgSize(size),
+	)
+}
+
+// WithKeepalive makes the client ping the access node after interval without activity to keep the
+// connection alive, closing the connection if the ping is not acknowledged within timeout.
+//
+// Access nodes reject pings sent more often than their enforcement policy allows, which is usually
+// once every few minutes.
+func WithKeepalive(interval time.Duration, timeout time.Duration) grpc.DialOption {
+	return grpc.WithKeepaliveParams(keepalive.ClientParameters{
+		Time:                interval,
+		Timeout:             timeout,
+		PermitWithoutStream: true,
+	})
+}
+
+// WithTLS secures the connection to the access node with TLS using the provided configuration.
+func WithTLS(config *tls.Config) grpc.DialOption {
+	return grpc.WithTransportCredentials(credentials.NewTLS(config))
+}
+
+// WithSystemTLS secures the connection to the access node with TLS, verifying the access node
+// certificate with the system root certificates.
+func WithSystemTLS() grpc.DialOption {
+	return WithTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+}
+
+// WithTLSFiles secures the connection to the access node with TLS configured from the provided files,
+// see NewTLSConfig.
+func WithTLSFiles(caFile string, certFile string, keyFile string) (grpc.DialOption, error) {
+	config, err := NewTLSConfig(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return WithTLS(config), nil
+}
+
+// NewTLSConfig creates a TLS configuration from PEM encoded files.
+//
+// The access node certificate is verified with the certificates of the CA file, or with the system
+// root certificates if the CA file is empty. The client certificate and key files are presented to
+// access nodes requiring mutual TLS, they are optional but must be provided together.
+func NewTLSConfig(caFile string, certFile string, keyFile string) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caFile != "" {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", caFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// WithUnaryInterceptors adds interceptors to the unary calls made by the client, such as the
+// access API requests. The interceptors are chained in the provided order, after any interceptor
+// added by previous options.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryClientInterceptor) grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(interceptors...)
+}
+
+// WithStreamInterceptors adds interceptors to the streaming calls made by the client. The interceptors
+// are chained in the provided order, after any interceptor added by previous options.
+func WithStreamInterceptors(interceptors ...grpc.StreamClientInterceptor) grpc.DialOption {
+	return grpc.WithChainStreamInterceptor(interceptors...)
+}
+
+// WithHeader adds a metadata header, such as an authentication token, to every call made by the client.
+func WithHeader(key string, value string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(header{key: key, value: value})
+}
+
+// header is a metadata header sent with every call.
+type header struct {
+	key   string
+	value string
+}
+
+func (h header) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{h.key: h.value}, nil
+}
+
+func (h header) RequireTransportSecurity() bool {
+	return false
+}
+
+// defaultDialOptions are the dial options applied by NewClient before the provided options,
+// which can override them.
+func defaultDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		WithMaxMessageSize(DefaultMaxMessageSize),
+		grpc.WithChainUnaryInterceptor(answeringNodeInterceptor),
+	}
+}
@@ -0,0 +1,315 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow/protobuf/go/flow/access"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// scriptServer is an access API server returning the same script result for every script,
+// recording the metadata of the last request.
+type scriptServer struct {
+	access.UnimplementedAccessAPIServer
+	value    []byte
+	metadata metadata.MD
+}
+
+func (s *scriptServer) ExecuteScriptAtLatestBlock(
+	ctx context.Context,
+	_ *access.ExecuteScriptAtLatestBlockRequest,
+) (*access.ExecuteScriptResponse, error) {
+	s.metadata, _ = metadata.FromIncomingContext(ctx)
+	return &access.ExecuteScriptResponse{Value: s.value}, nil
+}
+
+func newBufconnClient(
+	t *testing.T,
+	srv access.AccessAPIServer,
+	serverOpts []grpc.ServerOption,
+	opts ...grpc.DialOption,
+) *Client {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer(append(serverOpts, grpc.MaxSendMsgSize(DefaultMaxMessageSize))...)
+	access.RegisterAccessAPIServer(server, srv)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+
+	client, err := NewClient("bufnet", append([]grpc.DialOption{dialer}, opts...)...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+func TestClient_DialOptions(t *testing.T) {
+	value, err := jsoncdc.Encode(cadence.String(strings.Repeat("a", 5*1024*1024)))
+	require.NoError(t, err)
+
+	srv := &scriptServer{value: value}
+
+	t.Run("Default max message size", func(t *testing.T) {
+		client := newBufconnClient(t, srv, nil)
+
+		result, err := client.ExecuteScriptAtLatestBlock(context.Background(), []byte("script"), nil)
+		require.NoError(t, err)
+		assert.Len(t, result.(cadence.String), 5*1024*1024)
+	})
+
+	t.Run("Max message size", func(t *testing.T) {
+		client := newBufconnClient(t, srv, nil, WithMaxMessageSize(1024*1024))
+
+		_, err := client.ExecuteScriptAtLatestBlock(context.Background(), []byte("script"), nil)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("Keepalive", func(t *testing.T) {
+		client := newBufconnClient(t, srv, nil, WithKeepalive(time.Minute, 10*time.Second))
+
+		_, err := client.ExecuteScriptAtLatestBlock(context.Background(), []byte("script"), nil)
+		assert.NoError(t, err)
+	})
+}
+
+// testCertificates are a CA and the server and client certificates it issued, written as PEM files.
+type testCertificates struct {
+	pool            *x509.CertPool
+	server          tls.Certificate
+	caFile          string
+	clientCertFile  string
+	clientKeyFile   string
+	untrustedCAFile string
+}
+
+func newTestCertificates(t *testing.T) testCertificates {
+	dir := t.TempDir()
+
+	newKey := func() *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		return key
+	}
+
+	writePEM := func(name string, blockType string, der []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+		return path
+	}
+
+	newCA := func(serial int64) (*x509.Certificate, *ecdsa.PrivateKey, []byte) {
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(serial),
+			Subject:               pkix.Name{CommonName: "test CA"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			KeyUsage:              x509.KeyUsageCertSign,
+			BasicConstraintsValid: true,
+		}
+		key := newKey()
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		return cert, key, der
+	}
+
+	ca, caKey, caDER := newCA(1)
+	_, _, untrustedDER := newCA(2)
+
+	issue := func(serial int64, usage x509.ExtKeyUsage) ([]byte, *ecdsa.PrivateKey) {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "bufnet"},
+			DNSNames:     []string{"bufnet"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		key := newKey()
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		return der, key
+	}
+
+	serverDER, serverKey := issue(3, x509.ExtKeyUsageServerAuth)
+	clientDER, clientKey := issue(4, x509.ExtKeyUsageClientAuth)
+
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	return testCertificates{
+		pool:            pool,
+		server:          tls.Certificate{Certificate: [][]byte{serverDER}, PrivateKey: serverKey},
+		caFile:          writePEM("ca.pem", "CERTIFICATE", caDER),
+		clientCertFile:  writePEM("client.pem", "CERTIFICATE", clientDER),
+		clientKeyFile:   writePEM("client-key.pem", "EC PRIVATE KEY", clientKeyDER),
+		untrustedCAFile: writePEM("untrusted-ca.pem", "CERTIFICATE", untrustedDER),
+	}
+}
+
+func TestClient_TLS(t *testing.T) {
+	certs := newTestCertificates(t)
+
+	value, err := jsoncdc.Encode(cadence.String("secure"))
+	require.NoError(t, err)
+
+	srv := &scriptServer{value: value}
+
+	tlsServer := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{certs.server},
+		})),
+	}
+
+	mutualTLSServer := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{certs.server},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    certs.pool,
+		})),
+	}
+
+	execute := func(client *Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := client.ExecuteScriptAtLatestBlock(ctx, []byte("script"), nil)
+		return err
+	}
+
+	t.Run("Custom CA", func(t *testing.T) {
+		opt, err := WithTLSFiles(certs.caFile, "", "")
+		require.NoError(t, err)
+
+		client := newBufconnClient(t, srv, tlsServer, opt)
+		assert.NoError(t, execute(client))
+	})
+
+	t.Run("Untrusted server", func(t *testing.T) {
+		opt, err := WithTLSFiles(certs.untrustedCAFile, "", "")
+		require.NoError(t, err)
+
+		client := newBufconnClient(t, srv, tlsServer, opt)
+		assert.Equal(t, codes.Unavailable, status.Code(execute(client)))
+	})
+
+	t.Run("Mutual TLS", func(t *testing.T) {
+		opt, err := WithTLSFiles(certs.caFile, certs.clientCertFile, certs.clientKeyFile)
+		require.NoError(t, err)
+
+		client := newBufconnClient(t, srv, mutualTLSServer, opt)
+		assert.NoError(t, execute(client))
+	})
+
+	t.Run("Missing client certificate", func(t *testing.T) {
+		opt, err := WithTLSFiles(certs.caFile, "", "")
+		require.NoError(t, err)
+
+		client := newBufconnClient(t, srv, mutualTLSServer, opt)
+		assert.Error(t, execute(client))
+	})
+
+	t.Run("Invalid files", func(t *testing.T) {
+		_, err := WithTLSFiles(filepath.Join(t.TempDir(), "missing.pem"), "", "")
+		assert.Error(t, err)
+
+		_, err = WithTLSFiles("", certs.clientCertFile, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_Interceptors(t *testing.T) {
+	value, err := jsoncdc.Encode(cadence.String("intercepted"))
+	require.NoError(t, err)
+
+	t.Run("Unary interceptors", func(t *testing.T) {
+		srv := &scriptServer{value: value}
+
+		var methods []string
+		record := func(name string) grpc.UnaryClientInterceptor {
+			return func(
+				ctx context.Context,
+				method string,
+				req, reply interface{},
+				cc *grpc.ClientConn,
+				invoker grpc.UnaryInvoker,
+				opts ...grpc.CallOption,
+			) error {
+				methods = append(methods, name+" "+method)
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}
+		}
+
+		client := newBufconnClient(t, srv, nil, WithUnaryInterceptors(record("first"), record("second")))
+
+		_, err := client.ExecuteScriptAtLatestBlock(context.Background(), []byte("script"), nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"first /flow.access.AccessAPI/ExecuteScriptAtLatestBlock",
+			"second /flow.access.AccessAPI/ExecuteScriptAtLatestBlock",
+		}, methods)
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		srv := &scriptServer{value: value}
+
+		client := newBufconnClient(t, srv, nil, WithHeader("authorization", "Bearer token"))
+
+		_, err := client.ExecuteScriptAtLatestBlock(context.Background(), []byte("script"), nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Bearer token"}, srv.metadata.Get("authorization"))
+	})
+}
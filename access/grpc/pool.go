@@ -0,0 +1,318 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow/protobuf/go/flow/access"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultHealthCheckInterval is the interval between the health checks of the pool connections.
+const DefaultHealthCheckInterval = 10 * time.Second
+
+// PoolOption configures a connection pool.
+type PoolOption func(p *Pool)
+
+// WithHealthCheckInterval sets the interval between the health checks of the pool connections,
+// which must be positive.
+func WithHealthCheckInterval(interval time.Duration) PoolOption {
+	return func(p *Pool) {
+		p.healthCheckInterval = interval
+	}
+}
+
+// WithLatencyRouting routes the calls to the healthy connection with the lowest health check latency
+// instead of spreading them over all the healthy connections.
+func WithLatencyRouting() PoolOption {
+	return func(p *Pool) {
+		p.latencyRouting = true
+	}
+}
+
+// WithScriptHosts routes the script executions to the access nodes of the pool with the provided hosts,
+// while the other calls are spread over all the access nodes.
+//
+// Whether an access node executes scripts against its local state or proxies them to execution nodes is
+// configured on the access node, the Access API doesn't let clients choose, so scripts are routed to the
+// access nodes configured with the expected mode, which keeps script results consistent.
+func WithScriptHosts(hosts ...string) PoolOption {
+	return func(p *Pool) {
+		p.scriptHosts = append(p.scriptHosts, hosts...)
+	}
+}
+
+// WithPoolDialOptions sets the dial options used to connect to every access node of the pool.
+func WithPoolDialOptions(opts ...grpc.DialOption) PoolOption {
+	return func(p *Pool) {
+		p.dialOptions = append(p.dialOptions, opts...)
+	}
+}
+
+// poolConn is a pool connection to an access node with its health state.
+type poolConn struct {
+	host string
+	conn *grpc.ClientConn
+
+	mu      sync.Mutex
+	healthy bool
+	latency time.Duration // moving average of the health check latency
+}
+
+func (c *poolConn) state() (bool, time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.healthy, c.latency
+}
+
+func (c *poolConn) setHealthy(healthy bool, latency time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.healthy = healthy
+	if !healthy {
+		return
+	}
+
+	if c.latency == 0 {
+		c.latency = latency
+	} else {
+		c.latency = (4*c.latency + latency) / 5
+	}
+}
+
+// Pool is a gRPC connection pool spreading calls over connections to multiple access nodes.
+//
+// Calls are made round-robin over the healthy connections, or to the connection with the lowest
+// latency if latency routing is enabled. The connections are health checked with a ping at the
+// health check interval, and a connection is considered unhealthy from a failed health check or
+// an unavailable error until the next successful health check. If no connection is healthy the
+// calls are spread over all the connections. Script executions can be routed to a subset of the
+// access nodes with WithScriptHosts.
+//
+// Pool implements grpc.ClientConnInterface, use NewPoolClient to create an access API client
+// using a pool.
+type Pool struct {
+	conns               []*poolConn
+	next                uint64
+	healthCheckInterval time.Duration
+	latencyRouting      bool
+	scriptHosts         []string
+	scriptConns         []*poolConn
+	dialOptions         []grpc.DialOption
+	stop                chan struct{}
+	done                sync.WaitGroup
+	closeOnce           sync.Once
+	closeErr            error
+}
+
+var _ grpc.ClientConnInterface = (*Pool)(nil)
+
+// NewPool creates a connection pool connected to the access nodes with the provided hosts.
+func NewPool(hosts []string, opts ...PoolOption) (*Pool, error) {
+	if len(hosts) == 0 {
+		return nil, errors.New("at least one host is required")
+	}
+
+	p := &Pool{
+		healthCheckInterval: DefaultHealthCheckInterval,
+		stop:                make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.healthCheckInterval <= 0 {
+		return nil, fmt.Errorf("health check interval must be positive, got %s", p.healthCheckInterval)
+	}
+
+	for _, host := range hosts {
+		conn, err := grpc.Dial(host, append(defaultDialOptions(), p.dialOptions...)...)
+		if err != nil {
+			_ = p.Close()
+			return nil, err
+		}
+
+		p.conns = append(p.conns, &poolConn{host: host, conn: conn, healthy: true})
+	}
+
+	for _, host := range p.scriptHosts {
+		conn := p.conn(host)
+		if conn == nil {
+			_ = p.Close()
+			return nil, fmt.Errorf("script host %s is not a host of the pool", host)
+		}
+
+		p.scriptConns = append(p.scriptConns, conn)
+	}
+
+	p.done.Add(1)
+	go p.healthCheckLoop()
+
+	return p, nil
+}
+
+// NewPoolClient creates a gRPC client spreading the calls over connections to the access nodes
+// with the provided hosts, see Pool.
+func NewPoolClient(hosts []string, opts ...PoolOption) (*Client, error) {
+	pool, err := NewPool(hosts, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{grpc: &BaseClient{
+		rpcClient:   access.NewAccessAPIClient(pool),
+		close:       pool.Close,
+		jsonOptions: []json.Option{json.WithAllowUnstructuredStaticTypes(true)},
+	}}, nil
+}
+
+// Invoke performs a unary call on one of the pool connections.
+func (p *Pool) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	conn := p.pick(method)
+
+	err := conn.conn.Invoke(ctx, method, args, reply, opts...)
+	if status.Code(err) == codes.Unavailable {
+		conn.setHealthy(false, 0)
+	}
+
+	return err
+}
+
+// NewStream begins a streaming call on one of the pool connections.
+func (p *Pool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	conn := p.pick(method)
+
+	stream, err := conn.conn.NewStream(ctx, desc, method, opts...)
+	if status.Code(err) == codes.Unavailable {
+		conn.setHealthy(false, 0)
+	}
+
+	return stream, err
+}
+
+// Close stops the health checks and closes all the pool connections.
+//
+// Closing the pool again has no effect and returns the error of the first call.
+func (p *Pool) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.stop)
+		p.done.Wait()
+
+		for _, c := range p.conns {
+			if err := c.conn.Close(); err != nil && p.closeErr == nil {
+				p.closeErr = err
+			}
+		}
+	})
+
+	return p.closeErr
+}
+
+// conn returns the connection to the access node with the host, or nil if the host is not part of the pool.
+func (p *Pool) conn(host string) *poolConn {
+	for _, c := range p.conns {
+		if c.host == host {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// pick returns the connection the next call of the method is made on.
+func (p *Pool) pick(method string) *poolConn {
+	conns := p.conns
+	if len(p.scriptConns) > 0 && strings.HasPrefix(method, "/flow.access.AccessAPI/ExecuteScript") {
+		conns = p.scriptConns
+	}
+
+	candidates := make([]*poolConn, 0, len(conns))
+	for _, c := range conns {
+		if healthy, _ := c.state(); healthy {
+			candidates = append(candidates, c)
+		}
+	}
+
+	if len(candidates) == 0 {
+		candidates = conns
+	}
+
+	if p.latencyRouting {
+		best := candidates[0]
+		_, bestLatency := best.state()
+		for _, c := range candidates[1:] {
+			if _, latency := c.state(); latency < bestLatency {
+				best, bestLatency = c, latency
+			}
+		}
+		return best
+	}
+
+	next := atomic.AddUint64(&p.next, 1) - 1
+	return candidates[next%uint64(len(candidates))]
+}
+
+func (p *Pool) healthCheckLoop() {
+	defer p.done.Done()
+
+	p.checkHealth()
+
+	ticker := time.NewTicker(p.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.checkHealth()
+		}
+	}
+}
+
+// checkHealth pings all the pool connections, updating their health state.
+func (p *Pool) checkHealth() {
+	var wg sync.WaitGroup
+	for _, c := range p.conns {
+		wg.Add(1)
+		go func(c *poolConn) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), p.healthCheckInterval)
+			defer cancel()
+
+			start := time.Now()
+			_, err := access.NewAccessAPIClient(c.conn).Ping(ctx, &access.PingRequest{})
+			c.setHealthy(err == nil, time.Since(start))
+		}(c)
+	}
+	wg.Wait()
+}
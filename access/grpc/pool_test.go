@@ -0,0 +1,297 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow/protobuf/go/flow/access"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// countingServer is an access API server counting the calls it receives, failing them while it is down.
+type countingServer struct {
+	access.UnimplementedAccessAPIServer
+
+	mu      sync.Mutex
+	calls   int
+	scripts int
+	down    bool
+}
+
+func (s *countingServer) Ping(context.Context, *access.PingRequest) (*access.PingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.down {
+		return nil, status.Error(codes.Unavailable, "down")
+	}
+	return &access.PingResponse{}, nil
+}
+
+func (s *countingServer) GetLatestBlockHeader(
+	context.Context,
+	*access.GetLatestBlockHeaderRequest,
+) (*access.BlockHeaderResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	if s.down {
+		return nil, status.Error(codes.Unavailable, "down")
+	}
+
+	header, err := blockHeaderToMessage(flow.BlockHeader{Timestamp: time.Now()})
+	if err != nil {
+		return nil, err
+	}
+	return &access.BlockHeaderResponse{Block: header}, nil
+}
+
+func (s *countingServer) ExecuteScriptAtLatestBlock(
+	context.Context,
+	*access.ExecuteScriptAtLatestBlockRequest,
+) (*access.ExecuteScriptResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scripts++
+	return &access.ExecuteScriptResponse{Value: []byte(`{"type":"Int","value":"42"}`)}, nil
+}
+
+func (s *countingServer) scriptCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scripts
+}
+
+func (s *countingServer) setDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.down = down
+}
+
+func (s *countingServer) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+// newTestPool creates a pool connected to the provided servers over in-memory connections.
+func newTestPool(t *testing.T, servers []*countingServer, opts ...PoolOption) *Pool {
+	listeners := make(map[string]*bufconn.Listener)
+	hosts := make([]string, len(servers))
+
+	for i, srv := range servers {
+		listener := bufconn.Listen(1024 * 1024)
+		server := grpc.NewServer()
+		access.RegisterAccessAPIServer(server, srv)
+		go func() { _ = server.Serve(listener) }()
+		t.Cleanup(server.Stop)
+
+		hosts[i] = string(rune('a'+i)) + ".bufnet"
+		listeners[hosts[i]] = listener
+	}
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, host string) (net.Conn, error) {
+		return listeners[host].DialContext(ctx)
+	})
+
+	pool, err := NewPool(hosts, append(opts, WithPoolDialOptions(dialer))...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = pool.Close() })
+
+	return pool
+}
+
+func TestPool(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Round robin", func(t *testing.T) {
+		servers := []*countingServer{{}, {}, {}}
+		client := NewFromRPCClient(access.NewAccessAPIClient(newTestPool(t, servers)))
+
+		for i := 0; i < 6; i++ {
+			_, err := client.GetLatestBlockHeader(ctx, true)
+			require.NoError(t, err)
+		}
+
+		for _, srv := range servers {
+			assert.Equal(t, 2, srv.callCount())
+		}
+	})
+
+	t.Run("Unhealthy connection", func(t *testing.T) {
+		servers := []*countingServer{{}, {down: true}}
+		pool := newTestPool(t, servers, WithHealthCheckInterval(time.Hour))
+		client := NewFromRPCClient(access.NewAccessAPIClient(pool))
+
+		pool.checkHealth()
+
+		for i := 0; i < 4; i++ {
+			_, err := client.GetLatestBlockHeader(ctx, true)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 4, servers[0].callCount())
+		assert.Equal(t, 0, servers[1].callCount())
+
+		// the connection is used again once it passes a health check
+		servers[1].setDown(false)
+		pool.checkHealth()
+
+		for i := 0; i < 4; i++ {
+			_, err := client.GetLatestBlockHeader(ctx, true)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 2, servers[1].callCount())
+	})
+
+	t.Run("Unavailable error", func(t *testing.T) {
+		servers := []*countingServer{{}, {}}
+		pool := newTestPool(t, servers, WithHealthCheckInterval(time.Hour))
+		client := NewFromRPCClient(access.NewAccessAPIClient(pool))
+
+		pool.checkHealth()
+		servers[0].setDown(true)
+
+		// the first call fails on the down node, which is then skipped
+		_, err := client.GetLatestBlockHeader(ctx, true)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		for i := 0; i < 3; i++ {
+			_, err := client.GetLatestBlockHeader(ctx, true)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 1, servers[0].callCount())
+		assert.Equal(t, 3, servers[1].callCount())
+	})
+
+	t.Run("All connections unhealthy", func(t *testing.T) {
+		servers := []*countingServer{{down: true}, {down: true}}
+		pool := newTestPool(t, servers, WithHealthCheckInterval(time.Hour))
+		client := NewFromRPCClient(access.NewAccessAPIClient(pool))
+
+		pool.checkHealth()
+
+		for i := 0; i < 2; i++ {
+			_, err := client.GetLatestBlockHeader(ctx, true)
+			assert.Error(t, err)
+		}
+
+		assert.Equal(t, 1, servers[0].callCount())
+		assert.Equal(t, 1, servers[1].callCount())
+	})
+
+	t.Run("Latency routing", func(t *testing.T) {
+		servers := []*countingServer{{}, {}, {}}
+		pool := newTestPool(t, servers, WithHealthCheckInterval(time.Hour), WithLatencyRouting())
+		client := NewFromRPCClient(access.NewAccessAPIClient(pool))
+
+		pool.checkHealth()
+		for i, c := range pool.conns {
+			c.mu.Lock()
+			c.latency = time.Duration(3-i) * time.Millisecond
+			c.mu.Unlock()
+		}
+
+		for i := 0; i < 3; i++ {
+			_, err := client.GetLatestBlockHeader(ctx, true)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 3, servers[2].callCount())
+	})
+
+	t.Run("Script hosts", func(t *testing.T) {
+		servers := []*countingServer{{}, {}, {}}
+		client := NewFromRPCClient(access.NewAccessAPIClient(newTestPool(t, servers, WithScriptHosts("b.bufnet"))))
+
+		for i := 0; i < 3; i++ {
+			_, err := client.ExecuteScriptAtLatestBlock(ctx, []byte("script"), nil)
+			require.NoError(t, err)
+
+			_, err = client.GetLatestBlockHeader(ctx, true)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 0, servers[0].scriptCount())
+		assert.Equal(t, 3, servers[1].scriptCount())
+		assert.Equal(t, 0, servers[2].scriptCount())
+
+		for _, srv := range servers {
+			assert.Equal(t, 1, srv.callCount())
+		}
+	})
+
+	t.Run("Unknown script host", func(t *testing.T) {
+		_, err := NewPool([]string{"a.bufnet"}, WithScriptHosts("b.bufnet"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Answering node", func(t *testing.T) {
+		servers := []*countingServer{{}, {}}
+		client := NewFromRPCClient(access.NewAccessAPIClient(newTestPool(t, servers)))
+
+		var node AnsweringNode
+		nodeCtx := RecordAnsweringNode(ctx, &node)
+
+		_, err := client.ExecuteScriptAtLatestBlock(nodeCtx, []byte("script"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "a.bufnet", node.Host)
+		assert.NotNil(t, node.Address)
+
+		_, err = client.ExecuteScriptAtLatestBlock(nodeCtx, []byte("script"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, "b.bufnet", node.Host)
+	})
+
+	t.Run("No hosts", func(t *testing.T) {
+		_, err := NewPool(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid health check interval", func(t *testing.T) {
+		_, err := NewPool([]string{"a.bufnet"}, WithHealthCheckInterval(0))
+		assert.Error(t, err)
+
+		_, err = NewPool([]string{"a.bufnet"}, WithHealthCheckInterval(-time.Second))
+		assert.Error(t, err)
+	})
+
+	t.Run("Close twice", func(t *testing.T) {
+		pool := newTestPool(t, []*countingServer{{}})
+
+		require.NoError(t, pool.Close())
+		assert.NoError(t, pool.Close())
+	})
+}
@@ -0,0 +1,121 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// DefaultPollInterval is the interval between transaction result requests of transaction status subscriptions.
+const DefaultPollInterval = time.Second
+
+// SubscribeOption configures a subscription.
+type SubscribeOption func(c *subscribeConfig)
+
+type subscribeConfig struct {
+	pollInterval time.Duration
+}
+
+// WithPollInterval sets the interval between the requests made to follow the transaction status.
+func WithPollInterval(interval time.Duration) SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.pollInterval = interval
+	}
+}
+
+// final returns true if the transaction status can't change anymore.
+func final(status flow.TransactionStatus) bool {
+	return status == flow.TransactionStatusSealed || status == flow.TransactionStatusExpired
+}
+
+// SendAndSubscribeTransactionStatuses sends the transaction and subscribes to its status updates.
+//
+// The transaction result is delivered on the returned channel every time the transaction status changes,
+// from pending to sealed or expired, after which the subscription ends. The access API doesn't provide
+// transaction status streaming, so the status is followed by requesting the transaction result at the
+// poll interval. A transaction not found by the access node yet is reported as pending. Both channels
+// are closed when the subscription ends.
+func (c *BaseClient) SendAndSubscribeTransactionStatuses(
+	ctx context.Context,
+	tx flow.Transaction,
+	opts ...SubscribeOption,
+) (<-chan *flow.TransactionResult, <-chan error, error) {
+	config := subscribeConfig{pollInterval: DefaultPollInterval}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	err := c.SendTransaction(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make(chan *flow.TransactionResult)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(errChan)
+		defer close(results)
+
+		ticker := time.NewTicker(config.pollInterval)
+		defer ticker.Stop()
+
+		current := flow.TransactionStatusUnknown
+		for {
+			result, err := c.GetTransactionResult(ctx, tx.ID())
+			if status.Code(err) == codes.NotFound {
+				// the access node may not know a just sent transaction yet
+				result, err = &flow.TransactionResult{Status: flow.TransactionStatusPending}, nil
+			}
+			if err != nil {
+				if ctx.Err() == nil {
+					errChan <- err
+				}
+				return
+			}
+
+			if result.Status != current {
+				select {
+				case results <- result:
+					current = result.Status
+				case <-ctx.Done():
+					return
+				}
+
+				if final(current) {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return results, errChan, nil
+}
@@ -20,10 +20,14 @@ package http
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/onflow/cadence"
 
 	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
 )
 
 const (
@@ -33,23 +37,120 @@ const (
 	CanarynetHost = "https://rest-canary.onflow.org/v1/"
 )
 
+// ChainHost returns the predefined host of the network with the provided chain ID.
+func ChainHost(chainID flow.ChainID) (string, error) {
+	switch chainID {
+	case flow.Mainnet:
+		return MainnetHost, nil
+	case flow.Testnet:
+		return TestnetHost, nil
+	case flow.Canary:
+		return CanarynetHost, nil
+	case flow.Emulator:
+		return EmulatorHost, nil
+	default:
+		return "", fmt.Errorf("no predefined host for chain ID %s", chainID)
+	}
+}
+
+// NewClientForChain creates an HTTP client connected to the predefined host of the network with the provided chain ID.
+func NewClientForChain(chainID flow.ChainID, opts ...ClientOption) (*Client, error) {
+	host, err := ChainHost(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(host, opts...)
+}
+
 // NewClient creates an HTTP client exposing all the common access APIs.
 // Client will use provided host for connection.
-func NewClient(host string) (*Client, error) {
-	client, err := NewBaseClient(host)
+//
+// The host can be any access node REST API base URL, not only the predefined hosts,
+// and the client can be customized using client options, for example:
+//
+//	client, err := http.NewClient(
+//		"https://access.example.com/v1",
+//		http.WithHTTPClient(proxyClient),
+//		http.WithTimeout(10*time.Second),
+//		http.WithHeader("Authorization", "Bearer token"),
+//	)
+func NewClient(host string, opts ...ClientOption) (*Client, error) {
+	client, err := NewBaseClient(host, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return &Client{client}, nil
+	return &Client{httpClient: client}, nil
 }
 
 // Client implements all common HTTP methods providing a network agnostic API.
 type Client struct {
 	httpClient *BaseClient
+
+	chainIDMu       sync.Mutex
+	chainID         flow.ChainID
+	expectedChainID flow.ChainID
+
+	blockTimestampsOnce sync.Once
+	blockTimestamps     *access.BlockTimestampIndex
+}
+
+var _ access.Client = (*Client)(nil)
+var _ access.FinalizedScriptClient = (*Client)(nil)
+var _ access.AccountAtBlockIDClient = (*Client)(nil)
+
+// SetExpectedChainID makes the client fail with an access.ChainIDMismatchError when the access node
+// serves another network than the expected chain ID.
+//
+// The chain ID of the access node is checked by Ping and before sending the first transaction, so
+// transactions signed for a network are never sent to an access node of another network.
+func (c *Client) SetExpectedChainID(chainID flow.ChainID) {
+	c.chainIDMu.Lock()
+	defer c.chainIDMu.Unlock()
+	c.expectedChainID = chainID
+}
+
+// GetChainID returns the chain ID of the network served by the access node.
+//
+// The chain ID is requested on first use and cached afterwards.
+func (c *Client) GetChainID(ctx context.Context) (flow.ChainID, error) {
+	c.chainIDMu.Lock()
+	defer c.chainIDMu.Unlock()
+
+	if c.chainID == "" {
+		chainID, err := c.httpClient.GetChainID(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.chainID = chainID
+	}
+
+	return c.chainID, nil
+}
+
+// checkChainID checks the access node serves the expected network, if any.
+func (c *Client) checkChainID(ctx context.Context) error {
+	c.chainIDMu.Lock()
+	expected := c.expectedChainID
+	c.chainIDMu.Unlock()
+
+	if expected == "" {
+		return nil
+	}
+
+	chainID, err := c.GetChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	return access.CheckChainID(expected, chainID)
 }
 
 func (c *Client) Ping(ctx context.Context) error {
-	return c.httpClient.Ping(ctx)
+	if err := c.httpClient.Ping(ctx); err != nil {
+		return err
+	}
+
+	return c.checkChainID(ctx)
 }
 
 func (c *Client) GetBlockByID(ctx context.Context, blockID flow.Identifier) (*flow.Block, error) {
@@ -57,30 +158,29 @@ func (c *Client) GetBlockByID(ctx context.Context, blockID flow.Identifier) (*fl
 }
 
 func (c *Client) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
-	block, err := c.GetLatestBlock(ctx, isSealed)
-	if err != nil {
-		return nil, err
+	height := FINAL
+	if isSealed {
+		height = SEALED
 	}
 
-	return &block.BlockHeader, nil
+	return c.GetBlockHeaderByHeight(ctx, height)
 }
 
 func (c *Client) GetBlockHeaderByID(ctx context.Context, blockID flow.Identifier) (*flow.BlockHeader, error) {
-	block, err := c.GetBlockByID(ctx, blockID) // todo optimization: passing the 'select' option to only get the header
-	if err != nil {
-		return nil, err
-	}
-
-	return &block.BlockHeader, nil
+	return c.httpClient.GetBlockHeaderByID(ctx, blockID)
 }
 
 func (c *Client) GetBlockHeaderByHeight(ctx context.Context, height uint64) (*flow.BlockHeader, error) {
-	block, err := c.GetBlockByHeight(ctx, height) // todo optimization: passing the 'select' option to only get the header
+	headers, err := c.httpClient.GetBlockHeadersByHeights(ctx, HeightQuery{Heights: []uint64{height}})
 	if err != nil {
 		return nil, err
 	}
 
-	return &block.BlockHeader, nil
+	if len(headers) == 0 { // sanity check
+		return nil, fmt.Errorf("get block header failed")
+	}
+
+	return headers[0], nil
 }
 
 func (c *Client) GetLatestBlock(ctx context.Context, isSealed bool) (*flow.Block, error) {
@@ -114,6 +214,10 @@ func (c *Client) GetCollection(ctx context.Context, ID flow.Identifier) (*flow.C
 }
 
 func (c *Client) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	if err := c.checkChainID(ctx); err != nil {
+		return err
+	}
+
 	return c.httpClient.SendTransaction(ctx, tx)
 }
 
@@ -137,6 +241,27 @@ func (c *Client) GetAccountAtLatestBlock(ctx context.Context, address flow.Addre
 	)
 }
 
+// GetAccountWithoutContracts gets the account at the latest sealed block with its keys
+// but without its contracts, which is cheaper for accounts holding large contracts.
+//
+// Use the WithoutAccountContracts option to fetch all the accounts without their contracts.
+func (c *Client) GetAccountWithoutContracts(ctx context.Context, address flow.Address) (*flow.Account, error) {
+	return c.httpClient.GetAccountAtBlockHeight(
+		ctx,
+		address, HeightQuery{Heights: []uint64{SEALED}},
+		&ExpandOpts{Expands: []string{"keys"}},
+	)
+}
+
+// GetAccountAtLatestFinalizedBlock gets the account state at the latest finalized block,
+// which is more recent than the latest sealed block used by GetAccountAtLatestBlock.
+func (c *Client) GetAccountAtLatestFinalizedBlock(ctx context.Context, address flow.Address) (*flow.Account, error) {
+	return c.httpClient.GetAccountAtBlockHeight(
+		ctx,
+		address, HeightQuery{Heights: []uint64{FINAL}},
+	)
+}
+
 func (c *Client) GetAccountAtBlockHeight(
 	ctx context.Context,
 	address flow.Address,
@@ -149,6 +274,23 @@ func (c *Client) GetAccountAtBlockHeight(
 	)
 }
 
+// GetAccountAtBlockID gets an account by address at the block with the given ID.
+//
+// The REST API doesn't support querying accounts by block ID, the block height
+// is resolved with an additional request before getting the account.
+func (c *Client) GetAccountAtBlockID(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+) (*flow.Account, error) {
+	header, err := c.GetBlockHeaderByID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetAccountAtBlockHeight(ctx, address, header.Height)
+}
+
 func (c *Client) ExecuteScriptAtLatestBlock(
 	ctx context.Context,
 	script []byte,
@@ -162,6 +304,21 @@ func (c *Client) ExecuteScriptAtLatestBlock(
 	)
 }
 
+// ExecuteScriptAtLatestFinalizedBlock executes the script against the state at the latest finalized block,
+// trading the guarantee of executing against sealed state for lower latency.
+func (c *Client) ExecuteScriptAtLatestFinalizedBlock(
+	ctx context.Context,
+	script []byte,
+	arguments []cadence.Value,
+) (cadence.Value, error) {
+	return c.httpClient.ExecuteScriptAtBlockHeight(
+		ctx,
+		HeightQuery{Heights: []uint64{FINAL}},
+		script,
+		arguments,
+	)
+}
+
 func (c *Client) ExecuteScriptAtBlockID(
 	ctx context.Context,
 	blockID flow.Identifier,
@@ -217,6 +374,99 @@ func (c *Client) GetExecutionResultForBlockID(ctx context.Context, blockID flow.
 	return c.httpClient.GetExecutionResultForBlockID(ctx, blockID)
 }
 
+// GetAccountStorage reports the storage used by the account, the values it stores and the capabilities
+// it publishes, as access.GetAccountStorage.
+func (c *Client) GetAccountStorage(ctx context.Context, address flow.Address) (*access.AccountStorage, error) {
+	return access.GetAccountStorage(ctx, c, address)
+}
+
+// GetBlockByTimestamp returns the last sealed block with a timestamp before or at the time.
+//
+// The block is found with a binary search over the block heights, and the timestamps fetched by the
+// searches are cached by the client, see access.BlockTimestampIndex.
+func (c *Client) GetBlockByTimestamp(ctx context.Context, t time.Time) (*flow.Block, error) {
+	return c.BlockTimestampIndex().GetBlockByTimestamp(ctx, t)
+}
+
+// BlockTimestampIndex returns the index used by GetBlockByTimestamp, which also translates time ranges
+// into height ranges.
+func (c *Client) BlockTimestampIndex() *access.BlockTimestampIndex {
+	c.blockTimestampsOnce.Do(func() {
+		c.blockTimestamps = access.NewBlockTimestampIndex(c)
+	})
+
+	return c.blockTimestamps
+}
+
+// GetNodeVersionInfo requests the software version of the access node and the spork of the network.
+func (c *Client) GetNodeVersionInfo(ctx context.Context) (*flow.NodeVersionInfo, error) {
+	return c.httpClient.GetNodeVersionInfo(ctx)
+}
+
+// SubscribeBlocks subscribes to the blocks starting at the provided height, sealed blocks by default.
+//
+// Blocks are delivered on the block channel and the subscription error, if any, on the error channel.
+// The subscription reconnects and resumes after the last delivered block if the connection is lost.
+func (c *Client) SubscribeBlocks(
+	ctx context.Context,
+	startHeight uint64,
+	opts ...SubscribeOption,
+) (<-chan *flow.Block, <-chan error, error) {
+	return c.httpClient.SubscribeBlocks(ctx, startHeight, opts...)
+}
+
+// SubscribeEvents subscribes to the events matching the filter, starting at the provided block height.
+//
+// Events are delivered grouped by block on the events channel and the subscription error, if any,
+// on the error channel. The subscription reconnects and resumes after the last delivered block if
+// the connection is lost.
+func (c *Client) SubscribeEvents(
+	ctx context.Context,
+	startHeight uint64,
+	filter flow.EventFilter,
+	opts ...SubscribeOption,
+) (<-chan flow.BlockEvents, <-chan error, error) {
+	return c.httpClient.SubscribeEvents(ctx, startHeight, filter, opts...)
+}
+
+// SendAndSubscribeTransactionStatuses sends the transaction and delivers its result on the returned
+// channel every time its status changes, until the transaction is sealed or expired.
+func (c *Client) SendAndSubscribeTransactionStatuses(
+	ctx context.Context,
+	tx flow.Transaction,
+	opts ...SubscribeOption,
+) (<-chan *flow.TransactionResult, <-chan error, error) {
+	return c.httpClient.SendAndSubscribeTransactionStatuses(ctx, tx, opts...)
+}
+
+// SubscribeAccountStatuses subscribes to the core account events of the accounts matching the filter,
+// starting at the provided block height.
+//
+// Account statuses are delivered on the statuses channel and the subscription error, if any, on the
+// error channel. The subscription reconnects and resumes after the last delivered block if the
+// connection is lost.
+func (c *Client) SubscribeAccountStatuses(
+	ctx context.Context,
+	startHeight uint64,
+	filter flow.AccountStatusFilter,
+	opts ...SubscribeOption,
+) (<-chan *flow.AccountStatus, <-chan error, error) {
+	return c.httpClient.SubscribeAccountStatuses(ctx, startHeight, filter, opts...)
+}
+
+// SubscribeBlockDigests subscribes to the ID, height and timestamp of the blocks with the provided status,
+// starting at the latest block with that status.
+//
+// Digests are delivered on the digests channel and the subscription error, if any, on the error channel.
+// The subscription reconnects and resumes after the last delivered block if the connection is lost.
+func (c *Client) SubscribeBlockDigests(
+	ctx context.Context,
+	blockStatus flow.BlockStatus,
+	opts ...SubscribeOption,
+) (<-chan *flow.BlockDigest, <-chan error, error) {
+	return c.httpClient.SubscribeBlockDigests(ctx, blockStatus, opts...)
+}
+
 func (c *Client) Close() error {
 	// Close method is not required by the HTTP as the connection is setup and tear down with every request.
 	return nil
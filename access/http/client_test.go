@@ -25,11 +25,13 @@ import (
 	"testing"
 
 	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
 	"github.com/onflow/flow-go-sdk/access/http/models"
 	"github.com/onflow/flow-go-sdk/test"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func clientTest(
@@ -38,7 +40,7 @@ func clientTest(
 	return func(t *testing.T) {
 		h := &mockHandler{}
 		client := &Client{
-			&BaseClient{handler: h},
+			httpClient: &BaseClient{handler: h},
 		}
 		f(context.Background(), t, h, client)
 		h.AssertExpectations(t)
@@ -62,6 +64,17 @@ func TestClient_Factories(t *testing.T) {
 	client, err = NewClient(EmulatorHost)
 	assert.NoError(t, err)
 	assert.NotNil(t, client)
+
+	client, err = NewClientForChain(flow.Canary)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	host, err := ChainHost(flow.Canary)
+	assert.NoError(t, err)
+	assert.Equal(t, CanarynetHost, host)
+
+	_, err = NewClientForChain(flow.Benchnet)
+	assert.EqualError(t, err, "no predefined host for chain ID flow-benchnet")
 }
 
 func TestBaseClient_GetBlockByID(t *testing.T) {
@@ -86,7 +99,7 @@ func TestBaseClient_GetBlockByID(t *testing.T) {
 		assert.NoError(t, err)
 
 		handler.
-			On(handlerName, mock.Anything, httpBlock.Header.Id).
+			On("getBlockHeaderByID", mock.Anything, httpBlock.Header.Id).
 			Return(&httpBlock, nil)
 
 		header, err := client.GetBlockHeaderByID(ctx, flow.HexToID(httpBlock.Header.Id))
@@ -145,7 +158,7 @@ func TestBaseClient_GetBlockByHeight(t *testing.T) {
 		assert.NoError(t, err)
 
 		handler.
-			On(handlerName, mock.Anything, httpBlock.Header.Height, "", "").
+			On("getBlockHeadersByHeights", mock.Anything, httpBlock.Header.Height, "", "").
 			Return([]*models.Block{&httpBlock}, nil)
 
 		block, err := client.GetBlockHeaderByHeight(ctx, expectedBlock.Height)
@@ -191,7 +204,7 @@ func TestBaseClient_GetLatestBlock(t *testing.T) {
 		assert.NoError(t, err)
 
 		handler.
-			On(handlerName, mock.Anything, "final", "", "").
+			On("getBlockHeadersByHeights", mock.Anything, "final", "", "").
 			Return([]*models.Block{&httpBlock}, nil)
 
 		block, err := client.GetLatestBlockHeader(ctx, false)
@@ -205,7 +218,7 @@ func TestBaseClient_GetLatestBlock(t *testing.T) {
 		assert.NoError(t, err)
 
 		handler.
-			On(handlerName, mock.Anything, "sealed", "", "").
+			On("getBlockHeadersByHeights", mock.Anything, "sealed", "", "").
 			Return([]*models.Block{&httpBlock}, nil)
 
 		block, err := client.GetLatestBlockHeader(ctx, true)
@@ -219,7 +232,8 @@ func TestBaseClient_GetCollection(t *testing.T) {
 
 	t.Run("Success", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
 		httpCollection := collectionFlowFixture()
-		expectedCollection := toCollection(&httpCollection)
+		expectedCollection, err := toCollection(&httpCollection)
+		require.NoError(t, err)
 
 		handler.
 			On(handlerName, mock.Anything, expectedCollection.ID().String()).
@@ -364,6 +378,35 @@ func TestBaseClient_GetAccount(t *testing.T) {
 		assert.Equal(t, account, expectedAccount)
 	}))
 
+	t.Run("Success Without Contracts", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		httpAccount := accountFlowFixture()
+		httpAccount.Contracts = nil
+		expectedAccount, err := toAccount(&httpAccount)
+		assert.NoError(t, err)
+
+		handler.
+			On(handlerName, mock.Anything, httpAccount.Address, "sealed", &ExpandOpts{Expands: []string{"keys"}}).
+			Return(&httpAccount, nil)
+
+		account, err := client.GetAccountWithoutContracts(ctx, expectedAccount.Address)
+		assert.NoError(t, err)
+		assert.Equal(t, account, expectedAccount)
+	}))
+
+	t.Run("Success Finalized", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		httpAccount := accountFlowFixture()
+		expectedAccount, err := toAccount(&httpAccount)
+		assert.NoError(t, err)
+
+		handler.
+			On(handlerName, mock.Anything, httpAccount.Address, "final").
+			Return(&httpAccount, nil)
+
+		account, err := client.GetAccountAtLatestFinalizedBlock(ctx, expectedAccount.Address)
+		assert.NoError(t, err)
+		assert.Equal(t, account, expectedAccount)
+	}))
+
 	t.Run("Not Found", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
 		handler.On(handlerName, mock.Anything, mock.Anything, mock.Anything).Return(nil, HTTPError{
 			Url:     "/",
@@ -411,6 +454,27 @@ func TestBaseClient_GetAccountAtBlockHeight(t *testing.T) {
 	}))
 }
 
+func TestClient_GetAccountAtBlockID(t *testing.T) {
+	t.Run("Success", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		httpBlock := blockFlowFixture()
+		httpAccount := accountFlowFixture()
+		expectedAccount, err := toAccount(&httpAccount)
+		assert.NoError(t, err)
+
+		handler.
+			On("getBlockHeaderByID", mock.Anything, httpBlock.Header.Id).
+			Return(&httpBlock, nil)
+
+		handler.
+			On("getAccount", mock.Anything, httpAccount.Address, httpBlock.Header.Height).
+			Return(&httpAccount, nil)
+
+		account, err := client.GetAccountAtBlockID(ctx, expectedAccount.Address, flow.HexToID(httpBlock.Header.Id))
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAccount, account)
+	}))
+}
+
 func TestBaseClient_ExecuteScript(t *testing.T) {
 
 	t.Run("Success Block Height", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
@@ -448,6 +512,23 @@ func TestBaseClient_ExecuteScript(t *testing.T) {
 		assert.Equal(t, val.String(), "\"Hello World\"")
 	}))
 
+	t.Run("Success Latest Finalized Height", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		script := []byte(`main() { return "Hello World" }`)
+		encodedScript := base64.StdEncoding.EncodeToString(script)
+		response := base64.StdEncoding.EncodeToString([]byte(`{
+		  "type": "String",
+		  "value": "Hello World"
+		}`))
+
+		handler.
+			On("executeScriptAtBlockHeight", mock.Anything, "final", encodedScript, []string{}).
+			Return(response, nil)
+
+		val, err := client.ExecuteScriptAtLatestFinalizedBlock(ctx, script, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, val.String(), "\"Hello World\"")
+	}))
+
 	t.Run("Success Block ID", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
 		script := []byte(`main() { return "Hello World" }`)
 		encodedScript := base64.StdEncoding.EncodeToString(script)
@@ -478,6 +559,15 @@ func TestBaseClient_ExecuteScript(t *testing.T) {
 		_, err := client.ExecuteScriptAtBlockID(ctx, flow.HexToID("0x1"), nil, nil)
 		assert.EqualError(t, err, "bad request")
 	}))
+
+	t.Run("Invalid Result", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		handler.
+			On("executeScriptAtBlockID", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return("not base64!", nil)
+
+		_, err := client.ExecuteScriptAtBlockID(ctx, flow.HexToID("0x1"), nil, nil)
+		assert.ErrorContains(t, err, "illegal base64 data")
+	}))
 }
 
 func TestBaseClient_GetEvents(t *testing.T) {
@@ -552,3 +642,90 @@ func TestBaseClient_GetEvents(t *testing.T) {
 	}))
 
 }
+
+func TestBaseClient_GetNodeVersionInfo(t *testing.T) {
+	const handlerName = "getNodeVersionInfo"
+
+	t.Run("Success", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		sporkID := test.IdentifierGenerator().New()
+		handler.
+			On(handlerName, mock.Anything).
+			Return(&models.NodeVersionInfo{
+				Semver:               "v0.37.10",
+				Commit:               "abc123",
+				SporkId:              sporkID.String(),
+				ProtocolVersion:      "0",
+				SporkRootBlockHeight: "85981135",
+			}, nil)
+
+		info, err := client.GetNodeVersionInfo(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &flow.NodeVersionInfo{
+			Semver:               "v0.37.10",
+			Commit:               "abc123",
+			SporkID:              sporkID,
+			SporkRootBlockHeight: 85981135,
+		}, info)
+	}))
+
+	t.Run("Invalid Spork ID", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		handler.
+			On(handlerName, mock.Anything).
+			Return(&models.NodeVersionInfo{SporkId: "invalid", ProtocolVersion: "0", SporkRootBlockHeight: "0"}, nil)
+
+		_, err := client.GetNodeVersionInfo(ctx)
+		assert.Error(t, err)
+	}))
+}
+
+func TestClient_ChainID(t *testing.T) {
+	const handlerName = "getNetworkParameters"
+
+	t.Run("Cached", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		handler.
+			On(handlerName, mock.Anything).
+			Return(&models.NetworkParameters{ChainId: "flow-testnet"}, nil).
+			Once()
+
+		for i := 0; i < 2; i++ {
+			chainID, err := client.GetChainID(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, flow.Testnet, chainID)
+		}
+	}))
+
+	t.Run("Mismatch", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		handler.
+			On(handlerName, mock.Anything).
+			Return(&models.NetworkParameters{ChainId: "flow-mainnet"}, nil)
+		handler.
+			On("getBlocksByHeights", mock.Anything, mock.Anything, "", "").
+			Return([]*models.Block{}, nil)
+
+		client.SetExpectedChainID(flow.Testnet)
+
+		err := client.Ping(ctx)
+		assert.ErrorIs(t, err, access.ErrChainIDMismatch)
+
+		err = client.SendTransaction(ctx, *test.TransactionGenerator().New())
+		var mismatchErr *access.ChainIDMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+		assert.Equal(t, flow.Testnet, mismatchErr.Expected)
+		assert.Equal(t, flow.Mainnet, mismatchErr.Actual)
+		handler.AssertNotCalled(t, "sendTransaction", mock.Anything, mock.Anything)
+	}))
+
+	t.Run("Match", clientTest(func(ctx context.Context, t *testing.T, handler *mockHandler, client *Client) {
+		handler.
+			On(handlerName, mock.Anything).
+			Return(&models.NetworkParameters{ChainId: "flow-testnet"}, nil)
+		handler.
+			On("sendTransaction", mock.Anything, mock.Anything).
+			Return(nil)
+
+		client.SetExpectedChainID(flow.Testnet)
+
+		err := client.SendTransaction(ctx, *test.TransactionGenerator().New())
+		assert.NoError(t, err)
+	}))
+}
@@ -0,0 +1,85 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const gzipEncoding = "gzip"
+
+// WithCompression makes the client request gzip compressed responses and decompress them, which makes
+// large payloads such as blocks and events much cheaper to transfer over WAN links.
+//
+// The default Go transport already requests compressed responses, but not when compression is disabled
+// or the requests go through custom transports, so this option makes the client handle compression itself.
+func WithCompression() ClientOption {
+	return func(h *httpHandler) {
+		h.compressResponses = true
+	}
+}
+
+// WithRequestCompression compresses with gzip the bodies of the requests larger than minSize bytes,
+// such as transactions and scripts with large arguments.
+//
+// The access node, or a proxy in front of it, must accept gzip encoded request bodies.
+func WithRequestCompression(minSize int) ClientOption {
+	return func(h *httpHandler) {
+		h.compressRequests = true
+		h.compressMinSize = minSize
+	}
+}
+
+// requestBody returns the reader of the body sent with a request, compressed if request compression
+// is enabled and the body is large enough, and the content encoding of the body.
+func (h *httpHandler) requestBody(body []byte) (io.Reader, string, error) {
+	if !h.compressRequests || len(body) < h.compressMinSize {
+		return bytes.NewReader(body), "", nil
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(body); err != nil {
+		return nil, "", err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return &buf, gzipEncoding, nil
+}
+
+// readResponseBody reads the body of the response, decompressing it if it is gzip encoded.
+func readResponseBody(res *http.Response) ([]byte, error) {
+	if !strings.EqualFold(res.Header.Get("Content-Encoding"), gzipEncoding) || res.ContentLength == 0 {
+		return io.ReadAll(res.Body)
+	}
+
+	reader, err := gzip.NewReader(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
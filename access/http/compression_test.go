@@ -0,0 +1,93 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Compression(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if request.Header.Get("Accept-Encoding") != "gzip" {
+				_, _ = writer.Write([]byte("[]"))
+				return
+			}
+
+			writer.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(writer)
+			_, _ = gz.Write([]byte(`[{"header": {"height": "42"}}]`))
+			_ = gz.Close()
+		}))
+		defer server.Close()
+
+		// the transport doesn't handle compression itself
+		client := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+
+		h, err := newHandler(server.URL, WithHTTPClient(client), WithCompression())
+		require.NoError(t, err)
+
+		blocks, err := h.getBlocksByHeights(ctx, "42", "", "")
+		require.NoError(t, err)
+		require.Len(t, blocks, 1)
+		assert.Equal(t, "42", blocks[0].Header.Height)
+	})
+
+	t.Run("Requests", func(t *testing.T) {
+		var bodies []string
+		var encodings []string
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			var reader io.Reader = request.Body
+			if request.Header.Get("Content-Encoding") == "gzip" {
+				gz, err := gzip.NewReader(request.Body)
+				require.NoError(t, err)
+				reader = gz
+			}
+
+			body, err := io.ReadAll(reader)
+			require.NoError(t, err)
+
+			bodies = append(bodies, string(body))
+			encodings = append(encodings, request.Header.Get("Content-Encoding"))
+			_, _ = writer.Write([]byte("{}"))
+		}))
+		defer server.Close()
+
+		h, err := newHandler(server.URL, WithRequestCompression(100))
+		require.NoError(t, err)
+
+		large := `{"script": "` + strings.Repeat("a", 100) + `"}`
+		require.NoError(t, h.sendTransaction(ctx, []byte(large)))
+		require.NoError(t, h.sendTransaction(ctx, []byte(`{}`)))
+
+		assert.Equal(t, []string{large, "{}"}, bodies)
+		assert.Equal(t, []string{"gzip", ""}, encodings)
+	})
+}
@@ -20,43 +20,165 @@ package http
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/onflow/cadence"
 	cadenceJSON "github.com/onflow/cadence/encoding/json"
-	"github.com/pkg/errors"
 
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/access/http/models"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
 	"github.com/onflow/flow-go-sdk/crypto"
 )
 
-func toAddress(address string) flow.Address {
-	return flow.HexToAddress(address)
+// The conversion functions validate the values returned by the access node and return an error
+// describing the invalid field instead of panicking or silently using a zero value.
+
+// errMissingValue is returned when a required value is missing from a response.
+var errMissingValue = errors.New("missing value")
+
+func invalidValueError(field string, value string, err error) error {
+	return fmt.Errorf("invalid %s %q: %w", field, value, err)
+}
+
+func toUint(value string, field string) (uint64, error) {
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, invalidValueError(field, value, err)
+	}
+	return parsed, nil
+}
+
+func toUint16(value string, field string) (uint16, error) {
+	parsed, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0, invalidValueError(field, value, err)
+	}
+	return uint16(parsed), nil
+}
+
+func toInt(value string, field string) (int, error) {
+	parsed, err := strconv.ParseUint(value, 10, 31)
+	if err != nil {
+		return 0, invalidValueError(field, value, err)
+	}
+	return int(parsed), nil
 }
 
-func toKeys(keys []models.AccountPublicKey) []*flow.AccountKey {
+func toHex(value string, field string, maxLength int) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, invalidValueError(field, value, err)
+	}
+	if len(decoded) > maxLength {
+		return nil, invalidValueError(field, value, fmt.Errorf("longer than %d bytes", maxLength))
+	}
+	return decoded, nil
+}
+
+func toID(value string, field string) (flow.Identifier, error) {
+	decoded, err := toHex(value, field, len(flow.EmptyID))
+	if err != nil {
+		return flow.EmptyID, err
+	}
+	return flow.BytesToID(decoded), nil
+}
+
+// toOptionalID converts an ID which is empty when not set.
+func toOptionalID(value string, field string) (flow.Identifier, error) {
+	if value == "" {
+		return flow.EmptyID, nil
+	}
+	return toID(value, field)
+}
+
+func toStateCommitment(value string, field string) (flow.StateCommitment, error) {
+	id, err := toOptionalID(value, field)
+	return flow.StateCommitment(id), err
+}
+
+func toAddress(value string, field string) (flow.Address, error) {
+	decoded, err := toHex(value, field, flow.AddressLength)
+	if err != nil {
+		return flow.EmptyAddress, err
+	}
+	return flow.BytesToAddress(decoded), nil
+}
+
+func toBase64(value string, field string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, invalidValueError(field, value, err)
+	}
+	return decoded, nil
+}
+
+func toKeys(keys []models.AccountPublicKey) ([]*flow.AccountKey, error) {
 	accountKeys := make([]*flow.AccountKey, len(keys))
 
 	for i, key := range keys {
-		sigAlgo := crypto.StringToSignatureAlgorithm(string(*key.SigningAlgorithm))
-		pkey, _ := crypto.DecodePublicKeyHex(sigAlgo, strings.TrimPrefix(key.PublicKey, "0x")) // validation is done on AN
-
-		accountKeys[i] = &flow.AccountKey{
-			Index:          mustToInt(key.Index),
-			PublicKey:      pkey,
-			SigAlgo:        sigAlgo,
-			HashAlgo:       crypto.StringToHashAlgorithm(string(*key.HashingAlgorithm)),
-			Weight:         mustToInt(key.Weight),
-			SequenceNumber: mustToUint(key.SequenceNumber),
-			Revoked:        key.Revoked,
+		accountKey, err := toKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert account key %d: %w", i, err)
 		}
+		accountKeys[i] = accountKey
 	}
 
-	return accountKeys
+	return accountKeys, nil
+}
+
+func toKey(key models.AccountPublicKey) (*flow.AccountKey, error) {
+	if key.SigningAlgorithm == nil {
+		return nil, fmt.Errorf("signing algorithm: %w", errMissingValue)
+	}
+	if key.HashingAlgorithm == nil {
+		return nil, fmt.Errorf("hashing algorithm: %w", errMissingValue)
+	}
+
+	sigAlgo := crypto.StringToSignatureAlgorithm(string(*key.SigningAlgorithm))
+	if sigAlgo == crypto.UnknownSignatureAlgorithm {
+		return nil, fmt.Errorf("unsupported signing algorithm %q", *key.SigningAlgorithm)
+	}
+
+	hashAlgo := crypto.StringToHashAlgorithm(string(*key.HashingAlgorithm))
+	if hashAlgo == crypto.UnknownHashAlgorithm {
+		return nil, fmt.Errorf("unsupported hashing algorithm %q", *key.HashingAlgorithm)
+	}
+
+	publicKey, err := crypto.DecodePublicKeyHex(sigAlgo, strings.TrimPrefix(key.PublicKey, "0x"))
+	if err != nil {
+		return nil, invalidValueError("public key", key.PublicKey, err)
+	}
+
+	index, err := toInt(key.Index, "key index")
+	if err != nil {
+		return nil, err
+	}
+
+	weight, err := toInt(key.Weight, "key weight")
+	if err != nil {
+		return nil, err
+	}
+
+	sequenceNumber, err := toUint(key.SequenceNumber, "key sequence number")
+	if err != nil {
+		return nil, err
+	}
+
+	return &flow.AccountKey{
+		Index:          index,
+		PublicKey:      publicKey,
+		SigAlgo:        sigAlgo,
+		HashAlgo:       hashAlgo,
+		Weight:         weight,
+		SequenceNumber: sequenceNumber,
+		Revoked:        key.Revoked,
+	}, nil
 }
 
 func toContracts(contracts map[string]string) (map[string][]byte, error) {
@@ -64,7 +186,7 @@ func toContracts(contracts map[string]string) (map[string][]byte, error) {
 	for name, code := range contracts {
 		dec, err := base64.StdEncoding.DecodeString(code)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to decode contract %s: %w", name, err)
 		}
 
 		decoded[name] = dec
@@ -74,60 +196,193 @@ func toContracts(contracts map[string]string) (map[string][]byte, error) {
 }
 
 func toAccount(account *models.Account) (*flow.Account, error) {
+	if account == nil {
+		return nil, fmt.Errorf("account: %w", errMissingValue)
+	}
+
+	address, err := toAddress(account.Address, "account address")
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := toUint(account.Balance, "account balance")
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := toKeys(account.Keys)
+	if err != nil {
+		return nil, err
+	}
+
 	contracts, err := toContracts(account.Contracts)
 	if err != nil {
 		return nil, err
 	}
 
 	return &flow.Account{
-		Address:   toAddress(account.Address),
-		Balance:   mustToUint(account.Balance),
-		Keys:      toKeys(account.Keys),
+		Address:   address,
+		Balance:   balance,
+		Keys:      keys,
 		Contracts: contracts,
 	}, nil
 }
 
-func toBlockHeader(header *models.BlockHeader) *flow.BlockHeader {
+func toBlockHeader(header *models.BlockHeader) (*flow.BlockHeader, error) {
+	if header == nil {
+		return nil, fmt.Errorf("block header: %w", errMissingValue)
+	}
+
+	id, err := toID(header.Id, "block ID")
+	if err != nil {
+		return nil, err
+	}
+
+	parentID, err := toID(header.ParentId, "parent block ID")
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := toUint(header.Height, "block height")
+	if err != nil {
+		return nil, err
+	}
+
+	sigData, err := toOptionalBytes(header.ParentVoterSignature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode parent voter signature: %w", err)
+	}
+
 	return &flow.BlockHeader{
-		ID:        flow.HexToID(header.Id),
-		ParentID:  flow.HexToID(header.ParentId),
-		Height:    mustToUint(header.Height),
-		Timestamp: header.Timestamp,
+		ID:                 id,
+		ParentID:           parentID,
+		Height:             height,
+		Timestamp:          header.Timestamp,
+		ParentVoterSigData: sigData,
+	}, nil
+}
+
+func toIdentifiers(ids []string, field string) ([]flow.Identifier, error) {
+	if len(ids) == 0 {
+		return nil, nil
 	}
+
+	flowIDs := make([]flow.Identifier, len(ids))
+	for i, id := range ids {
+		flowID, err := toID(id, field)
+		if err != nil {
+			return nil, err
+		}
+		flowIDs[i] = flowID
+	}
+
+	return flowIDs, nil
 }
 
-func toCollectionGuarantees(guarantees []models.CollectionGuarantee) []*flow.CollectionGuarantee {
+// toOptionalBytes decodes a base64 value, leaving an absent value nil.
+func toOptionalBytes(value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	return base64.StdEncoding.DecodeString(value)
+}
+
+func toCollectionGuarantees(guarantees []models.CollectionGuarantee) ([]*flow.CollectionGuarantee, error) {
 	flowGuarantees := make([]*flow.CollectionGuarantee, len(guarantees))
 
 	for i, guarantee := range guarantees {
+		collectionID, err := toID(guarantee.CollectionId, "collection ID")
+		if err != nil {
+			return nil, err
+		}
+
+		signerIDs, err := toIdentifiers(guarantee.SignerIds, "signer ID")
+		if err != nil {
+			return nil, err
+		}
+
+		signature, err := toOptionalBytes(guarantee.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signature of collection guarantee %s: %w", guarantee.CollectionId, err)
+		}
+
 		flowGuarantees[i] = &flow.CollectionGuarantee{
-			flow.HexToID(guarantee.CollectionId),
+			CollectionID: collectionID,
+			SignerIDs:    signerIDs,
+			Signature:    signature,
 		}
 	}
 
-	return flowGuarantees
+	return flowGuarantees, nil
+}
+
+func toAggregatedSignatures(sigs []models.AggregatedSignature) ([]*flow.AggregatedSignature, error) {
+	if len(sigs) == 0 {
+		return nil, nil
+	}
+
+	flowSigs := make([]*flow.AggregatedSignature, len(sigs))
+
+	for i, sig := range sigs {
+		var signatures [][]byte
+		for _, ver := range sig.VerifierSignatures {
+			dec, err := base64.StdEncoding.DecodeString(ver)
+			if err != nil {
+				return nil, err
+			}
+			signatures = append(signatures, dec)
+		}
+
+		signerIDs, err := toIdentifiers(sig.SignerIds, "verifier ID")
+		if err != nil {
+			return nil, err
+		}
+
+		flowSigs[i] = &flow.AggregatedSignature{
+			VerifierSignatures: signatures,
+			SignerIDs:          signerIDs,
+		}
+	}
+
+	return flowSigs, nil
 }
 
 func toBlockSeals(seals []models.BlockSeal) ([]*flow.BlockSeal, error) {
 	flowSeal := make([]*flow.BlockSeal, len(seals))
 
 	for i, seal := range seals {
-		signatures := make([][]byte, 0)
-		for _, sig := range seal.AggregatedApprovalSignatures {
-			for _, ver := range sig.VerifierSignatures {
-				dec, err := base64.StdEncoding.DecodeString(ver)
-				if err != nil {
-					return nil, err
-				}
-				signatures = append(signatures, dec)
+		blockID, err := toID(seal.BlockId, "sealed block ID")
+		if err != nil {
+			return nil, err
+		}
+
+		resultID, err := toID(seal.ResultId, "sealed result ID")
+		if err != nil {
+			return nil, err
+		}
+
+		sigs, err := toAggregatedSignatures(seal.AggregatedApprovalSignatures)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode approval signatures of seal for block %s: %w", seal.BlockId, err)
+		}
+
+		var finalState []byte
+		if seal.FinalState != "" {
+			finalState, err = hex.DecodeString(strings.TrimPrefix(seal.FinalState, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode final state of seal for block %s: %w", seal.BlockId, err)
 			}
 		}
 
 		flowSeal[i] = &flow.BlockSeal{
-			BlockID: flow.HexToID(seal.BlockId),
-			// TODO: this needs to be changed to resultID
-			// https://github.com/onflow/flow-go/blob/3683183977f2ea769836d8a31997701b3dbced83/model/flow/seal.go#L42
-			ExecutionReceiptID: flow.HexToID(seal.ResultId),
+			BlockID: blockID,
+			// the REST API only exposes the result ID, which is kept as the receipt ID for
+			// compatibility with existing consumers
+			ExecutionReceiptID:     resultID,
+			ResultID:               resultID,
+			FinalState:             finalState,
+			AggregatedApprovalSigs: sigs,
 		}
 	}
 
@@ -135,13 +390,22 @@ func toBlockSeals(seals []models.BlockSeal) ([]*flow.BlockSeal, error) {
 }
 
 func toBlockPayload(payload *models.BlockPayload) (*flow.BlockPayload, error) {
+	if payload == nil { // payload is not included unless expanded
+		return &flow.BlockPayload{}, nil
+	}
+
+	guarantees, err := toCollectionGuarantees(payload.CollectionGuarantees)
+	if err != nil {
+		return nil, err
+	}
+
 	seals, err := toBlockSeals(payload.BlockSeals)
 	if err != nil {
 		return nil, err
 	}
 
 	return &flow.BlockPayload{
-		CollectionGuarantees: toCollectionGuarantees(payload.CollectionGuarantees),
+		CollectionGuarantees: guarantees,
 		Seals:                seals,
 	}, nil
 }
@@ -160,25 +424,42 @@ func toBlocks(blocks []*models.Block) ([]*flow.Block, error) {
 }
 
 func toBlock(block *models.Block) (*flow.Block, error) {
+	if block == nil {
+		return nil, fmt.Errorf("block: %w", errMissingValue)
+	}
+
+	header, err := toBlockHeader(block.Header)
+	if err != nil {
+		return nil, err
+	}
+
 	payload, err := toBlockPayload(block.Payload)
 	if err != nil {
 		return nil, err
 	}
 
 	return &flow.Block{
-		BlockHeader:  *toBlockHeader(block.Header),
+		BlockHeader:  *header,
 		BlockPayload: *payload,
 	}, nil
 }
 
-func toCollection(collection *models.Collection) *flow.Collection {
+func toCollection(collection *models.Collection) (*flow.Collection, error) {
+	if collection == nil {
+		return nil, fmt.Errorf("collection: %w", errMissingValue)
+	}
+
 	IDs := make([]flow.Identifier, len(collection.Transactions))
 	for i, tx := range collection.Transactions {
-		IDs[i] = flow.HexToID(tx.Id)
+		id, err := toID(tx.Id, "transaction ID")
+		if err != nil {
+			return nil, err
+		}
+		IDs[i] = id
 	}
 	return &flow.Collection{
 		TransactionIDs: IDs,
-	}
+	}, nil
 }
 
 func encodeScript(script []byte) string {
@@ -210,21 +491,11 @@ func toArgs(arguments []string) ([][]byte, error) {
 	return args, nil
 }
 
-func mustToUint(value string) uint64 {
-	parsed, _ := strconv.ParseUint(value, 10, 64) // we can ignore error since these values are validated before returned
-	return parsed
-}
-
-func mustToInt(value string) int {
-	parsed, _ := strconv.Atoi(value) // we can ignore error since these values are validated before returned
-	return parsed
-}
-
 func encodeCadenceArgs(args []cadence.Value) ([]string, error) {
 	encArgs := make([]string, len(args))
 
 	for i, a := range args {
-		jsonArg, err := cadenceJSON.Encode(a)
+		jsonArg, err := sdkcadence.EncodeJSONCDC(a)
 		if err != nil {
 			return nil, err
 		}
@@ -235,100 +506,191 @@ func encodeCadenceArgs(args []cadence.Value) ([]string, error) {
 	return encArgs, nil
 }
 
+// decodeCadenceValue decodes a base64 encoded JSON-CDC value, such as a script result, decoding the
+// base64 encoding as the value is read instead of buffering the decoded encoding.
 func decodeCadenceValue(value string, options []cadenceJSON.Option) (cadence.Value, error) {
-	decoded, err := base64.StdEncoding.DecodeString(value)
+	return sdkcadence.DecodeJSON(base64.NewDecoder(base64.StdEncoding, strings.NewReader(value)), options...)
+}
+
+func toProposalKey(key *models.ProposalKey) (flow.ProposalKey, error) {
+	if key == nil {
+		return flow.ProposalKey{}, fmt.Errorf("proposal key: %w", errMissingValue)
+	}
+
+	address, err := toAddress(key.Address, "proposal key address")
 	if err != nil {
-		return nil, err
+		return flow.ProposalKey{}, err
 	}
 
-	return cadenceJSON.Decode(nil, decoded, options...)
-}
+	keyIndex, err := toInt(key.KeyIndex, "proposal key index")
+	if err != nil {
+		return flow.ProposalKey{}, err
+	}
 
-func toProposalKey(key *models.ProposalKey) flow.ProposalKey {
-	return flow.ProposalKey{
-		Address:        flow.HexToAddress(key.Address),
-		KeyIndex:       mustToInt(key.KeyIndex),
-		SequenceNumber: mustToUint(key.SequenceNumber),
+	sequenceNumber, err := toUint(key.SequenceNumber, "proposal key sequence number")
+	if err != nil {
+		return flow.ProposalKey{}, err
 	}
+
+	return flow.ProposalKey{
+		Address:        address,
+		KeyIndex:       keyIndex,
+		SequenceNumber: sequenceNumber,
+	}, nil
 }
 
-func toSignatures(signatures []models.TransactionSignature) []flow.TransactionSignature {
+func toSignatures(signatures []models.TransactionSignature) ([]flow.TransactionSignature, error) {
 	sigs := make([]flow.TransactionSignature, len(signatures))
 	for i, sig := range signatures {
-		signature, _ := base64.StdEncoding.DecodeString(sig.Signature) // signatures are validated and must be valid
+		address, err := toAddress(sig.Address, "signer address")
+		if err != nil {
+			return nil, err
+		}
+
+		keyIndex, err := toInt(sig.KeyIndex, "signer key index")
+		if err != nil {
+			return nil, err
+		}
+
+		signature, err := toBase64(sig.Signature, "signature")
+		if err != nil {
+			return nil, err
+		}
+
 		sigs[i] = flow.TransactionSignature{
-			Address:   flow.HexToAddress(sig.Address),
-			KeyIndex:  mustToInt(sig.KeyIndex),
+			Address:   address,
+			KeyIndex:  keyIndex,
 			Signature: signature,
 		}
 	}
-	return sigs
+	return sigs, nil
 }
 
 func toTransaction(tx *models.Transaction) (*flow.Transaction, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("transaction: %w", errMissingValue)
+	}
+
 	script, err := toScript(tx.Script)
 	if err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("failed to decode script of transaction with ID %s", tx.Id))
+		return nil, fmt.Errorf("failed to decode script of transaction with ID %s: %w", tx.Id, err)
 	}
 	args, err := toArgs(tx.Arguments)
 	if err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("failed to decode arguments of transaction with ID %s", tx.Id))
+		return nil, fmt.Errorf("failed to decode arguments of transaction with ID %s: %w", tx.Id, err)
+	}
+
+	referenceBlockID, err := toID(tx.ReferenceBlockId, "reference block ID")
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err := toUint(tx.GasLimit, "gas limit")
+	if err != nil {
+		return nil, err
+	}
+
+	proposalKey, err := toProposalKey(tx.ProposalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	payer, err := toAddress(tx.Payer, "payer address")
+	if err != nil {
+		return nil, err
 	}
 
 	auths := make([]flow.Address, len(tx.Authorizers))
 	for i, a := range tx.Authorizers {
-		auths[i] = flow.HexToAddress(a)
+		auths[i], err = toAddress(a, "authorizer address")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	payloadSignatures, err := toSignatures(tx.PayloadSignatures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode payload signatures of transaction with ID %s: %w", tx.Id, err)
+	}
+
+	envelopeSignatures, err := toSignatures(tx.EnvelopeSignatures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode envelope signatures of transaction with ID %s: %w", tx.Id, err)
 	}
 
 	return &flow.Transaction{
 		Script:             script,
 		Arguments:          args,
-		ReferenceBlockID:   flow.HexToID(tx.ReferenceBlockId),
-		GasLimit:           mustToUint(tx.GasLimit),
-		ProposalKey:        toProposalKey(tx.ProposalKey),
-		Payer:              flow.HexToAddress(tx.Payer),
+		ReferenceBlockID:   referenceBlockID,
+		GasLimit:           gasLimit,
+		ProposalKey:        proposalKey,
+		Payer:              payer,
 		Authorizers:        auths,
-		PayloadSignatures:  toSignatures(tx.PayloadSignatures),
-		EnvelopeSignatures: toSignatures(tx.EnvelopeSignatures),
+		PayloadSignatures:  payloadSignatures,
+		EnvelopeSignatures: envelopeSignatures,
 	}, nil
 }
 
-func toTransactionStatus(status *models.TransactionStatus) flow.TransactionStatus {
+func toTransactionStatus(status *models.TransactionStatus) (flow.TransactionStatus, error) {
+	if status == nil {
+		return flow.TransactionStatusUnknown, nil
+	}
+
 	switch *status {
 	case models.PENDING_TransactionStatus:
-		return flow.TransactionStatusPending
+		return flow.TransactionStatusPending, nil
 	case models.SEALED_TransactionStatus:
-		return flow.TransactionStatusSealed
+		return flow.TransactionStatusSealed, nil
 	case models.FINALIZED_TransactionStatus:
-		return flow.TransactionStatusFinalized
+		return flow.TransactionStatusFinalized, nil
 	case models.EXECUTED_TransactionStatus:
-		return flow.TransactionStatusExecuted
+		return flow.TransactionStatusExecuted, nil
 	case models.EXPIRED_TransactionStatus:
-		return flow.TransactionStatusExpired
+		return flow.TransactionStatusExpired, nil
 	default:
-		return flow.TransactionStatusUnknown
+		return flow.TransactionStatusUnknown, fmt.Errorf("unsupported transaction status %q", *status)
 	}
 }
 
 func toEvents(events []models.Event, options []cadenceJSON.Option) ([]flow.Event, error) {
 	flowEvents := make([]flow.Event, len(events))
 	for i, e := range events {
-		payload, err := base64.StdEncoding.DecodeString(e.Payload)
+		payload, err := toBase64(e.Payload, "event payload")
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := sdkcadence.DecodeJSONCDC(payload, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		event, ok := value.(cadence.Event)
+		if !ok {
+			return nil, fmt.Errorf("expected Event value, got %T", value)
+		}
+
+		transactionID, err := toID(e.TransactionId, "event transaction ID")
 		if err != nil {
 			return nil, err
 		}
 
-		event, err := cadenceJSON.Decode(nil, payload, options...)
+		transactionIndex, err := toInt(e.TransactionIndex, "event transaction index")
+		if err != nil {
+			return nil, err
+		}
+
+		eventIndex, err := toInt(e.EventIndex, "event index")
 		if err != nil {
 			return nil, err
 		}
 
 		flowEvents[i] = flow.Event{
 			Type:             e.Type_,
-			TransactionID:    flow.HexToID(e.TransactionId),
-			TransactionIndex: mustToInt(e.TransactionIndex),
-			EventIndex:       mustToInt(e.EventIndex),
-			Value:            event.(cadence.Event),
+			TransactionID:    transactionID,
+			TransactionIndex: transactionIndex,
+			EventIndex:       eventIndex,
+			Value:            event,
 			Payload:          payload,
 		}
 	}
@@ -343,9 +705,19 @@ func toBlockEvents(blockEvents []models.BlockEvents, options []cadenceJSON.Optio
 			return nil, err
 		}
 
+		blockID, err := toID(block.BlockId, "block ID")
+		if err != nil {
+			return nil, err
+		}
+
+		height, err := toUint(block.BlockHeight, "block height")
+		if err != nil {
+			return nil, err
+		}
+
 		blocks[i] = flow.BlockEvents{
-			BlockID:        flow.HexToID(block.BlockId),
-			Height:         mustToUint(block.BlockHeight),
+			BlockID:        blockID,
+			Height:         height,
 			BlockTimestamp: block.BlockTimestamp,
 			Events:         events,
 		}
@@ -354,21 +726,36 @@ func toBlockEvents(blockEvents []models.BlockEvents, options []cadenceJSON.Optio
 }
 
 func toTransactionResult(txr *models.TransactionResult, options []cadenceJSON.Option) (*flow.TransactionResult, error) {
+	if txr == nil {
+		return nil, fmt.Errorf("transaction result: %w", errMissingValue)
+	}
+
 	events, err := toEvents(txr.Events, options)
 	if err != nil {
 		return nil, err
 	}
 
+	status, err := toTransactionStatus(txr.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	// the result of a pending transaction has no block ID
+	blockID, err := toOptionalID(txr.BlockId, "block ID")
+	if err != nil {
+		return nil, err
+	}
+
 	var txErr error
 	if txr.ErrorMessage != "" {
-		txErr = fmt.Errorf(txr.ErrorMessage)
+		txErr = errors.New(txr.ErrorMessage)
 	}
 
 	return &flow.TransactionResult{
-		Status:  toTransactionStatus(txr.Status),
+		Status:  status,
 		Error:   txErr,
 		Events:  events,
-		BlockID: flow.HexToID(txr.BlockId),
+		BlockID: blockID,
 	}, nil
 }
 
@@ -408,34 +795,147 @@ func encodeTransaction(tx flow.Transaction) ([]byte, error) {
 	})
 }
 
-func toExecutionResults(result models.ExecutionResult) *flow.ExecutionResult {
-	events := make([]*flow.ServiceEvent, len(result.Events))
-	for i, e := range result.Events {
-		events[i] = &flow.ServiceEvent{
+func toServiceEvents(events []models.Event) ([]*flow.ServiceEvent, error) {
+	serviceEvents := make([]*flow.ServiceEvent, len(events))
+	for i, e := range events {
+		payload, err := base64.StdEncoding.DecodeString(e.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode payload of service event %s: %w", e.Type_, err)
+		}
+
+		serviceEvents[i] = &flow.ServiceEvent{
 			Type:    e.Type_,
-			Payload: []byte(e.Payload),
+			Payload: payload,
 		}
 	}
 
-	chunks := make([]*flow.Chunk, len(result.Chunks))
+	return serviceEvents, nil
+}
+
+func toChunk(chunk models.Chunk) (*flow.Chunk, error) {
+	var eventCollection crypto.Hash
+	if chunk.EventCollection != "" {
+		decoded, err := hex.DecodeString(chunk.EventCollection)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode event collection of chunk %s: %w", chunk.Index, err)
+		}
+		eventCollection = decoded
+	}
+
+	collectionIndex, err := toUint(chunk.CollectionIndex, "chunk collection index")
+	if err != nil {
+		return nil, err
+	}
+
+	startState, err := toStateCommitment(chunk.StartState, "chunk start state")
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := toID(chunk.BlockId, "chunk block ID")
+	if err != nil {
+		return nil, err
+	}
+
+	totalComputationUsed, err := toUint(chunk.TotalComputationUsed, "chunk total computation used")
+	if err != nil {
+		return nil, err
+	}
+
+	numberOfTransactions, err := toUint16(chunk.NumberOfTransactions, "chunk number of transactions")
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := toUint(chunk.Index, "chunk index")
+	if err != nil {
+		return nil, err
+	}
+
+	endState, err := toStateCommitment(chunk.EndState, "chunk end state")
+	if err != nil {
+		return nil, err
+	}
+
+	return &flow.Chunk{
+		CollectionIndex:      uint(collectionIndex),
+		StartState:           startState,
+		EventCollection:      eventCollection,
+		BlockID:              blockID,
+		TotalComputationUsed: totalComputationUsed,
+		NumberOfTransactions: numberOfTransactions,
+		Index:                index,
+		EndState:             endState,
+	}, nil
+}
 
+func toExecutionResult(result models.ExecutionResult) (*flow.ExecutionResult, error) {
+	events, err := toServiceEvents(result.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([]*flow.Chunk, len(result.Chunks))
 	for i, chunk := range result.Chunks {
-		chunks[i] = &flow.Chunk{
-			CollectionIndex:      uint(mustToUint(chunk.CollectionIndex)),
-			StartState:           flow.HexToStateCommitment(chunk.StartState),
-			EventCollection:      crypto.Hash(chunk.EventCollection),
-			BlockID:              flow.HexToID(chunk.BlockId),
-			TotalComputationUsed: mustToUint(chunk.TotalComputationUsed),
-			NumberOfTransactions: uint16(mustToUint(chunk.NumberOfTransactions)),
-			Index:                mustToUint(chunk.Index),
-			EndState:             flow.HexToStateCommitment(chunk.EndState),
+		chunks[i], err = toChunk(chunk)
+		if err != nil {
+			return nil, err
 		}
 	}
 
+	previousResultID, err := toID(result.PreviousResultId, "previous result ID")
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := toID(result.BlockId, "block ID")
+	if err != nil {
+		return nil, err
+	}
+
 	return &flow.ExecutionResult{
-		PreviousResultID: flow.HexToID(result.PreviousResultId),
-		BlockID:          flow.HexToID(result.BlockId),
+		PreviousResultID: previousResultID,
+		BlockID:          blockID,
 		Chunks:           chunks,
 		ServiceEvents:    events,
+	}, nil
+}
+
+func toNodeVersionInfo(info *models.NodeVersionInfo) (*flow.NodeVersionInfo, error) {
+	if info == nil {
+		return nil, fmt.Errorf("node version info: %w", errMissingValue)
+	}
+
+	sporkID, err := toID(info.SporkId, "spork ID")
+	if err != nil {
+		return nil, err
 	}
+
+	protocolVersion, err := toUint(info.ProtocolVersion, "protocol version")
+	if err != nil {
+		return nil, err
+	}
+
+	sporkRootHeight, err := toUint(info.SporkRootBlockHeight, "spork root block height")
+	if err != nil {
+		return nil, err
+	}
+
+	// access nodes running older versions don't report their root block height
+	var nodeRootHeight uint64
+	if info.NodeRootBlockHeight != "" {
+		nodeRootHeight, err = toUint(info.NodeRootBlockHeight, "node root block height")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &flow.NodeVersionInfo{
+		Semver:               info.Semver,
+		Commit:               info.Commit,
+		SporkID:              sporkID,
+		ProtocolVersion:      protocolVersion,
+		SporkRootBlockHeight: sporkRootHeight,
+		NodeRootBlockHeight:  nodeRootHeight,
+	}, nil
 }
@@ -0,0 +1,222 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-go-sdk/access/http/models"
+)
+
+func Test_ConvertMalformedValues(t *testing.T) {
+	invalidStatus := models.TransactionStatus("Lost")
+	invalidAlgo := models.SigningAlgorithm("RSA")
+
+	tests := []struct {
+		name    string
+		convert func() error
+	}{
+		{"block ID", func() error {
+			block := blockFlowFixture()
+			block.Header.Id = "not hex"
+			_, err := toBlock(&block)
+			return err
+		}},
+		{"too long block ID", func() error {
+			block := blockFlowFixture()
+			block.Header.Id += "00"
+			_, err := toBlock(&block)
+			return err
+		}},
+		{"block height", func() error {
+			block := blockFlowFixture()
+			block.Header.Height = "-1"
+			_, err := toBlock(&block)
+			return err
+		}},
+		{"missing block header", func() error {
+			block := blockFlowFixture()
+			block.Header = nil
+			_, err := toBlock(&block)
+			return err
+		}},
+		{"guarantee signer ID", func() error {
+			block := blockFlowFixture()
+			block.Payload.CollectionGuarantees[0].SignerIds[0] = "1"
+			_, err := toBlock(&block)
+			return err
+		}},
+		{"account address", func() error {
+			account := accountFlowFixture()
+			account.Address = "0x0102030405060708090a"
+			_, err := toAccount(&account)
+			return err
+		}},
+		{"account balance", func() error {
+			account := accountFlowFixture()
+			account.Balance = "1.5"
+			_, err := toAccount(&account)
+			return err
+		}},
+		{"missing key hashing algorithm", func() error {
+			account := accountFlowFixture()
+			account.Keys[0].HashingAlgorithm = nil
+			_, err := toAccount(&account)
+			return err
+		}},
+		{"key signing algorithm", func() error {
+			account := accountFlowFixture()
+			account.Keys[0].SigningAlgorithm = &invalidAlgo
+			_, err := toAccount(&account)
+			return err
+		}},
+		{"public key", func() error {
+			account := accountFlowFixture()
+			account.Keys[0].PublicKey = "0x1234"
+			_, err := toAccount(&account)
+			return err
+		}},
+		{"key index", func() error {
+			account := accountFlowFixture()
+			account.Keys[0].Index = "99999999999999999999"
+			_, err := toAccount(&account)
+			return err
+		}},
+		{"missing proposal key", func() error {
+			tx := transactionFlowFixture()
+			tx.ProposalKey = nil
+			_, err := toTransaction(&tx)
+			return err
+		}},
+		{"gas limit", func() error {
+			tx := transactionFlowFixture()
+			tx.GasLimit = "lots"
+			_, err := toTransaction(&tx)
+			return err
+		}},
+		{"signature", func() error {
+			tx := transactionFlowFixture()
+			tx.EnvelopeSignatures[0].Signature = "%%%"
+			_, err := toTransaction(&tx)
+			return err
+		}},
+		{"transaction status", func() error {
+			result := transactionResultFlowFixture()
+			result.Status = &invalidStatus
+			_, err := toTransactionResult(&result, nil)
+			return err
+		}},
+		{"event index", func() error {
+			result := transactionResultFlowFixture()
+			result.Events[0].EventIndex = "first"
+			_, err := toTransactionResult(&result, nil)
+			return err
+		}},
+		{"events block height", func() error {
+			events := blockEventsFlowFixture()
+			events.BlockHeight = ""
+			_, err := toBlockEvents([]models.BlockEvents{events}, nil)
+			return err
+		}},
+		{"chunk number of transactions", func() error {
+			result := executionResultFlowFixture()
+			result.Chunks[0].NumberOfTransactions = "70000"
+			_, err := toExecutionResult(result)
+			return err
+		}},
+		{"collection transaction ID", func() error {
+			collection := collectionFlowFixture()
+			collection.Transactions[0].Id = "xyz"
+			_, err := toCollection(&collection)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.convert())
+		})
+	}
+
+	t.Run("Error message with format verbs", func(t *testing.T) {
+		result := transactionResultFlowFixture()
+		result.ErrorMessage = "failed with 100%s"
+
+		converted, err := toTransactionResult(&result, nil)
+		assert.NoError(t, err)
+		assert.EqualError(t, converted.Error, "failed with 100%s")
+	})
+}
+
+// fuzzConversion adds the JSON encoding of the seed to the corpus and checks that converting
+// arbitrary JSON decoded into the model never panics.
+func fuzzConversion[T any](f *testing.F, seed T, convert func(*T) error) {
+	encoded, err := json.Marshal(seed)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(encoded)
+	f.Add([]byte(`{}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var model T
+		if json.Unmarshal(data, &model) != nil {
+			return
+		}
+		_ = convert(&model)
+	})
+}
+
+func FuzzToBlock(f *testing.F) {
+	fuzzConversion(f, blockFlowFixture(), func(block *models.Block) error {
+		_, err := toBlock(block)
+		return err
+	})
+}
+
+func FuzzToAccount(f *testing.F) {
+	fuzzConversion(f, accountFlowFixture(), func(account *models.Account) error {
+		_, err := toAccount(account)
+		return err
+	})
+}
+
+func FuzzToTransaction(f *testing.F) {
+	fuzzConversion(f, transactionFlowFixture(), func(tx *models.Transaction) error {
+		_, err := toTransaction(tx)
+		return err
+	})
+}
+
+func FuzzToTransactionResult(f *testing.F) {
+	fuzzConversion(f, transactionResultFlowFixture(), func(result *models.TransactionResult) error {
+		_, err := toTransactionResult(result, nil)
+		return err
+	})
+}
+
+func FuzzToExecutionResult(f *testing.F) {
+	fuzzConversion(f, executionResultFlowFixture(), func(result *models.ExecutionResult) error {
+		_, err := toExecutionResult(*result)
+		return err
+	})
+}
@@ -20,14 +20,17 @@ package http
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
 	"github.com/onflow/cadence"
 
 	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_ConvertBlock(t *testing.T) {
@@ -43,6 +46,54 @@ func Test_ConvertBlock(t *testing.T) {
 	assert.Equal(t, block.ParentID.String(), httpBlock.Header.ParentId)
 	assert.Len(t, block.BlockPayload.CollectionGuarantees, len(httpBlock.Payload.CollectionGuarantees))
 	assert.Equal(t, block.BlockPayload.CollectionGuarantees[0].CollectionID.String(), httpBlock.Payload.CollectionGuarantees[0].CollectionId)
+
+	t.Run("Signatures", func(t *testing.T) {
+		sigData, _ := base64.StdEncoding.DecodeString(httpBlock.Header.ParentVoterSignature)
+		assert.Equal(t, sigData, block.ParentVoterSigData)
+
+		httpGuarantee := httpBlock.Payload.CollectionGuarantees[0]
+		guarantee := block.CollectionGuarantees[0]
+		signature, _ := base64.StdEncoding.DecodeString(httpGuarantee.Signature)
+		assert.Equal(t, signature, guarantee.Signature)
+		require.Len(t, guarantee.SignerIDs, len(httpGuarantee.SignerIds))
+		for i, id := range httpGuarantee.SignerIds {
+			assert.Equal(t, id, guarantee.SignerIDs[i].String())
+		}
+
+		httpSeal := httpBlock.Payload.BlockSeals[0]
+		seal := block.Seals[0]
+		assert.Equal(t, httpSeal.ResultId, seal.ResultID.String())
+		assert.Equal(t, httpSeal.FinalState, hex.EncodeToString(seal.FinalState))
+		require.Len(t, seal.AggregatedApprovalSigs, 1)
+		verifierSig, _ := base64.StdEncoding.DecodeString(httpSeal.AggregatedApprovalSignatures[0].VerifierSignatures[0])
+		assert.Equal(t, [][]byte{verifierSig}, seal.AggregatedApprovalSigs[0].VerifierSignatures)
+		assert.Equal(t, httpSeal.AggregatedApprovalSignatures[0].SignerIds[0], seal.AggregatedApprovalSigs[0].SignerIDs[0].String())
+	})
+
+	t.Run("Same as gRPC", func(t *testing.T) {
+		expected := test.BlockGenerator().New()
+		expected.CollectionGuarantees = expected.CollectionGuarantees[:1]
+		// the REST API only exposes the result ID of seals
+		expected.Seals[0].ExecutionReceiptID = expected.Seals[0].ResultID
+
+		assert.Equal(t, expected, block)
+	})
+
+	t.Run("Invalid guarantee signature", func(t *testing.T) {
+		httpBlock := blockFlowFixture()
+		httpBlock.Payload.CollectionGuarantees[0].Signature = "not base64"
+
+		_, err := toBlock(&httpBlock)
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid final state", func(t *testing.T) {
+		httpBlock := blockFlowFixture()
+		httpBlock.Payload.BlockSeals[0].FinalState = "zz"
+
+		_, err := toBlock(&httpBlock)
+		assert.Error(t, err)
+	})
 }
 
 func Test_ConvertAccount(t *testing.T) {
@@ -63,7 +114,8 @@ func Test_ConvertAccount(t *testing.T) {
 func Test_ConvertCollection(t *testing.T) {
 	httpColl := collectionFlowFixture()
 
-	collection := toCollection(&httpColl)
+	collection, err := toCollection(&httpColl)
+	require.NoError(t, err)
 
 	assert.Len(t, collection.TransactionIDs, len(httpColl.Transactions))
 	assert.Equal(t, collection.TransactionIDs[0].String(), httpColl.Transactions[0].Id)
@@ -127,8 +179,29 @@ func Test_EncodeCadenceArgs(t *testing.T) {
 
 func Test_ConvertExecutionResults(t *testing.T) {
 	exec := executionResultFlowFixture()
-	res := toExecutionResults(exec)
+	res, err := toExecutionResult(exec)
+	require.NoError(t, err)
 	assert.Equal(t, res.BlockID.String(), exec.BlockId)
 	assert.Equal(t, res.Chunks[0].BlockID.String(), exec.Chunks[0].BlockId)
-	assert.Len(t, res.Chunks, 1)
+	assert.Len(t, res.Chunks, 2)
+
+	t.Run("Same as gRPC", func(t *testing.T) {
+		assert.Equal(t, test.ExecutionResultGenerator().New(), res)
+	})
+
+	t.Run("Invalid event collection", func(t *testing.T) {
+		exec := executionResultFlowFixture()
+		exec.Chunks[0].EventCollection = "zz"
+
+		_, err := toExecutionResult(exec)
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid service event payload", func(t *testing.T) {
+		exec := executionResultFlowFixture()
+		exec.Events[0].Payload = "not base64"
+
+		_, err := toExecutionResult(exec)
+		assert.Error(t, err)
+	})
 }
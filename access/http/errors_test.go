@@ -0,0 +1,123 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+func TestHTTPError_Is(t *testing.T) {
+	tests := []struct {
+		code     int
+		expected error
+	}{
+		{http.StatusNotFound, access.ErrNotFound},
+		{http.StatusBadRequest, access.ErrInvalidArgument},
+		{http.StatusTooManyRequests, access.ErrRateLimited},
+		{http.StatusBadGateway, access.ErrUnavailable},
+		{http.StatusServiceUnavailable, access.ErrUnavailable},
+		{http.StatusGatewayTimeout, access.ErrUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.code), func(t *testing.T) {
+			err := fmt.Errorf("request failed: %w", HTTPError{Code: tt.code, Message: "failed"})
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.False(t, errors.Is(err, access.ErrOutOfRange))
+		})
+	}
+
+	t.Run("Unmapped status code", func(t *testing.T) {
+		err := HTTPError{Code: http.StatusInternalServerError, Message: "failed"}
+
+		assert.False(t, errors.Is(err, access.ErrUnavailable))
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		err := HTTPError{Code: http.StatusBadRequest, Message: "height 1000 is out of range"}
+
+		assert.ErrorIs(t, err, access.ErrOutOfRange)
+		assert.ErrorIs(t, err, access.ErrInvalidArgument)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+	assert.Zero(t, parseRetryAfter("", now))
+	assert.Zero(t, parseRetryAfter("-1", now))
+	assert.Zero(t, parseRetryAfter("soon", now))
+	assert.Zero(t, parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+}
+
+func TestHandler_RetryAfter(t *testing.T) {
+	var calls int32
+	var retriedAt time.Time
+	var failedAt time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			failedAt = time.Now()
+			writer.Header().Set("Retry-After", "1")
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		retriedAt = time.Now()
+		_, _ = writer.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	t.Run("Returned in error", func(t *testing.T) {
+		h, err := newHandler(server.URL)
+		require.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+		require.Error(t, err)
+
+		assert.Equal(t, time.Second, access.RetryAfter(err))
+		assert.True(t, access.IsRetryable(err))
+		assert.ErrorIs(t, err, access.ErrRateLimited)
+	})
+
+	t.Run("Respected by retries", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+
+		h, err := newHandler(server.URL, WithRetry(testRetryConfig()))
+		require.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+		require.NoError(t, err)
+
+		assert.GreaterOrEqual(t, retriedAt.Sub(failedAt), time.Second)
+	})
+}
@@ -20,8 +20,10 @@ package http
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/access/http/models"
 	"github.com/onflow/flow-go-sdk/test"
 )
@@ -64,6 +66,8 @@ func accountKeyFlowFixture() models.AccountPublicKey {
 
 func blockFlowFixture() models.Block {
 	block := test.BlockGenerator().New()
+	guarantee := block.CollectionGuarantees[0]
+	seal := block.Seals[0]
 
 	return models.Block{
 		Header: &models.BlockHeader{
@@ -71,19 +75,23 @@ func blockFlowFixture() models.Block {
 			ParentId:             block.ParentID.String(),
 			Height:               fmt.Sprintf("%d", block.Height),
 			Timestamp:            block.Timestamp,
-			ParentVoterSignature: base64.StdEncoding.EncodeToString([]byte("test")),
+			ParentVoterSignature: base64.StdEncoding.EncodeToString(block.ParentVoterSigData),
 		},
 		Payload: &models.BlockPayload{
 			CollectionGuarantees: []models.CollectionGuarantee{{
-				CollectionId: block.CollectionGuarantees[0].CollectionID.String(),
+				CollectionId: guarantee.CollectionID.String(),
+				SignerIds:    []string{guarantee.SignerIDs[0].String(), guarantee.SignerIDs[1].String()},
+				Signature:    base64.StdEncoding.EncodeToString(guarantee.Signature),
 			}},
 			BlockSeals: []models.BlockSeal{{
-				BlockId:    block.Seals[0].BlockID.String(),
-				ResultId:   block.Seals[0].ExecutionReceiptID.String(),
-				FinalState: "",
+				BlockId:    seal.BlockID.String(),
+				ResultId:   seal.ResultID.String(),
+				FinalState: hex.EncodeToString(seal.FinalState),
 				AggregatedApprovalSignatures: []models.AggregatedSignature{{
-					VerifierSignatures: []string{"dGVzdA=="},
-					SignerIds:          []string{"1"},
+					VerifierSignatures: []string{
+						base64.StdEncoding.EncodeToString(seal.AggregatedApprovalSigs[0].VerifierSignatures[0]),
+					},
+					SignerIds: []string{seal.AggregatedApprovalSigs[0].SignerIDs[0].String()},
 				}},
 			}},
 		},
@@ -190,26 +198,37 @@ func blockEventsFlowFixture() models.BlockEvents {
 }
 
 func executionResultFlowFixture() models.ExecutionResult {
-	block := test.BlockGenerator().New()
-	events := eventsFlowFixture(4)
+	result := test.ExecutionResultGenerator().New()
 	id := test.IdentifierGenerator().New()
-	prevId := test.IdentifierGenerator().New()
+
+	chunks := make([]models.Chunk, len(result.Chunks))
+	for i, chunk := range result.Chunks {
+		chunks[i] = models.Chunk{
+			BlockId:              chunk.BlockID.String(),
+			CollectionIndex:      fmt.Sprintf("%d", chunk.CollectionIndex),
+			StartState:           flow.Identifier(chunk.StartState).String(),
+			EndState:             flow.Identifier(chunk.EndState).String(),
+			EventCollection:      hex.EncodeToString(chunk.EventCollection),
+			Index:                fmt.Sprintf("%d", chunk.Index),
+			NumberOfTransactions: fmt.Sprintf("%d", chunk.NumberOfTransactions),
+			TotalComputationUsed: fmt.Sprintf("%d", chunk.TotalComputationUsed),
+		}
+	}
+
+	events := make([]models.Event, len(result.ServiceEvents))
+	for i, event := range result.ServiceEvents {
+		events[i] = models.Event{
+			Type_:   event.Type,
+			Payload: base64.StdEncoding.EncodeToString(event.Payload),
+		}
+	}
 
 	return models.ExecutionResult{
-		Id:      id.String(),
-		BlockId: block.ID.String(),
-		Events:  events,
-		Chunks: []models.Chunk{{
-			BlockId:              block.ID.String(),
-			CollectionIndex:      block.CollectionGuarantees[0].CollectionID.String(),
-			StartState:           "",
-			EndState:             "",
-			EventCollection:      "",
-			Index:                id.String(),
-			NumberOfTransactions: "2",
-			TotalComputationUsed: "100",
-		}},
-		PreviousResultId: prevId.String(),
+		Id:               id.String(),
+		BlockId:          result.BlockID.String(),
+		Events:           events,
+		Chunks:           chunks,
+		PreviousResultId: result.PreviousResultID.String(),
 		Links:            nil,
 	}
 }
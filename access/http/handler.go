@@ -19,16 +19,17 @@
 package http
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/onflow/flow-go-sdk/access"
 	"github.com/onflow/flow-go-sdk/access/http/models"
 
 	"github.com/pkg/errors"
@@ -38,33 +39,277 @@ type queryOpts interface {
 	toQuery() (string, string)
 }
 
+// An HTTPError is an error response returned by the access node.
+//
+// The error message is the message provided by the access node. The request and response details
+// are included when the error is formatted with %+v, so they end up in logged errors.
 type HTTPError struct {
 	Url     string
 	Code    int
 	Message string
+	// Method is the HTTP method of the request.
+	Method string `json:"-"`
+	// Body is the raw body of the response.
+	Body []byte `json:"-"`
+	// RequestID is the request ID assigned by the access node or a proxy in front of it,
+	// or empty if the response had no request ID header.
+	RequestID string `json:"-"`
+
+	retryAfter time.Duration
 }
 
+// requestIDHeaders are the response headers checked in order for the ID of the request.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "X-Correlation-Id"}
+
 func (h HTTPError) Error() string {
 	return h.Message
 }
 
+// Details returns a description of the failed request, including the request method and URL,
+// the status code, the request ID and the response body.
+func (h HTTPError) Details() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s failed with status %d", h.Method, h.Url, h.Code)
+	if h.RequestID != "" {
+		fmt.Fprintf(&b, " (request ID %s)", h.RequestID)
+	}
+	fmt.Fprintf(&b, ": %s", h.Message)
+	if len(h.Body) > 0 && string(h.Body) != h.Message {
+		fmt.Fprintf(&b, "\nresponse body: %s", h.Body)
+	}
+	return b.String()
+}
+
+// Format formats the error message, or the error details with the %+v verb.
+func (h HTTPError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		_, _ = io.WriteString(s, h.Details())
+	case verb == 'q':
+		_, _ = fmt.Fprintf(s, "%q", h.Error())
+	default:
+		_, _ = io.WriteString(s, h.Error())
+	}
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of the response,
+// or 0 if the response had no valid Retry-After header.
+func (h HTTPError) RetryAfter() time.Duration {
+	return h.retryAfter
+}
+
+// errorsByStatusCode are the transport independent errors matched by the HTTP status codes.
+var errorsByStatusCode = map[int]error{
+	http.StatusNotFound:           access.ErrNotFound,
+	http.StatusBadRequest:         access.ErrInvalidArgument,
+	http.StatusTooManyRequests:    access.ErrRateLimited,
+	http.StatusBadGateway:         access.ErrUnavailable,
+	http.StatusServiceUnavailable: access.ErrUnavailable,
+	http.StatusGatewayTimeout:     access.ErrUnavailable,
+}
+
+// Is reports whether the error matches one of the transport independent errors of the access
+// package, such as access.ErrNotFound, based on its status code.
+//
+// The REST API has no status code for heights out of the range of the node, so access.ErrOutOfRange
+// is matched by the error message.
+func (h HTTPError) Is(target error) bool {
+	if target == access.ErrOutOfRange {
+		return strings.Contains(strings.ToLower(h.Message), "out of range")
+	}
+
+	err, ok := errorsByStatusCode[h.Code]
+	return ok && err == target
+}
+
+// newHTTPError creates an HTTPError from a failed response.
+//
+// Responses not containing a JSON error body, for example ones coming from a proxy
+// in front of the access node, use the raw body as the error message.
+func newHTTPError(req *http.Request, statusCode int, header http.Header, body []byte) HTTPError {
+	var httpErr HTTPError
+	err := json.Unmarshal(body, &httpErr)
+	if err != nil {
+		httpErr.Message = string(body)
+	}
+
+	httpErr.Url = req.URL.String()
+	httpErr.Method = req.Method
+	httpErr.Code = statusCode
+	httpErr.Body = body
+	httpErr.retryAfter = parseRetryAfter(header.Get("Retry-After"), time.Now())
+	for _, name := range requestIDHeaders {
+		if id := header.Get(name); id != "" {
+			httpErr.RequestID = id
+			break
+		}
+	}
+	return httpErr
+}
+
+// parseRetryAfter parses a Retry-After header value, either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
 type httpHandler struct {
-	client *http.Client
-	base   string
-	debug  bool
+	client   *http.Client
+	base     string
+	hooks    hooksList
+	headers  http.Header
+	retry    *RetryConfig
+	limiters map[RequestClass]*rateLimiter
+	// compressResponses, compressRequests and compressMinSize configure the gzip compression.
+	compressResponses bool
+	compressRequests  bool
+	compressMinSize   int
+	// skipAccountContracts fetches the accounts with their keys but without their contracts.
+	skipAccountContracts bool
+	// timeouts are the timeouts by request class of requests made without a context deadline.
+	timeouts map[RequestClass]time.Duration
 }
 
-func newHandler(host string, debug bool) (*httpHandler, error) {
+func newHandler(host string, opts ...ClientOption) (*httpHandler, error) {
 	_, err := url.Parse(host)
 	if err != nil {
 		return nil, err
 	}
 
-	return &httpHandler{
-		client: http.DefaultClient,
-		base:   host,
-		debug:  debug,
-	}, nil
+	h := &httpHandler{
+		client:  http.DefaultClient,
+		base:    host,
+		headers: http.Header{},
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h, nil
+}
+
+// ClientOption configures the HTTP transport used by the client.
+type ClientOption func(h *httpHandler)
+
+// WithHTTPClient sets the underlying HTTP client used to make requests, allowing
+// custom transports such as proxies or custom TLS configuration.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(h *httpHandler) {
+		h.client = client
+	}
+}
+
+// WithTimeout sets the timeout applied to requests made with a context without a deadline,
+// so requests to an unresponsive access node fail instead of blocking indefinitely.
+//
+// Deadlines set on the request context by the caller always take precedence.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return WithClassTimeout(AllRequests, timeout)
+}
+
+// WithClassTimeout sets the timeout applied to requests of the given class made with a context
+// without a deadline, overriding the timeout set with WithTimeout for that class. For example, script
+// executions and event queries can be given a longer timeout than other requests.
+func WithClassTimeout(class RequestClass, timeout time.Duration) ClientOption {
+	return func(h *httpHandler) {
+		if h.timeouts == nil {
+			h.timeouts = make(map[RequestClass]time.Duration)
+		}
+		h.timeouts[class] = timeout
+	}
+}
+
+// timeout returns the timeout applied to requests for the URL path made without a context deadline.
+func (h *httpHandler) timeout(path string) time.Duration {
+	if timeout, ok := h.timeouts[requestClass(path)]; ok {
+		return timeout
+	}
+	return h.timeouts[AllRequests]
+}
+
+// WithoutAccountContracts makes the client fetch accounts with their keys but without their contracts,
+// making account requests lighter when the contract code is not needed.
+func WithoutAccountContracts() ClientOption {
+	return func(h *httpHandler) {
+		h.skipAccountContracts = true
+	}
+}
+
+// WithHeader adds a header sent with every request made by the client,
+// for example an API key required by a hosted access node provider.
+func WithHeader(key string, value string) ClientOption {
+	return func(h *httpHandler) {
+		h.headers.Add(key, value)
+	}
+}
+
+// WithHeaders adds all the provided headers to every request made by the client.
+func WithHeaders(headers map[string]string) ClientOption {
+	return func(h *httpHandler) {
+		for key, value := range headers {
+			h.headers.Add(key, value)
+		}
+	}
+}
+
+// WithHooks registers hooks notified about every request made by the client,
+// for example to log requests or measure endpoint latencies.
+//
+// The option can be provided multiple times, hooks are called in the order they were registered.
+func WithHooks(hooks Hooks) ClientOption {
+	return func(h *httpHandler) {
+		h.hooks = append(h.hooks, hooks)
+	}
+}
+
+// newRequest creates a request applying the configured headers and timeout.
+//
+// The returned cancel function must be called once the response is consumed.
+func (h *httpHandler) newRequest(
+	ctx context.Context,
+	method string,
+	url *url.URL,
+	body io.Reader,
+) (*http.Request, context.CancelFunc, error) {
+	cancel := context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok {
+		if timeout := h.timeout(url.Path); timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), body)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	for key, values := range h.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if h.compressResponses {
+		req.Header.Set("Accept-Encoding", gzipEncoding)
+	}
+
+	return req, cancel, nil
 }
 
 func (h *httpHandler) mustBuildURL(path string, opts ...queryOpts) *url.URL {
@@ -79,42 +324,27 @@ func (h *httpHandler) mustBuildURL(path string, opts ...queryOpts) *url.URL {
 	return u
 }
 
-func (h *httpHandler) get(_ context.Context, url *url.URL, model interface{}) error {
-	if h.debug {
-		fmt.Printf("\n-> GET %s t=%d", url.String(), time.Now().Unix())
-	}
+// get requests the resource at the URL and decodes it into the model.
+//
+// Get requests are read-only and are retried according to the retry configuration.
+func (h *httpHandler) get(ctx context.Context, url *url.URL, model interface{}) error {
+	return h.withRetry(ctx, func() error {
+		return h.doGet(ctx, url, model)
+	})
+}
 
-	// todo use a .Do() method and use the context
-	res, err := h.client.Get(url.String())
+func (h *httpHandler) doGet(ctx context.Context, url *url.URL, model interface{}) error {
+	req, cancel, err := h.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return err
 	}
-	defer res.Body.Close()
+	defer cancel()
 
-	body, err := ioutil.ReadAll(res.Body)
+	body, err := h.do(req, nil)
 	if err != nil {
 		return err
 	}
 
-	if res.StatusCode >= http.StatusBadRequest {
-		if h.debug {
-			fmt.Printf("\n<- FAILED GET %s t=%d status=%d - %s", url.String(), res.StatusCode, time.Now().Unix(), body)
-		}
-
-		var httpErr HTTPError
-		err = json.Unmarshal(body, &httpErr)
-		if err != nil {
-			return err
-		}
-
-		httpErr.Url = url.String()
-		return httpErr
-	}
-
-	if h.debug {
-		fmt.Printf("\n<- GET %s t=%d - %s", url.String(), time.Now().Unix(), body)
-	}
-
 	err = json.Unmarshal(body, &model)
 	if err != nil {
 		return errors.Wrap(err, "JSON decoding failed")
@@ -123,59 +353,95 @@ func (h *httpHandler) get(_ context.Context, url *url.URL, model interface{}) er
 	return nil
 }
 
-func (h *httpHandler) post(_ context.Context, url *url.URL, body []byte, model interface{}) error {
-	if h.debug {
-		fmt.Printf("\n-> POST %s t=%d - %s", url.String(), time.Now().Unix(), string(body))
+func (h *httpHandler) post(ctx context.Context, url *url.URL, body []byte, model interface{}) error {
+	requestBody, encoding, err := h.requestBody(body)
+	if err != nil {
+		return errors.Wrap(err, "request body compression failed")
 	}
 
-	res, err := h.client.Post(
-		url.String(),
-		"application/json",
-		bytes.NewReader(body),
-	)
+	req, cancel, err := h.newRequest(ctx, http.MethodPost, url, requestBody)
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("HTTP POST %s failed", url.String()))
+		return err
+	}
+	defer cancel()
+	req.Header.Set("Content-Type", "application/json")
+	if encoding != "" {
+		req.Header.Set("Content-Encoding", encoding)
 	}
-	defer res.Body.Close()
 
-	responseBody, err := ioutil.ReadAll(res.Body)
+	responseBody, err := h.do(req, body)
 	if err != nil {
 		return err
 	}
 
-	if res.StatusCode >= http.StatusBadRequest {
-		if h.debug {
-			fmt.Printf("\n<- POST FAILED %s, status=%d, response: %s", url.String(), res.StatusCode, responseBody)
+	err = json.Unmarshal(responseBody, &model)
+	if err != nil {
+		return errors.Wrap(err, "JSON decoding failed")
+	}
+
+	return nil
+}
+
+// do sends the request and returns the response body, notifying the hooks about the request outcome.
+//
+// Responses with an error status code are returned as an HTTPError.
+func (h *httpHandler) do(req *http.Request, requestBody []byte) ([]byte, error) {
+	err := h.waitRateLimit(req.Context(), req.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	h.hooks.OnRequest(req, requestBody)
+	start := time.Now()
+
+	body, err := func() ([]byte, error) {
+		res, err := h.client.Do(req)
+		if err != nil {
+			if req.Method == http.MethodPost {
+				return nil, errors.Wrap(err, fmt.Sprintf("HTTP POST %s failed", req.URL.String()))
+			}
+			return nil, err
 		}
+		defer res.Body.Close()
 
-		var httpErr HTTPError
-		err = json.Unmarshal(responseBody, &httpErr)
+		body, err := readResponseBody(res)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		httpErr.Url = url.String()
-		return httpErr
-	}
+		h.hooks.OnResponse(req, res, body, time.Since(start))
 
-	if h.debug {
-		fmt.Printf("\n<- POST %s t=%d - %s", url.String(), time.Now().Unix(), string(body))
-	}
+		if res.StatusCode >= http.StatusBadRequest {
+			return nil, newHTTPError(req, res.StatusCode, res.Header, body)
+		}
 
-	err = json.Unmarshal(responseBody, &model)
+		return body, nil
+	}()
 	if err != nil {
-		return errors.Wrap(err, "JSON decoding failed")
+		h.hooks.OnError(req, err, time.Since(start))
+		return nil, err
 	}
 
-	return nil
+	return body, nil
 }
 
 func (h *httpHandler) getBlockByID(ctx context.Context, ID string, opts ...queryOpts) (*models.Block, error) {
+	return h.blockByID(ctx, ID, true, opts...)
+}
+
+// getBlockHeaderByID gets the block without its payload.
+func (h *httpHandler) getBlockHeaderByID(ctx context.Context, ID string, opts ...queryOpts) (*models.Block, error) {
+	return h.blockByID(ctx, ID, false, opts...)
+}
+
+func (h *httpHandler) blockByID(ctx context.Context, ID string, expandPayload bool, opts ...queryOpts) (*models.Block, error) {
 	u := h.mustBuildURL(fmt.Sprintf("/blocks/%s", ID), opts...)
 
-	q := u.Query()
-	q.Add("expand", "payload")
-	u.RawQuery = q.Encode()
+	if expandPayload {
+		q := u.Query()
+		q.Add("expand", "payload")
+		u.RawQuery = q.Encode()
+	}
 
 	var blocks []*models.Block
 	err := h.get(ctx, u, &blocks)
@@ -196,6 +462,28 @@ func (h *httpHandler) getBlocksByHeights(
 	startHeight string,
 	endHeight string,
 	opts ...queryOpts,
+) ([]*models.Block, error) {
+	return h.blocksByHeights(ctx, heights, startHeight, endHeight, true, opts...)
+}
+
+// getBlockHeadersByHeights gets the blocks without their payloads.
+func (h *httpHandler) getBlockHeadersByHeights(
+	ctx context.Context,
+	heights string,
+	startHeight string,
+	endHeight string,
+	opts ...queryOpts,
+) ([]*models.Block, error) {
+	return h.blocksByHeights(ctx, heights, startHeight, endHeight, false, opts...)
+}
+
+func (h *httpHandler) blocksByHeights(
+	ctx context.Context,
+	heights string,
+	startHeight string,
+	endHeight string,
+	expandPayload bool,
+	opts ...queryOpts,
 ) ([]*models.Block, error) {
 	u := h.mustBuildURL("/blocks", opts...)
 
@@ -209,7 +497,9 @@ func (h *httpHandler) getBlocksByHeights(
 		return nil, fmt.Errorf("must provide either heights or start and end height")
 	}
 
-	q.Add("expand", "payload")
+	if expandPayload {
+		q.Add("expand", "payload")
+	}
 	u.RawQuery = q.Encode()
 
 	var blocks []*models.Block
@@ -231,7 +521,13 @@ func (h *httpHandler) getAccount(
 
 	q := u.Query()
 	q.Add("height", height)
-	q.Add("expand", "keys,contracts")
+	if q.Get("expand") == "" { // keys and contracts are fetched in the same request unless specified otherwise
+		if h.skipAccountContracts {
+			q.Set("expand", "keys")
+		} else {
+			q.Set("expand", "keys,contracts")
+		}
+	}
 	u.RawQuery = q.Encode()
 
 	var account models.Account
@@ -282,7 +578,9 @@ func (h *httpHandler) executeScript(
 	}
 
 	var result string
-	err = h.post(ctx, u, body, &result)
+	err = h.withRetry(ctx, func() error { // scripts are read-only and safe to retry
+		return h.post(ctx, u, body, &result)
+	})
 	if err != nil {
 		return "", errors.Wrap(err, fmt.Sprintf("executing script %s failed", script))
 	}
@@ -400,6 +698,26 @@ func (h *httpHandler) getExecutionResults(
 	return results, nil
 }
 
+func (h *httpHandler) getNodeVersionInfo(ctx context.Context, opts ...queryOpts) (*models.NodeVersionInfo, error) {
+	var info models.NodeVersionInfo
+	err := h.get(ctx, h.mustBuildURL("/node_version_info", opts...), &info)
+	if err != nil {
+		return nil, errors.Wrap(err, "get node version info failed")
+	}
+
+	return &info, nil
+}
+
+func (h *httpHandler) getNetworkParameters(ctx context.Context, opts ...queryOpts) (*models.NetworkParameters, error) {
+	var params models.NetworkParameters
+	err := h.get(ctx, h.mustBuildURL("/network/parameters", opts...), &params)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network parameters failed")
+	}
+
+	return &params, nil
+}
+
 func (h *httpHandler) getExecutionResultByID(ctx context.Context, id string, opts ...queryOpts) (*models.ExecutionResult, error) {
 	u := h.mustBuildURL(fmt.Sprintf("/execution_results/%s", id), opts...)
 
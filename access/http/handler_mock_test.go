@@ -130,6 +130,66 @@ func (_m *mockHandler) getBlockByID(ctx context.Context, ID string, opts ...quer
 	return r0, r1
 }
 
+// getBlockHeaderByID provides a mock function with given fields: ctx, ID, opts
+func (_m *mockHandler) getBlockHeaderByID(ctx context.Context, ID string, opts ...queryOpts) (*models.Block, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *models.Block
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...queryOpts) *models.Block); ok {
+		r0 = rf(ctx, ID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Block)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...queryOpts) error); ok {
+		r1 = rf(ctx, ID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// getBlockHeadersByHeights provides a mock function with given fields: ctx, heights, startHeight, endHeight, opts
+func (_m *mockHandler) getBlockHeadersByHeights(ctx context.Context, heights string, startHeight string, endHeight string, opts ...queryOpts) ([]*models.Block, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, heights, startHeight, endHeight)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []*models.Block
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...queryOpts) []*models.Block); ok {
+		r0 = rf(ctx, heights, startHeight, endHeight, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Block)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, ...queryOpts) error); ok {
+		r1 = rf(ctx, heights, startHeight, endHeight, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // getBlocksByHeights provides a mock function with given fields: ctx, heights, startHeight, endHeight, opts
 func (_m *mockHandler) getBlocksByHeights(ctx context.Context, heights string, startHeight string, endHeight string, opts ...queryOpts) ([]*models.Block, error) {
 	_va := make([]interface{}, len(opts))
@@ -220,6 +280,66 @@ func (_m *mockHandler) getEvents(ctx context.Context, eventType string, start st
 	return r0, r1
 }
 
+// getNodeVersionInfo provides a mock function with given fields: ctx, opts
+func (_m *mockHandler) getNodeVersionInfo(ctx context.Context, opts ...queryOpts) (*models.NodeVersionInfo, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *models.NodeVersionInfo
+	if rf, ok := ret.Get(0).(func(context.Context, ...queryOpts) *models.NodeVersionInfo); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NodeVersionInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...queryOpts) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// getNetworkParameters provides a mock function with given fields: ctx, opts
+func (_m *mockHandler) getNetworkParameters(ctx context.Context, opts ...queryOpts) (*models.NetworkParameters, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *models.NetworkParameters
+	if rf, ok := ret.Get(0).(func(context.Context, ...queryOpts) *models.NetworkParameters); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NetworkParameters)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...queryOpts) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // getExecutionResultByID provides a mock function with given fields: ctx, id, opts
 func (_m *mockHandler) getExecutionResultByID(ctx context.Context, id string, opts ...queryOpts) (*models.ExecutionResult, error) {
 	_va := make([]interface{}, len(opts))
@@ -330,3 +450,26 @@ func (_m *mockHandler) sendTransaction(ctx context.Context, transaction []byte,
 
 	return r0
 }
+
+// subscribe provides a mock function with given fields: ctx, topic, arguments
+func (_m *mockHandler) subscribe(ctx context.Context, topic string, arguments map[string]interface{}) (subscription, error) {
+	ret := _m.Called(ctx, topic, arguments)
+
+	var r0 subscription
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) subscription); ok {
+		r0 = rf(ctx, topic, arguments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(subscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]interface{}) error); ok {
+		r1 = rf(ctx, topic, arguments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -27,10 +27,12 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/onflow/flow-go-sdk/access/http/models"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // handlerTest is a helper that builds handler with a http test server
@@ -56,7 +58,6 @@ func handlerTest(f func(ctx context.Context, t *testing.T, handler httpHandler,
 		h := httpHandler{
 			client: server.Client(),
 			base:   server.URL,
-			debug:  false,
 		}
 
 		f(context.Background(), t, h, testReq)
@@ -146,6 +147,33 @@ func TestHandler_GetBlockByID(t *testing.T) {
 	}))
 }
 
+func TestHandler_GetBlockHeaders(t *testing.T) {
+	t.Run("By ID", handlerTest(func(ctx context.Context, t *testing.T, handler httpHandler, req *testRequest) {
+		b := blockFlowFixture()
+		httpBlock := []*models.Block{&b}
+
+		const id = "0x1"
+		blockURL, _ := url.Parse(fmt.Sprintf("/blocks/%s", id))
+		req.SetData(*blockURL, httpBlock)
+
+		block, err := handler.getBlockHeaderByID(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, block, httpBlock[0])
+	}))
+
+	t.Run("By Heights", handlerTest(func(ctx context.Context, t *testing.T, handler httpHandler, req *testRequest) {
+		b := blockFlowFixture()
+		httpBlock := []*models.Block{&b}
+
+		blockURL, _ := url.Parse("/blocks")
+		req.SetData(addQuery(blockURL, map[string]string{"height": "1,2"}), httpBlock)
+
+		blocks, err := handler.getBlockHeadersByHeights(ctx, "1,2", "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, blocks, httpBlock)
+	}))
+}
+
 func TestHandler_GetBlockByHeights(t *testing.T) {
 	const startHeightKey = "start_height"
 	const endHeightKey = "end_height"
@@ -268,6 +296,46 @@ func TestHandler_GetAccount(t *testing.T) {
 		assert.Equal(t, *acc, httpAccount)
 	}))
 
+	t.Run("Success Without Contracts", handlerTest(func(ctx context.Context, t *testing.T, handler httpHandler, req *testRequest) {
+		httpAccount := accountFlowFixture()
+		httpAccount.Contracts = nil
+
+		const height = "sealed"
+		u, _ := url.Parse(fmt.Sprintf("/accounts/%s", httpAccount.Address))
+		req.SetData(
+			addQuery(u, map[string]string{
+				"height": height,
+				"expand": "keys",
+			}),
+			httpAccount,
+		)
+
+		acc, err := handler.getAccount(ctx, httpAccount.Address, height, &ExpandOpts{Expands: []string{"keys"}})
+		assert.NoError(t, err)
+		assert.Equal(t, *acc, httpAccount)
+	}))
+
+	t.Run("Success Without Contracts Option", handlerTest(func(ctx context.Context, t *testing.T, handler httpHandler, req *testRequest) {
+		httpAccount := accountFlowFixture()
+		httpAccount.Contracts = nil
+
+		const height = "sealed"
+		u, _ := url.Parse(fmt.Sprintf("/accounts/%s", httpAccount.Address))
+		req.SetData(
+			addQuery(u, map[string]string{
+				"height": height,
+				"expand": "keys",
+			}),
+			httpAccount,
+		)
+
+		WithoutAccountContracts()(&handler)
+
+		acc, err := handler.getAccount(ctx, httpAccount.Address, height)
+		assert.NoError(t, err)
+		assert.Equal(t, *acc, httpAccount)
+	}))
+
 	t.Run("Failure", handlerTest(func(ctx context.Context, t *testing.T, handler httpHandler, req *testRequest) {
 		errHTTP := models.ModelError{
 			Code:    400,
@@ -574,10 +642,167 @@ func TestHandler_URLBuilder(t *testing.T) {
 		endpoint := "/test"
 		u := handler.mustBuildURL(endpoint, opts...)
 		assert.Equal(t, u.RawQuery, fmt.Sprintf(
-			"expands=%s&select=%s",
+			"expand=%s&select=%s",
 			strings.Join(expands, "%2C"),
 			strings.Join(selects, "%2C"),
 		))
 		assert.Equal(t, u.Path, endpoint)
 	}))
 }
+
+func TestHandler_ClientOptions(t *testing.T) {
+	t.Run("Headers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, "key", request.Header.Get("X-Api-Key"))
+			assert.Equal(t, "bar", request.Header.Get("X-Foo"))
+			_, _ = writer.Write([]byte("[]"))
+		}))
+		defer server.Close()
+
+		h, err := newHandler(
+			server.URL,
+			WithHTTPClient(server.Client()),
+			WithHeader("X-Api-Key", "key"),
+			WithHeaders(map[string]string{"X-Foo": "bar"}),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, server.Client(), h.client)
+
+		_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			<-done
+		}))
+		defer server.Close()
+		defer close(done)
+
+		h, err := newHandler(server.URL, WithTimeout(10*time.Millisecond))
+		assert.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Class Timeouts", func(t *testing.T) {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			select {
+			case <-done:
+			case <-time.After(50 * time.Millisecond):
+				_, _ = writer.Write([]byte("[]"))
+			}
+		}))
+		defer server.Close()
+		defer close(done)
+
+		h, err := newHandler(
+			server.URL,
+			WithTimeout(10*time.Millisecond),
+			WithClassTimeout(EventsRequests, time.Second),
+		)
+		assert.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// class timeout overrides the timeout
+		_, err = h.getEvents(context.Background(), "A.Foo", "1", "2", nil)
+		assert.NoError(t, err)
+
+		// caller deadline takes precedence over the timeout
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err = h.getBlocksByHeights(ctx, "1", "", "")
+		assert.NoError(t, err)
+	})
+}
+
+type recordingHooks struct {
+	requests  []string
+	responses []int
+	errors    []error
+}
+
+func (r *recordingHooks) OnRequest(req *http.Request, _ []byte) {
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+}
+
+func (r *recordingHooks) OnResponse(_ *http.Request, res *http.Response, _ []byte, _ time.Duration) {
+	r.responses = append(r.responses, res.StatusCode)
+}
+
+func (r *recordingHooks) OnError(_ *http.Request, err error, _ time.Duration) {
+	r.errors = append(r.errors, err)
+}
+
+func TestHandler_Hooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodPost {
+			writer.WriteHeader(http.StatusBadRequest)
+			_, _ = writer.Write([]byte(`{"code": 400, "message": "invalid transaction"}`))
+			return
+		}
+		_, _ = writer.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	hooks := &recordingHooks{}
+	h, err := newHandler(server.URL, WithHooks(hooks))
+	assert.NoError(t, err)
+
+	_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+	assert.NoError(t, err)
+
+	err = h.sendTransaction(context.Background(), []byte("{}"))
+	assert.EqualError(t, err, "invalid transaction")
+
+	assert.Equal(t, []string{"GET /blocks", "POST /transactions"}, hooks.requests)
+	assert.Equal(t, []int{http.StatusOK, http.StatusBadRequest}, hooks.responses)
+	assert.Equal(t, []error{HTTPError{
+		Url:     server.URL + "/transactions",
+		Code:    http.StatusBadRequest,
+		Message: "invalid transaction",
+		Method:  http.MethodPost,
+		Body:    []byte(`{"code": 400, "message": "invalid transaction"}`),
+	}}, hooks.errors)
+}
+
+func TestHandler_ErrorDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("X-Request-Id", "req-42")
+		writer.WriteHeader(http.StatusNotFound)
+		_, _ = writer.Write([]byte(`{"code": 404, "message": "block not found"}`))
+	}))
+	defer server.Close()
+
+	h, err := newHandler(server.URL)
+	require.NoError(t, err)
+
+	_, err = h.getBlockByID(context.Background(), "abc")
+	require.Error(t, err)
+
+	var httpErr HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	assert.Equal(t, http.MethodGet, httpErr.Method)
+	assert.Equal(t, "req-42", httpErr.RequestID)
+	assert.Equal(t, "block not found", httpErr.Message)
+	assert.Equal(t, `{"code": 404, "message": "block not found"}`, string(httpErr.Body))
+
+	assert.Equal(t, "get block ID abc failed: block not found", err.Error())
+
+	details := fmt.Sprintf("%+v", err)
+	assert.Contains(t, details, "GET "+server.URL+"/blocks/abc?expand=payload failed with status 404 (request ID req-42): block not found")
+	assert.Contains(t, details, `response body: {"code": 404, "message": "block not found"}`)
+
+	t.Run("Non JSON body", func(t *testing.T) {
+		httpErr := HTTPError{Url: "http://node/blocks", Method: http.MethodGet, Code: 502, Message: "bad gateway", Body: []byte("bad gateway")}
+
+		assert.Equal(t, "GET http://node/blocks failed with status 502: bad gateway", httpErr.Details())
+		assert.Equal(t, `"bad gateway"`, fmt.Sprintf("%q", httpErr))
+	})
+}
@@ -0,0 +1,64 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"net/http"
+	"time"
+)
+
+// Hooks are notified about the requests made by the HTTP client.
+//
+// Hooks can be used to plug in a structured logger, collect metrics or trace requests.
+// Request and response bodies are passed as-is, implementations are responsible for
+// redacting any sensitive data before logging them. Hooks must not modify the passed values.
+type Hooks interface {
+	// OnRequest is called before the request is sent, body is nil for requests without a body.
+	OnRequest(req *http.Request, body []byte)
+
+	// OnResponse is called when a response is received, including responses with an error status code.
+	OnResponse(req *http.Request, res *http.Response, body []byte, duration time.Duration)
+
+	// OnError is called when the request fails, either because no response was received
+	// or because the response has an error status code.
+	OnError(req *http.Request, err error, duration time.Duration)
+}
+
+// hooksList notifies all the registered hooks in order.
+type hooksList []Hooks
+
+var _ Hooks = hooksList{}
+
+func (l hooksList) OnRequest(req *http.Request, body []byte) {
+	for _, h := range l {
+		h.OnRequest(req, body)
+	}
+}
+
+func (l hooksList) OnResponse(req *http.Request, res *http.Response, body []byte, duration time.Duration) {
+	for _, h := range l {
+		h.OnResponse(req, res, body, duration)
+	}
+}
+
+func (l hooksList) OnError(req *http.Request, err error, duration time.Duration) {
+	for _, h := range l {
+		h.OnError(req, err, duration)
+	}
+}
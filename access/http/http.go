@@ -39,6 +39,8 @@ import (
 type handler interface {
 	getBlockByID(ctx context.Context, ID string, opts ...queryOpts) (*models.Block, error)
 	getBlocksByHeights(ctx context.Context, heights string, startHeight string, endHeight string, opts ...queryOpts) ([]*models.Block, error)
+	getBlockHeaderByID(ctx context.Context, ID string, opts ...queryOpts) (*models.Block, error)
+	getBlockHeadersByHeights(ctx context.Context, heights string, startHeight string, endHeight string, opts ...queryOpts) ([]*models.Block, error)
 	getAccount(ctx context.Context, address string, height string, opts ...queryOpts) (*models.Account, error)
 	getCollection(ctx context.Context, ID string, opts ...queryOpts) (*models.Collection, error)
 	executeScriptAtBlockHeight(ctx context.Context, height string, script string, arguments []string, opts ...queryOpts) (string, error)
@@ -48,6 +50,9 @@ type handler interface {
 	getEvents(ctx context.Context, eventType string, start string, end string, blockIDs []string, opts ...queryOpts) ([]models.BlockEvents, error)
 	getExecutionResultByID(ctx context.Context, id string, opts ...queryOpts) (*models.ExecutionResult, error)
 	getExecutionResults(ctx context.Context, blockIDs []string, opts ...queryOpts) ([]models.ExecutionResult, error)
+	getNodeVersionInfo(ctx context.Context, opts ...queryOpts) (*models.NodeVersionInfo, error)
+	getNetworkParameters(ctx context.Context, opts ...queryOpts) (*models.NetworkParameters, error)
+	subscribe(ctx context.Context, topic string, arguments map[string]interface{}) (subscription, error)
 }
 
 // ExpandOpts allows you to define a list of fields that you want to retrieve as extra data in the response.
@@ -58,7 +63,7 @@ type ExpandOpts struct {
 }
 
 func (e *ExpandOpts) toQuery() (string, string) {
-	return "expands", strings.Join(e.Expands, ",")
+	return "expand", strings.Join(e.Expands, ",")
 }
 
 // SelectOpts allows you to define a list of fields that you only want to fetch in the response filtering out any other data.
@@ -150,8 +155,11 @@ func (b *HeightQuery) singleHeightDefined() bool {
 //
 // Use this client if you need advance access to the HTTP API. If you
 // don't require special methods use the Client instead.
-func NewBaseClient(host string) (*BaseClient, error) {
-	handler, err := newHandler(host, false)
+//
+// The host is the base URL of the access node REST API, optional client options
+// can be provided to customize the underlying HTTP transport.
+func NewBaseClient(host string, opts ...ClientOption) (*BaseClient, error) {
+	handler, err := newHandler(host, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +203,53 @@ func (c *BaseClient) GetBlockByID(ctx context.Context, blockID flow.Identifier,
 	return toBlock(block)
 }
 
+// GetBlockHeaderByID requests the header of the block with the given ID, without the block payload.
+func (c *BaseClient) GetBlockHeaderByID(ctx context.Context, blockID flow.Identifier, opts ...queryOpts) (*flow.BlockHeader, error) {
+	block, err := c.handler.getBlockHeaderByID(ctx, blockID.String(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBlockHeader(block.Header)
+}
+
+// GetBlockHeadersByHeights requests the headers of the blocks by the specified block query, without the block payloads.
+func (c *BaseClient) GetBlockHeadersByHeights(
+	ctx context.Context,
+	heightQuery HeightQuery,
+	opts ...queryOpts,
+) ([]*flow.BlockHeader, error) {
+	if !heightQuery.heightsDefined() && !heightQuery.rangeDefined() {
+		return nil, fmt.Errorf("must either provide heights or start and end height range")
+	}
+
+	err := heightQuery.validateRange()
+	if err != nil {
+		return nil, err
+	}
+
+	httpBlocks, err := c.handler.getBlockHeadersByHeights(
+		ctx,
+		heightQuery.heightsString(),
+		heightQuery.startString(),
+		heightQuery.endString(),
+		opts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make([]*flow.BlockHeader, len(httpBlocks))
+	for i, block := range httpBlocks {
+		headers[i], err = toBlockHeader(block.Header)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return headers, nil
+}
+
 // GetBlocksByHeights requests the blocks by the specified block query.
 func (c *BaseClient) GetBlocksByHeights(
 	ctx context.Context,
@@ -235,7 +290,7 @@ func (c *BaseClient) GetCollection(
 		return nil, err
 	}
 
-	return toCollection(collection), nil
+	return toCollection(collection)
 }
 
 func (c *BaseClient) SendTransaction(
@@ -401,6 +456,26 @@ func (c *BaseClient) GetLatestProtocolStateSnapshot(ctx context.Context) ([]byte
 	return nil, fmt.Errorf("get latest protocol snapshot is currently not supported for HTTP API, if you require this functionality please open an issue on the flow-go-sdk github")
 }
 
+// GetNodeVersionInfo requests the software version of the access node and the spork of the network.
+func (c *BaseClient) GetNodeVersionInfo(ctx context.Context) (*flow.NodeVersionInfo, error) {
+	info, err := c.handler.getNodeVersionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toNodeVersionInfo(info)
+}
+
+// GetChainID requests the chain ID of the network served by the access node.
+func (c *BaseClient) GetChainID(ctx context.Context) (flow.ChainID, error) {
+	params, err := c.handler.getNetworkParameters(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return flow.ChainID(params.ChainId), nil
+}
+
 func (c *BaseClient) GetExecutionResultForBlockID(ctx context.Context, blockID flow.Identifier) (*flow.ExecutionResult, error) {
 	results, err := c.handler.getExecutionResults(ctx, []string{blockID.String()})
 	if err != nil {
@@ -411,5 +486,5 @@ func (c *BaseClient) GetExecutionResultForBlockID(ctx context.Context, blockID f
 		return nil, fmt.Errorf("results not found") // sanity check
 	}
 
-	return toExecutionResults(results[0]), nil
+	return toExecutionResult(results[0])
 }
@@ -0,0 +1,312 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httptest
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/http/models"
+)
+
+// SetLatestBlock sets the block returned as the latest sealed or finalized block,
+// and registers it like AddBlock.
+func (s *Server) SetLatestBlock(block *flow.Block, isSealed bool) {
+	height := "final"
+	if isSealed {
+		height = "sealed"
+	}
+
+	s.AddBlock(block)
+	s.On(http.MethodGet, "/blocks?height="+height).Reply(http.StatusOK, []*models.Block{blockToModel(block)})
+}
+
+// AddBlock registers the block, returned when requested by ID or height.
+func (s *Server) AddBlock(block *flow.Block) {
+	blocks := []*models.Block{blockToModel(block)}
+
+	s.On(http.MethodGet, "/blocks/"+block.ID.String()).Reply(http.StatusOK, blocks)
+	s.On(http.MethodGet, fmt.Sprintf("/blocks?height=%d", block.Height)).Reply(http.StatusOK, blocks)
+}
+
+// AddAccount registers the account, returned when requested at any block height.
+func (s *Server) AddAccount(account *flow.Account) {
+	s.On(http.MethodGet, "/accounts/"+account.Address.String()).Reply(http.StatusOK, accountToModel(account))
+}
+
+// AddCollection registers the collection, returned when requested by ID.
+func (s *Server) AddCollection(collection *flow.Collection) {
+	s.On(http.MethodGet, "/collections/"+collection.ID().String()).Reply(http.StatusOK, collectionToModel(collection))
+}
+
+// AddTransaction registers the transaction, returned when requested by ID.
+func (s *Server) AddTransaction(tx *flow.Transaction) {
+	s.On(http.MethodGet, "/transactions/"+tx.ID().String()).Reply(http.StatusOK, transactionToModel(tx))
+}
+
+// AddTransactionResult registers the result of the transaction with the ID,
+// returned when the result of the transaction is requested.
+func (s *Server) AddTransactionResult(txID flow.Identifier, result *flow.TransactionResult) {
+	tx := models.Transaction{
+		Id:     txID.String(),
+		Result: transactionResultToModel(result),
+	}
+
+	s.On(http.MethodGet, "/transactions/"+txID.String()+"?expand=result").Reply(http.StatusOK, tx)
+}
+
+// AddEvents registers the events of the type, returned by event queries for the type
+// regardless of the requested heights or block IDs.
+func (s *Server) AddEvents(eventType string, blockEvents ...flow.BlockEvents) {
+	events := make([]models.BlockEvents, len(blockEvents))
+	for i, be := range blockEvents {
+		events[i] = models.BlockEvents{
+			BlockId:        be.BlockID.String(),
+			BlockHeight:    fmt.Sprintf("%d", be.Height),
+			BlockTimestamp: be.BlockTimestamp,
+			Events:         eventsToModels(be.Events),
+		}
+	}
+
+	s.On(http.MethodGet, "/events?type="+eventType).Reply(http.StatusOK, events)
+}
+
+// SetScriptResult sets the value returned by script executions, regardless of the script.
+//
+// SetScriptResult panics if the value can't be encoded as JSON-Cadence.
+func (s *Server) SetScriptResult(value cadence.Value) {
+	encoded, err := jsoncdc.Encode(value)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode script result: %s", err))
+	}
+
+	s.On(http.MethodPost, "/scripts").Reply(http.StatusOK, base64.StdEncoding.EncodeToString(encoded))
+}
+
+// SetNodeVersionInfo sets the version info reported by the access node.
+func (s *Server) SetNodeVersionInfo(info *flow.NodeVersionInfo) {
+	s.On(http.MethodGet, "/node_version_info").Reply(http.StatusOK, models.NodeVersionInfo{
+		Semver:               info.Semver,
+		Commit:               info.Commit,
+		SporkId:              info.SporkID.String(),
+		ProtocolVersion:      fmt.Sprintf("%d", info.ProtocolVersion),
+		SporkRootBlockHeight: fmt.Sprintf("%d", info.SporkRootBlockHeight),
+		NodeRootBlockHeight:  fmt.Sprintf("%d", info.NodeRootBlockHeight),
+	})
+}
+
+// SetChainID sets the chain ID of the network served by the access node.
+func (s *Server) SetChainID(chainID flow.ChainID) {
+	s.On(http.MethodGet, "/network/parameters").Reply(http.StatusOK, models.NetworkParameters{
+		ChainId: string(chainID),
+	})
+}
+
+// AcceptTransactions makes the server accept all the sent transactions.
+func (s *Server) AcceptTransactions() {
+	s.On(http.MethodPost, "/transactions").Reply(http.StatusCreated, models.Transaction{})
+}
+
+func blockToModel(block *flow.Block) *models.Block {
+	guarantees := make([]models.CollectionGuarantee, len(block.CollectionGuarantees))
+	for i, g := range block.CollectionGuarantees {
+		guarantees[i] = models.CollectionGuarantee{
+			CollectionId: g.CollectionID.String(),
+			SignerIds:    identifiersToStrings(g.SignerIDs),
+			Signature:    base64.StdEncoding.EncodeToString(g.Signature),
+		}
+	}
+
+	seals := make([]models.BlockSeal, len(block.Seals))
+	for i, seal := range block.Seals {
+		sigs := make([]models.AggregatedSignature, len(seal.AggregatedApprovalSigs))
+		for j, sig := range seal.AggregatedApprovalSigs {
+			verifierSigs := make([]string, len(sig.VerifierSignatures))
+			for k, v := range sig.VerifierSignatures {
+				verifierSigs[k] = base64.StdEncoding.EncodeToString(v)
+			}
+			sigs[j] = models.AggregatedSignature{
+				VerifierSignatures: verifierSigs,
+				SignerIds:          identifiersToStrings(sig.SignerIDs),
+			}
+		}
+
+		seals[i] = models.BlockSeal{
+			BlockId:                      seal.BlockID.String(),
+			ResultId:                     seal.ResultID.String(),
+			FinalState:                   hex.EncodeToString(seal.FinalState),
+			AggregatedApprovalSignatures: sigs,
+		}
+	}
+
+	return &models.Block{
+		Header: &models.BlockHeader{
+			Id:                   block.ID.String(),
+			ParentId:             block.ParentID.String(),
+			Height:               fmt.Sprintf("%d", block.Height),
+			Timestamp:            block.Timestamp,
+			ParentVoterSignature: base64.StdEncoding.EncodeToString(block.ParentVoterSigData),
+		},
+		Payload: &models.BlockPayload{
+			CollectionGuarantees: guarantees,
+			BlockSeals:           seals,
+		},
+	}
+}
+
+func accountToModel(account *flow.Account) models.Account {
+	keys := make([]models.AccountPublicKey, len(account.Keys))
+	for i, key := range account.Keys {
+		sigAlgo := models.SigningAlgorithm(key.SigAlgo.String())
+		hashAlgo := models.HashingAlgorithm(key.HashAlgo.String())
+		keys[i] = models.AccountPublicKey{
+			Index:            fmt.Sprintf("%d", key.Index),
+			PublicKey:        key.PublicKey.String(),
+			SigningAlgorithm: &sigAlgo,
+			HashingAlgorithm: &hashAlgo,
+			SequenceNumber:   fmt.Sprintf("%d", key.SequenceNumber),
+			Weight:           fmt.Sprintf("%d", key.Weight),
+			Revoked:          key.Revoked,
+		}
+	}
+
+	contracts := make(map[string]string, len(account.Contracts))
+	for name, code := range account.Contracts {
+		contracts[name] = base64.StdEncoding.EncodeToString(code)
+	}
+
+	return models.Account{
+		Address:   account.Address.String(),
+		Balance:   fmt.Sprintf("%d", account.Balance),
+		Keys:      keys,
+		Contracts: contracts,
+	}
+}
+
+func collectionToModel(collection *flow.Collection) models.Collection {
+	txs := make([]models.Transaction, len(collection.TransactionIDs))
+	for i, id := range collection.TransactionIDs {
+		txs[i] = models.Transaction{Id: id.String()}
+	}
+
+	return models.Collection{
+		Id:           collection.ID().String(),
+		Transactions: txs,
+	}
+}
+
+func transactionToModel(tx *flow.Transaction) models.Transaction {
+	args := make([]string, len(tx.Arguments))
+	for i, arg := range tx.Arguments {
+		args[i] = base64.StdEncoding.EncodeToString(arg)
+	}
+
+	auths := make([]string, len(tx.Authorizers))
+	for i, address := range tx.Authorizers {
+		auths[i] = address.String()
+	}
+
+	return models.Transaction{
+		Id:               tx.ID().String(),
+		Script:           base64.StdEncoding.EncodeToString(tx.Script),
+		Arguments:        args,
+		ReferenceBlockId: tx.ReferenceBlockID.String(),
+		GasLimit:         fmt.Sprintf("%d", tx.GasLimit),
+		Payer:            tx.Payer.String(),
+		ProposalKey: &models.ProposalKey{
+			Address:        tx.ProposalKey.Address.String(),
+			KeyIndex:       fmt.Sprintf("%d", tx.ProposalKey.KeyIndex),
+			SequenceNumber: fmt.Sprintf("%d", tx.ProposalKey.SequenceNumber),
+		},
+		Authorizers:        auths,
+		PayloadSignatures:  signaturesToModels(tx.PayloadSignatures),
+		EnvelopeSignatures: signaturesToModels(tx.EnvelopeSignatures),
+	}
+}
+
+func signaturesToModels(signatures []flow.TransactionSignature) []models.TransactionSignature {
+	sigs := make([]models.TransactionSignature, len(signatures))
+	for i, sig := range signatures {
+		sigs[i] = models.TransactionSignature{
+			Address:   sig.Address.String(),
+			KeyIndex:  fmt.Sprintf("%d", sig.KeyIndex),
+			Signature: base64.StdEncoding.EncodeToString(sig.Signature),
+		}
+	}
+	return sigs
+}
+
+var transactionStatuses = map[flow.TransactionStatus]models.TransactionStatus{
+	flow.TransactionStatusPending:   models.PENDING_TransactionStatus,
+	flow.TransactionStatusFinalized: models.FINALIZED_TransactionStatus,
+	flow.TransactionStatusExecuted:  models.EXECUTED_TransactionStatus,
+	flow.TransactionStatusSealed:    models.SEALED_TransactionStatus,
+	flow.TransactionStatusExpired:   models.EXPIRED_TransactionStatus,
+}
+
+func transactionResultToModel(result *flow.TransactionResult) *models.TransactionResult {
+	txr := &models.TransactionResult{
+		Events: eventsToModels(result.Events),
+	}
+
+	if status, ok := transactionStatuses[result.Status]; ok {
+		txr.Status = &status
+	}
+	if result.BlockID != flow.EmptyID {
+		txr.BlockId = result.BlockID.String()
+	}
+	if result.Error != nil {
+		txr.StatusCode = 1
+		txr.ErrorMessage = result.Error.Error()
+	}
+
+	return txr
+}
+
+func eventsToModels(events []flow.Event) []models.Event {
+	converted := make([]models.Event, len(events))
+	for i, e := range events {
+		converted[i] = models.Event{
+			Type_:            e.Type,
+			TransactionId:    e.TransactionID.String(),
+			TransactionIndex: fmt.Sprintf("%d", e.TransactionIndex),
+			EventIndex:       fmt.Sprintf("%d", e.EventIndex),
+			Payload:          base64.StdEncoding.EncodeToString(e.Payload),
+		}
+	}
+	return converted
+}
+
+func identifiersToStrings(ids []flow.Identifier) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	converted := make([]string, len(ids))
+	for i, id := range ids {
+		converted[i] = id.String()
+	}
+	return converted
+}
@@ -0,0 +1,264 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// RecorderMode defines whether a recorder records responses from an access node or replays recorded ones.
+type RecorderMode int
+
+const (
+	// ModeReplay serves the responses recorded in the fixture file, without sending any request.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends the requests to the access node and records the responses,
+	// overwriting the fixture file when the recorder is stopped.
+	ModeRecord
+	// ModeReplayOrRecord replays the fixture file if it exists and records it otherwise.
+	ModeReplayOrRecord
+)
+
+// An Interaction is a request and its response recorded in a fixture file.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// A RecordedRequest is a request recorded in a fixture file.
+//
+// The request headers are not recorded, so API keys sent to the access node don't end up in fixtures.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// URI is the path and query of the request, without the host so fixtures can be replayed with any host.
+	URI  string `json:"uri"`
+	Body string `json:"body,omitempty"`
+}
+
+// A RecordedResponse is a response recorded in a fixture file.
+type RecordedResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body"`
+}
+
+// A Recorder is an HTTP transport recording the responses of an access node to a fixture file,
+// and replaying them deterministically, to write reproducible tests of code depending on
+// live network data such as mainnet accounts or events.
+//
+// The recorder is used as the HTTP client of the SDK HTTP client:
+//
+//	recorder, err := httptest.NewRecorder("testdata/account.json", httptest.ModeReplayOrRecord)
+//	defer recorder.Stop()
+//
+//	client, err := flowhttp.NewClient(flowhttp.MainnetHost, flowhttp.WithHTTPClient(recorder.Client()))
+//
+// Requests are replayed by method, path, query and body. Identical requests are replayed in the
+// order they were recorded, and the last response is repeated once they have all been served.
+type Recorder struct {
+	path         string
+	mode         RecorderMode
+	transport    http.RoundTripper
+	mu           sync.Mutex
+	interactions []Interaction
+	served       map[string]int
+}
+
+// RecorderOption configures a recorder.
+type RecorderOption func(r *Recorder)
+
+// WithTransport sets the transport used to send the recorded requests, http.DefaultTransport by default.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// NewRecorder creates a recorder using the fixture file at the path.
+//
+// An error is returned in replay mode if the fixture file can't be read.
+func NewRecorder(path string, mode RecorderMode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		served:    make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplayOrRecord {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+
+	if r.mode == ModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture file: %w", err)
+		}
+
+		err = json.Unmarshal(data, &r.interactions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode fixture file %s: %w", path, err)
+		}
+	}
+
+	return r, nil
+}
+
+// Mode returns whether the recorder is recording or replaying responses.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Client returns an HTTP client using the recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interactions returns the recorded interactions.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// Stop writes the recorded interactions to the fixture file in record mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode interactions: %w", err)
+	}
+
+	err = ioutil.WriteFile(r.path, data, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write fixture file: %w", err)
+	}
+
+	return nil
+}
+
+// RoundTrip records or replays the response to the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URI:    req.URL.RequestURI(),
+		Body:   string(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	if req.Body != nil {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode:  res.StatusCode,
+			ContentType: res.Header.Get("Content-Type"),
+			Body:        string(resBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := fmt.Sprintf("%s %s %s", recorded.Method, recorded.URI, recorded.Body)
+
+	var matches []Interaction
+	for _, i := range r.interactions {
+		if i.Request == recorded {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no recorded response for %s %s in %s", recorded.Method, recorded.URI, r.path)
+	}
+
+	index := r.served[key]
+	if index >= len(matches) {
+		index = len(matches) - 1
+	}
+	r.served[key]++
+
+	response := matches[index].Response
+	header := http.Header{}
+	if response.ContentType != "" {
+		header.Set("Content-Type", response.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(response.Body))),
+		ContentLength: int64(len(response.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httptest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	flowhttp "github.com/onflow/flow-go-sdk/access/http"
+	"github.com/onflow/flow-go-sdk/access/http/httptest"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "fixture.json")
+
+	server := httptest.NewServer()
+	defer server.Close()
+
+	account := test.AccountGenerator().New()
+	block := test.BlockGenerator().New()
+	server.AddAccount(account)
+	server.SetLatestBlock(block, true)
+
+	t.Run("Record", func(t *testing.T) {
+		recorder, err := httptest.NewRecorder(path, httptest.ModeReplayOrRecord)
+		require.NoError(t, err)
+		assert.Equal(t, httptest.ModeRecord, recorder.Mode())
+
+		client, err := flowhttp.NewClient(server.URL, flowhttp.WithHTTPClient(recorder.Client()))
+		require.NoError(t, err)
+
+		fetched, err := client.GetAccount(ctx, account.Address)
+		require.NoError(t, err)
+		assert.Equal(t, account.Address, fetched.Address)
+
+		latest, err := client.GetLatestBlock(ctx, true)
+		require.NoError(t, err)
+		assert.Equal(t, block.ID, latest.ID)
+
+		require.NoError(t, recorder.Stop())
+		assert.Len(t, recorder.Interactions(), 2)
+	})
+
+	server.Close()
+
+	t.Run("Replay", func(t *testing.T) {
+		recorder, err := httptest.NewRecorder(path, httptest.ModeReplayOrRecord)
+		require.NoError(t, err)
+		assert.Equal(t, httptest.ModeReplay, recorder.Mode())
+
+		client, err := flowhttp.NewClient(server.URL, flowhttp.WithHTTPClient(recorder.Client()))
+		require.NoError(t, err)
+
+		fetched, err := client.GetAccount(ctx, account.Address)
+		require.NoError(t, err)
+		assert.Equal(t, account.Address, fetched.Address)
+		assert.Equal(t, account.Keys, fetched.Keys)
+
+		latest, err := client.GetLatestBlock(ctx, true)
+		require.NoError(t, err)
+		assert.Equal(t, block.ID, latest.ID)
+
+		_, err = client.GetAccount(ctx, flow.HexToAddress("01"))
+		assert.ErrorContains(t, err, "no recorded response")
+
+		require.NoError(t, recorder.Stop())
+	})
+
+	t.Run("Missing fixture", func(t *testing.T) {
+		_, err := httptest.NewRecorder(filepath.Join(t.TempDir(), "missing.json"), httptest.ModeReplay)
+		assert.Error(t, err)
+	})
+}
+
+func TestRecorder_RecordsErrors(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "fixture.json")
+
+	server := httptest.NewServer()
+	defer server.Close()
+	server.On(http.MethodPost, "/transactions").ReplyError(http.StatusBadRequest, "invalid transaction")
+
+	recorder, err := httptest.NewRecorder(path, httptest.ModeRecord)
+	require.NoError(t, err)
+	client, err := flowhttp.NewClient(server.URL, flowhttp.WithHTTPClient(recorder.Client()))
+	require.NoError(t, err)
+
+	err = client.SendTransaction(ctx, *test.TransactionGenerator().New())
+	require.True(t, errors.Is(err, access.ErrInvalidArgument))
+	require.NoError(t, recorder.Stop())
+
+	recorder, err = httptest.NewRecorder(path, httptest.ModeReplay)
+	require.NoError(t, err)
+	client, err = flowhttp.NewClient(server.URL, flowhttp.WithHTTPClient(recorder.Client()))
+	require.NoError(t, err)
+
+	err = client.SendTransaction(ctx, *test.TransactionGenerator().New())
+	assert.True(t, errors.Is(err, access.ErrInvalidArgument))
+	assert.Len(t, server.Requests(), 1, "replayed requests are not sent")
+}
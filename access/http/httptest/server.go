@@ -0,0 +1,350 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package httptest provides a fake access node serving the REST endpoints called by the HTTP client,
+// to write fast integration tests of code using the SDK without running an emulator.
+//
+// Responses are programmed per endpoint, either as raw responses or from SDK types, and the requests
+// received by the server are recorded so tests can assert on them:
+//
+//	server := httptest.NewServer()
+//	defer server.Close()
+//
+//	server.SetLatestBlock(block, true)
+//	server.On(http.MethodPost, "/transactions").ReplyError(http.StatusBadRequest, "invalid signature")
+//
+//	client, _ := flowhttp.NewClient(server.URL)
+//	...
+//	server.AssertExpectations(t)
+//
+// The package also provides a Recorder, recording the responses of a real access node to fixture files
+// and replaying them, to write reproducible tests depending on live network data.
+package httptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	nethttptest "net/http/httptest"
+	"net/url"
+	"sync"
+)
+
+// TestingT is the subset of testing.TB used to report failed assertions.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// A Request is a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// A Server is a fake access node serving programmed responses over HTTP.
+type Server struct {
+	// URL is the base URL of the server, to be used as the host of the HTTP client.
+	URL string
+
+	server    *nethttptest.Server
+	mu        sync.Mutex
+	routes    []*Route
+	requests  []Request
+	unmatched []Request
+}
+
+// NewServer starts a fake access node. The server must be closed once the test is done.
+func NewServer() *Server {
+	s := &Server{}
+	s.server = nethttptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// On registers the endpoint with the method and path, and returns the route used to program its responses.
+//
+// The path can contain query parameters, in which case the route only matches requests having
+// the same values for these parameters, for example "/blocks?height=sealed". When several routes match
+// a request, the route with the most query parameters is used, then the most recently registered one,
+// so routes can be overridden during a test.
+func (s *Server) On(method string, path string) *Route {
+	u, err := url.Parse(path)
+	if err != nil {
+		panic(fmt.Sprintf("invalid route path %q: %s", path, err))
+	}
+
+	r := &Route{
+		method: method,
+		path:   u.Path,
+		query:  u.Query(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append(s.routes, r)
+
+	return r
+}
+
+// Requests returns the requests received by the server, in the order they were received.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Unmatched returns the requests received by the server that didn't match any route.
+func (s *Server) Unmatched() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.unmatched...)
+}
+
+// Reset removes all the routes and recorded requests.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = nil
+	s.requests = nil
+	s.unmatched = nil
+}
+
+// AssertCalled asserts that the server received a request matching the method and path,
+// which can contain query parameters like the paths of On.
+func (s *Server) AssertCalled(t TestingT, method string, path string) bool {
+	t.Helper()
+
+	u, err := url.Parse(path)
+	if err != nil {
+		t.Errorf("invalid path %q: %s", path, err)
+		return false
+	}
+
+	for _, req := range s.Requests() {
+		if req.matches(method, u.Path, u.Query()) {
+			return true
+		}
+	}
+
+	t.Errorf("expected a %s %s request, received %d requests", method, path, len(s.Requests()))
+	return false
+}
+
+// AssertExpectations asserts that every route received at least one request, and that the server
+// didn't receive any request not matching a route.
+func (s *Server) AssertExpectations(t TestingT) bool {
+	t.Helper()
+
+	s.mu.Lock()
+	routes := append([]*Route(nil), s.routes...)
+	unmatched := append([]Request(nil), s.unmatched...)
+	s.mu.Unlock()
+
+	ok := true
+	for _, r := range routes {
+		if r.Calls() == 0 {
+			t.Errorf("expected a request to %s, received none", r)
+			ok = false
+		}
+	}
+	for _, req := range unmatched {
+		t.Errorf("unexpected request %s %s", req.Method, req.Path)
+		ok = false
+	}
+
+	return ok
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to read request body: %s", err))
+		return
+	}
+
+	req := Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	route := s.match(req)
+	if route == nil {
+		s.unmatched = append(s.unmatched, req)
+	}
+	s.mu.Unlock()
+
+	if route == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no response programmed for %s %s", req.Method, req.Path))
+		return
+	}
+
+	route.serve(w, r, req)
+}
+
+// match returns the route used for the request, or nil if no route matches.
+func (s *Server) match(req Request) *Route {
+	var match *Route
+	for _, r := range s.routes {
+		if !req.matches(r.method, r.path, r.query) {
+			continue
+		}
+		if match == nil || len(r.query) >= len(match.query) {
+			match = r
+		}
+	}
+	return match
+}
+
+func (r Request) matches(method string, path string, query url.Values) bool {
+	if r.Method != method || r.Path != path {
+		return false
+	}
+
+	for key := range query {
+		if r.Query.Get(key) != query.Get(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// A Route is an endpoint of the server with programmed responses.
+//
+// The responses are served in the order they were added, and the last one is repeated once all the
+// others have been served. A route without responses replies with an empty JSON object.
+type Route struct {
+	method    string
+	path      string
+	query     url.Values
+	mu        sync.Mutex
+	responses []http.HandlerFunc
+	requests  []Request
+}
+
+// Reply adds a response with the status code and the body, encoded as JSON unless it is a byte slice.
+//
+// Reply panics if the body can't be encoded as JSON.
+func (r *Route) Reply(status int, body interface{}) *Route {
+	var data []byte
+	switch b := body.(type) {
+	case []byte:
+		data = b
+	default:
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			panic(fmt.Sprintf("failed to encode response of %s: %s", r, err))
+		}
+	}
+
+	return r.ReplyFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(data)
+	})
+}
+
+// ReplyError adds an error response with the status code and the message,
+// in the format used by the access node.
+func (r *Route) ReplyError(status int, message string) *Route {
+	return r.ReplyFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, status, message)
+	})
+}
+
+// ReplyFunc adds a response written by the handler, for example to delay the response
+// or to compute it from the request.
+func (r *Route) ReplyFunc(handler http.HandlerFunc) *Route {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.responses = append(r.responses, handler)
+	return r
+}
+
+// Calls returns the number of requests served by the route.
+func (r *Route) Calls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+// Requests returns the requests served by the route.
+func (r *Route) Requests() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Request(nil), r.requests...)
+}
+
+func (r *Route) String() string {
+	if len(r.query) == 0 {
+		return fmt.Sprintf("%s %s", r.method, r.path)
+	}
+	return fmt.Sprintf("%s %s?%s", r.method, r.path, r.query.Encode())
+}
+
+func (r *Route) serve(w http.ResponseWriter, httpReq *http.Request, req Request) {
+	r.mu.Lock()
+	index := len(r.requests)
+	r.requests = append(r.requests, req)
+	var handler http.HandlerFunc
+	if len(r.responses) > 0 {
+		if index >= len(r.responses) {
+			index = len(r.responses) - 1
+		}
+		handler = r.responses[index]
+	}
+	r.mu.Unlock()
+
+	if handler == nil {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+		return
+	}
+
+	handler(w, httpReq)
+}
+
+// writeError writes an error response in the format used by the access node.
+func writeError(w http.ResponseWriter, status int, message string) {
+	body, _ := json.Marshal(struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}{status, message})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,195 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package httptest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	flowhttp "github.com/onflow/flow-go-sdk/access/http"
+	"github.com/onflow/flow-go-sdk/access/http/httptest"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func newClient(t *testing.T) (*httptest.Server, *flowhttp.Client) {
+	server := httptest.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := flowhttp.NewClient(server.URL)
+	require.NoError(t, err)
+
+	return server, client
+}
+
+func TestServer_Entities(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+
+	block := test.BlockGenerator().New()
+	account := test.AccountGenerator().New()
+	account.Keys = test.AccountKeyGenerator().NewAllAlgorithms()
+	collection := test.CollectionGenerator().New()
+	tx := test.TransactionGenerator().New()
+	result := test.TransactionResultGenerator().New()
+	events := test.BlockEventsGenerator().New()
+
+	server.SetLatestBlock(block, true)
+	server.AddAccount(account)
+	server.AddCollection(collection)
+	server.AddTransaction(tx)
+	server.AddTransactionResult(tx.ID(), &result)
+	server.AddEvents("A.Foo.Bar", events)
+	server.SetScriptResult(cadence.NewInt(42))
+	server.AcceptTransactions()
+	server.SetNodeVersionInfo(&flow.NodeVersionInfo{Semver: "v0.37.10", SporkID: block.ID, SporkRootBlockHeight: 1})
+	server.SetChainID(flow.Testnet)
+
+	latest, err := client.GetLatestBlock(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, block.ID, latest.ID)
+	assert.Equal(t, block.Height, latest.Height)
+
+	byHeight, err := client.GetBlockByHeight(ctx, block.Height)
+	require.NoError(t, err)
+	assert.Equal(t, block.ID, byHeight.ID)
+
+	byID, err := client.GetBlockByID(ctx, block.ID)
+	require.NoError(t, err)
+	assert.Equal(t, block.CollectionGuarantees, byID.CollectionGuarantees)
+
+	acc, err := client.GetAccount(ctx, account.Address)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address, acc.Address)
+	assert.Equal(t, account.Balance, acc.Balance)
+	assert.Equal(t, account.Keys, acc.Keys)
+
+	col, err := client.GetCollection(ctx, collection.ID())
+	require.NoError(t, err)
+	assert.Equal(t, collection, col)
+
+	fetchedTx, err := client.GetTransaction(ctx, tx.ID())
+	require.NoError(t, err)
+	assert.Equal(t, tx.PayloadMessage(), fetchedTx.PayloadMessage())
+
+	fetchedResult, err := client.GetTransactionResult(ctx, tx.ID())
+	require.NoError(t, err)
+	assert.Equal(t, result.Status, fetchedResult.Status)
+	assert.Equal(t, result.Error, fetchedResult.Error)
+	assert.Equal(t, result.Events, fetchedResult.Events)
+
+	blockEvents, err := client.GetEventsForHeightRange(ctx, "A.Foo.Bar", 1, 10)
+	require.NoError(t, err)
+	require.Len(t, blockEvents, 1)
+	assert.Equal(t, events.BlockID, blockEvents[0].BlockID)
+	assert.Equal(t, events.Events, blockEvents[0].Events)
+
+	value, err := client.ExecuteScriptAtLatestBlock(ctx, []byte("script"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewInt(42), value)
+
+	err = client.SendTransaction(ctx, *tx)
+	require.NoError(t, err)
+
+	chainID, err := client.GetChainID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, flow.Testnet, chainID)
+
+	version, err := access.DetectCadenceVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, sdkcadence.Version1, version)
+
+	server.AssertExpectations(t)
+}
+
+func TestServer_Responses(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+	tx := test.TransactionGenerator().New()
+
+	route := server.On(http.MethodPost, "/transactions").
+		ReplyError(http.StatusTooManyRequests, "rate limited").
+		Reply(http.StatusOK, map[string]string{})
+
+	err := client.SendTransaction(ctx, *tx)
+	assert.True(t, errors.Is(err, access.ErrRateLimited))
+
+	err = client.SendTransaction(ctx, *tx)
+	assert.NoError(t, err)
+
+	err = client.SendTransaction(ctx, *tx)
+	assert.NoError(t, err, "last response is repeated")
+
+	assert.Equal(t, 3, route.Calls())
+	assert.Contains(t, string(route.Requests()[0].Body), tx.ReferenceBlockID.String())
+	server.AssertCalled(t, http.MethodPost, "/transactions")
+}
+
+func TestServer_Routing(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+
+	block := test.BlockGenerator().New()
+	server.AddBlock(block)
+	server.On(http.MethodGet, "/blocks/"+block.ID.String()+"?expand=payload").
+		ReplyError(http.StatusInternalServerError, "overridden")
+
+	_, err := client.GetBlockByID(ctx, block.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "overridden", "route with more query parameters is used")
+
+	header, err := client.GetBlockHeaderByID(ctx, block.ID)
+	require.NoError(t, err)
+	assert.Equal(t, block.ID, header.ID)
+
+	_, err = client.GetBlockByHeight(ctx, block.Height+1)
+	assert.True(t, errors.Is(err, access.ErrNotFound))
+
+	require.Len(t, server.Unmatched(), 1)
+	assert.Equal(t, "/blocks", server.Unmatched()[0].Path)
+
+	recorder := &errorRecorder{}
+	assert.False(t, server.AssertExpectations(recorder))
+	assert.Equal(t, []string{
+		"expected a request to GET /blocks?height=" + fmt.Sprint(block.Height) + ", received none",
+		"unexpected request GET /blocks",
+	}, recorder.errors)
+
+	server.Reset()
+	assert.Empty(t, server.Requests())
+}
+
+type errorRecorder struct {
+	errors []string
+}
+
+func (r *errorRecorder) Helper() {}
+
+func (r *errorRecorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
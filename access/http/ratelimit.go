@@ -0,0 +1,156 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestClass groups the access node REST API requests by the resource they access.
+type RequestClass string
+
+const (
+	// AllRequests is the class including every request made by the client.
+	AllRequests              RequestClass = ""
+	BlocksRequests           RequestClass = "blocks"
+	AccountsRequests         RequestClass = "accounts"
+	CollectionsRequests      RequestClass = "collections"
+	TransactionsRequests     RequestClass = "transactions"
+	ScriptsRequests          RequestClass = "scripts"
+	EventsRequests           RequestClass = "events"
+	ExecutionResultsRequests RequestClass = "execution_results"
+)
+
+// requestClass returns the class of the request for the given URL path.
+func requestClass(path string) RequestClass {
+	for _, segment := range strings.Split(path, "/") {
+		switch class := RequestClass(segment); class {
+		case BlocksRequests, AccountsRequests, CollectionsRequests, TransactionsRequests,
+			ScriptsRequests, EventsRequests, ExecutionResultsRequests:
+			return class
+		}
+	}
+
+	return AllRequests
+}
+
+// WithRateLimit limits the rate of requests of the given class to requestsPerSecond,
+// allowing bursts of up to burst requests.
+//
+// Requests exceeding the rate wait until they are allowed or until their context is done.
+// Use the AllRequests class to limit the rate of all the requests made by the client,
+// this limit is applied in addition to any limit set for a specific class.
+//
+// A requestsPerSecond that isn't positive removes the limit of the class, and a burst below 1
+// allows a single request at a time.
+func WithRateLimit(class RequestClass, requestsPerSecond float64, burst int) ClientOption {
+	return func(h *httpHandler) {
+		if !(requestsPerSecond > 0) {
+			delete(h.limiters, class)
+			return
+		}
+		if h.limiters == nil {
+			h.limiters = make(map[RequestClass]*rateLimiter)
+		}
+		h.limiters[class] = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// waitRateLimit blocks until the request for the given URL path is allowed by the configured rate limits.
+func (h *httpHandler) waitRateLimit(ctx context.Context, path string) error {
+	if len(h.limiters) == 0 {
+		return nil
+	}
+
+	if limiter, ok := h.limiters[AllRequests]; ok {
+		if err := limiter.wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	class := requestClass(path)
+	if limiter, ok := h.limiters[class]; ok && class != AllRequests {
+		return limiter.wait(ctx)
+	}
+
+	return nil
+}
+
+// rateLimiter is a token bucket rate limiter.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token if one is available, otherwise it returns the time until the next token is available.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestClass(t *testing.T) {
+	assert.Equal(t, BlocksRequests, requestClass("/v1/blocks/1"))
+	assert.Equal(t, AccountsRequests, requestClass("/v1/accounts/0x01"))
+	assert.Equal(t, ScriptsRequests, requestClass("/v1/scripts"))
+	assert.Equal(t, EventsRequests, requestClass("/v1/events"))
+	assert.Equal(t, ExecutionResultsRequests, requestClass("/v1/execution_results"))
+	assert.Equal(t, AllRequests, requestClass("/v1/network/parameters"))
+}
+
+func TestHandler_RateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	t.Run("Limits Class", func(t *testing.T) {
+		h, err := newHandler(server.URL, WithRateLimit(BlocksRequests, 20, 1))
+		assert.NoError(t, err)
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+			assert.NoError(t, err)
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+		// other classes are not limited
+		start = time.Now()
+		for i := 0; i < 3; i++ {
+			_, err = h.getEvents(context.Background(), "A.Foo", "1", "2", nil)
+			assert.NoError(t, err)
+		}
+		assert.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("No Limit", func(t *testing.T) {
+		for _, rate := range []float64{0, -1} {
+			h, err := newHandler(server.URL, WithRateLimit(BlocksRequests, 20, 1), WithRateLimit(BlocksRequests, rate, 0))
+			assert.NoError(t, err)
+
+			start := time.Now()
+			for i := 0; i < 3; i++ {
+				_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+				assert.NoError(t, err)
+			}
+			assert.Less(t, time.Since(start), 50*time.Millisecond)
+		}
+	})
+
+	t.Run("Zero Burst", func(t *testing.T) {
+		h, err := newHandler(server.URL, WithRateLimit(BlocksRequests, 20, 0))
+		assert.NoError(t, err)
+
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+			assert.NoError(t, err)
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+
+	t.Run("Context Done", func(t *testing.T) {
+		h, err := newHandler(server.URL, WithRateLimit(AllRequests, 0.1, 1))
+		assert.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(context.Background(), "1", "", "")
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = h.getBlocksByHeights(ctx, "1", "", "")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
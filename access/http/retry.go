@@ -0,0 +1,145 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// RetryConfig defines how failed read-only requests are retried.
+//
+// Requests are retried with an exponential backoff, starting at InitialBackoff and growing by
+// Multiplier after each attempt up to MaxBackoff. Each backoff is randomized by the Jitter fraction
+// to avoid many clients retrying at the same time. Responses with a Retry-After header are retried
+// no sooner than requested by the header, up to MaxBackoff.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts, including the first request.
+	MaxAttempts int
+	// InitialBackoff is the wait time before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum wait time between retries.
+	MaxBackoff time.Duration
+	// Multiplier is the factor the backoff grows by after each retry.
+	Multiplier float64
+	// Jitter is the fraction (between 0 and 1) of the backoff that is randomized.
+	Jitter float64
+	// StatusCodes are the HTTP status codes of responses that are retried.
+	StatusCodes []int
+}
+
+// DefaultRetryConfig returns a retry configuration suitable for public access nodes,
+// retrying rate limited and temporarily unavailable responses.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    5,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetry enables retrying of read-only requests (blocks, accounts, scripts, events...)
+// using the provided retry configuration.
+//
+// Sending transactions is never retried since it is not an idempotent operation.
+func WithRetry(config RetryConfig) ClientOption {
+	return func(h *httpHandler) {
+		h.retry = &config
+	}
+}
+
+// retryable returns true if the error is a response with one of the retryable status codes.
+func (r *RetryConfig) retryable(err error) bool {
+	var httpErr HTTPError
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+
+	for _, code := range r.StatusCodes {
+		if httpErr.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait time before the retry following the given attempt (starting at 1).
+func (r *RetryConfig) backoff(attempt int) time.Duration {
+	backoff := float64(r.InitialBackoff) * math.Pow(r.Multiplier, float64(attempt-1))
+	if r.MaxBackoff > 0 && backoff > float64(r.MaxBackoff) {
+		backoff = float64(r.MaxBackoff)
+	}
+
+	if r.Jitter > 0 {
+		backoff += backoff * r.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(backoff)
+}
+
+// retryDelay returns the wait time before retrying the given attempt failed with err: at least as long
+// as requested by the Retry-After header of the response, but no longer than MaxBackoff.
+func (r *RetryConfig) retryDelay(attempt int, err error) time.Duration {
+	backoff := r.backoff(attempt)
+	if retryAfter := access.RetryAfter(err); retryAfter > backoff {
+		backoff = retryAfter
+		if r.MaxBackoff > 0 && backoff > r.MaxBackoff {
+			backoff = r.MaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// withRetry calls the request function, retrying it according to the retry configuration.
+func (h *httpHandler) withRetry(ctx context.Context, request func() error) error {
+	if h.retry == nil {
+		return request()
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := request()
+		if err == nil || attempt >= h.retry.MaxAttempts || !h.retry.retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(h.retry.retryDelay(attempt, err))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func retryServer(failures int32, status int) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			writer.WriteHeader(status)
+			_, _ = writer.Write([]byte("<html>unavailable</html>"))
+			return
+		}
+		_, _ = writer.Write([]byte("[]"))
+	}))
+
+	return server, &calls
+}
+
+func testRetryConfig() RetryConfig {
+	config := DefaultRetryConfig()
+	config.InitialBackoff = time.Millisecond
+	config.MaxAttempts = 3
+	return config
+}
+
+func TestHandler_Retry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Retries Transient Failures", func(t *testing.T) {
+		server, calls := retryServer(2, http.StatusServiceUnavailable)
+		defer server.Close()
+
+		h, err := newHandler(server.URL, WithRetry(testRetryConfig()))
+		assert.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(ctx, "1", "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+	})
+
+	t.Run("Stops After Max Attempts", func(t *testing.T) {
+		server, calls := retryServer(5, http.StatusTooManyRequests)
+		defer server.Close()
+
+		h, err := newHandler(server.URL, WithRetry(testRetryConfig()))
+		assert.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(ctx, "1", "", "")
+		assert.Error(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+
+		var httpErr HTTPError
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+		assert.Equal(t, "<html>unavailable</html>", httpErr.Message)
+	})
+
+	t.Run("Caps Retry-After", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				writer.Header().Set("Retry-After", "3600")
+				writer.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = writer.Write([]byte("[]"))
+		}))
+		defer server.Close()
+
+		config := testRetryConfig()
+		config.MaxBackoff = 10 * time.Millisecond
+
+		h, err := newHandler(server.URL, WithRetry(config))
+		assert.NoError(t, err)
+
+		start := time.Now()
+		_, err = h.getBlocksByHeights(ctx, "1", "", "")
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("Does Not Retry Other Status Codes", func(t *testing.T) {
+		server, calls := retryServer(5, http.StatusNotFound)
+		defer server.Close()
+
+		h, err := newHandler(server.URL, WithRetry(testRetryConfig()))
+		assert.NoError(t, err)
+
+		_, err = h.getBlocksByHeights(ctx, "1", "", "")
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+	})
+
+	t.Run("Does Not Retry Transactions", func(t *testing.T) {
+		server, calls := retryServer(5, http.StatusServiceUnavailable)
+		defer server.Close()
+
+		h, err := newHandler(server.URL, WithRetry(testRetryConfig()))
+		assert.NoError(t, err)
+
+		err = h.sendTransaction(ctx, []byte("{}"))
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+	})
+}
+
+func TestRetryConfig_Backoff(t *testing.T) {
+	config := RetryConfig{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, config.backoff(1))
+	assert.Equal(t, 400*time.Millisecond, config.backoff(3))
+	assert.Equal(t, time.Second, config.backoff(10))
+
+	config.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		backoff := config.backoff(1)
+		assert.GreaterOrEqual(t, backoff, 50*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 150*time.Millisecond)
+	}
+}
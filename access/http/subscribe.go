@@ -0,0 +1,548 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/http/models"
+)
+
+// streaming topics of the access node websocket API.
+const (
+	blocksTopic       = "blocks"
+	blockDigestsTopic = "block_digests"
+	eventsTopic       = "events"
+
+	accountStatusesTopic = "account_statuses"
+
+	transactionStatusesTopic     = "transaction_statuses"
+	sendTransactionStatusesTopic = "send_and_get_transaction_statuses"
+)
+
+// SubscribeOption configures a subscription.
+type SubscribeOption func(c *subscribeConfig)
+
+type subscribeConfig struct {
+	blockStatus      flow.BlockStatus
+	maxReconnects    int
+	reconnectBackoff time.Duration
+}
+
+func newSubscribeConfig(opts []SubscribeOption) subscribeConfig {
+	config := subscribeConfig{
+		blockStatus:      flow.BlockStatusSealed,
+		maxReconnects:    5,
+		reconnectBackoff: time.Second,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// WithBlockStatus sets the status of the blocks the subscription follows, sealed blocks by default.
+func WithBlockStatus(status flow.BlockStatus) SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.blockStatus = status
+	}
+}
+
+// WithReconnect sets how many times in a row the subscription reconnects after the connection is lost,
+// waiting for the backoff duration before each attempt. Reconnecting is disabled with zero attempts.
+//
+// By default, subscriptions reconnect up to 5 times with a 1 second backoff.
+func WithReconnect(maxAttempts int, backoff time.Duration) SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.maxReconnects = maxAttempts
+		c.reconnectBackoff = backoff
+	}
+}
+
+// reconnectable returns true if the subscription failed because of the connection and can be resumed.
+func reconnectable(err error) bool {
+	var httpErr HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code >= 500
+	}
+	return true
+}
+
+// errSubscriptionComplete is returned by subscription handlers to end the subscription without error.
+var errSubscriptionComplete = errors.New("subscription complete")
+
+// subscriptionRequest returns the topic and arguments of a subscription.
+type subscriptionRequest func() (topic string, arguments map[string]interface{})
+
+// topicRequest returns a subscription request for the topic with the arguments returned by the arguments function.
+func topicRequest(topic string, arguments func() map[string]interface{}) subscriptionRequest {
+	return func() (string, map[string]interface{}) {
+		return topic, arguments()
+	}
+}
+
+// subscribe subscribes to a topic and calls handle with every received payload until the context is done,
+// handle fails or the subscription fails without being able to reconnect.
+//
+// The request function is called before every connection attempt, allowing the subscription to resume
+// from the last handled payload. The subscription error, if any, is sent to the returned channel, which is
+// closed when the subscription ends, after calling done.
+func (c *BaseClient) subscribe(
+	ctx context.Context,
+	request subscriptionRequest,
+	config subscribeConfig,
+	handle func(payload json.RawMessage) error,
+	done func(),
+) (<-chan error, error) {
+	topic, arguments := request()
+	sub, err := c.handler.subscribe(ctx, topic, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(errChan)
+		defer done()
+
+		err := c.receive(ctx, sub, topic, request, config, handle)
+		if err != nil && ctx.Err() == nil {
+			errChan <- err
+		}
+	}()
+
+	return errChan, nil
+}
+
+func (c *BaseClient) receive(
+	ctx context.Context,
+	sub subscription,
+	topic string,
+	request subscriptionRequest,
+	config subscribeConfig,
+	handle func(payload json.RawMessage) error,
+) error {
+	for attempt := 0; ; {
+		var err error
+		if sub == nil {
+			var arguments map[string]interface{}
+			topic, arguments = request()
+			sub, err = c.handler.subscribe(ctx, topic, arguments)
+		}
+
+		if sub != nil {
+			for {
+				var payload json.RawMessage
+				payload, err = sub.next()
+				if err != nil {
+					break
+				}
+
+				attempt = 0
+				if err = handle(payload); err != nil {
+					_ = sub.close()
+					if errors.Is(err, errSubscriptionComplete) {
+						return nil
+					}
+					return err
+				}
+			}
+
+			_ = sub.close()
+			sub = nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if !reconnectable(err) || attempt >= config.maxReconnects {
+			return fmt.Errorf("subscription to %s failed: %w", topic, err)
+		}
+		attempt++
+
+		timer := time.NewTimer(config.reconnectBackoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// SubscribeBlocks subscribes to the blocks starting at the provided height, sealed blocks by default.
+//
+// Blocks are delivered in height order on the returned block channel. If the connection to the access node
+// is lost, the subscription reconnects and resumes after the last delivered block. The subscription ends when
+// the context is done, or when it fails, in which case the error is sent on the error channel. Both channels
+// are closed when the subscription ends.
+func (c *BaseClient) SubscribeBlocks(
+	ctx context.Context,
+	startHeight uint64,
+	opts ...SubscribeOption,
+) (<-chan *flow.Block, <-chan error, error) {
+	config := newSubscribeConfig(opts)
+
+	next := startHeight
+	arguments := func() map[string]interface{} {
+		return map[string]interface{}{
+			"block_status":       config.blockStatus.String(),
+			"start_block_height": fmt.Sprintf("%d", next),
+		}
+	}
+
+	blocks := make(chan *flow.Block)
+	handle := func(payload json.RawMessage) error {
+		var block models.Block
+		if err := json.Unmarshal(payload, &block); err != nil {
+			return fmt.Errorf("block decoding failed: %w", err)
+		}
+
+		converted, err := toBlock(&block)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case blocks <- converted:
+			next = converted.Height + 1
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	errChan, err := c.subscribe(ctx, topicRequest(blocksTopic, arguments), config, handle, func() { close(blocks) })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blocks, errChan, nil
+}
+
+// SubscribeEvents subscribes to the events matching the filter, starting at the provided block height.
+//
+// Events are delivered grouped by block in height order on the returned channel. The access node also sends
+// heartbeat messages for blocks without matching events, which are delivered with an empty list of events
+// and can be used to track the progress of the subscription. If the connection is lost, the subscription
+// reconnects and resumes after the last delivered block. Both channels are closed when the subscription ends.
+func (c *BaseClient) SubscribeEvents(
+	ctx context.Context,
+	startHeight uint64,
+	filter flow.EventFilter,
+	opts ...SubscribeOption,
+) (<-chan flow.BlockEvents, <-chan error, error) {
+	config := newSubscribeConfig(opts)
+
+	next := startHeight
+	arguments := func() map[string]interface{} {
+		args := map[string]interface{}{
+			"start_block_height": fmt.Sprintf("%d", next),
+		}
+		if len(filter.EventTypes) > 0 {
+			args["event_types"] = filter.EventTypes
+		}
+		if len(filter.Addresses) > 0 {
+			args["addresses"] = filter.Addresses
+		}
+		if len(filter.Contracts) > 0 {
+			args["contracts"] = filter.Contracts
+		}
+		return args
+	}
+
+	events := make(chan flow.BlockEvents)
+	handle := func(payload json.RawMessage) error {
+		var blockEvents models.BlockEvents
+		if err := json.Unmarshal(payload, &blockEvents); err != nil {
+			return fmt.Errorf("events decoding failed: %w", err)
+		}
+
+		converted, err := toBlockEvents([]models.BlockEvents{blockEvents}, c.jsonOptions)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case events <- converted[0]:
+			next = converted[0].Height + 1
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	errChan, err := c.subscribe(ctx, topicRequest(eventsTopic, arguments), config, handle, func() { close(events) })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, errChan, nil
+}
+
+// transactionStatusMessage is the payload of transaction status subscriptions.
+type transactionStatusMessage struct {
+	TransactionResult models.TransactionResult `json:"transaction_result"`
+}
+
+// final returns true if the transaction status can't change anymore.
+func final(status flow.TransactionStatus) bool {
+	return status == flow.TransactionStatusSealed || status == flow.TransactionStatusExpired
+}
+
+// SendAndSubscribeTransactionStatuses sends the transaction and subscribes to its status updates.
+//
+// The transaction result is delivered on the returned channel every time the transaction status changes,
+// from pending to sealed or expired, after which the subscription ends. If the connection is lost after
+// a status of the transaction was received, the subscription reconnects without sending the transaction
+// again. Both channels are closed when the subscription ends.
+func (c *BaseClient) SendAndSubscribeTransactionStatuses(
+	ctx context.Context,
+	tx flow.Transaction,
+	opts ...SubscribeOption,
+) (<-chan *flow.TransactionResult, <-chan error, error) {
+	config := newSubscribeConfig(opts)
+
+	body, err := encodeTransaction(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var txArguments map[string]interface{}
+	if err := json.Unmarshal(body, &txArguments); err != nil {
+		return nil, nil, err
+	}
+
+	// the transaction is only known to be sent once a status is received for it,
+	// until then reconnecting sends it again
+	sent := false
+	request := func() (string, map[string]interface{}) {
+		if sent {
+			return transactionStatusesTopic, map[string]interface{}{"tx_id": tx.ID().String()}
+		}
+		return sendTransactionStatusesTopic, txArguments
+	}
+
+	results := make(chan *flow.TransactionResult)
+	status := flow.TransactionStatusUnknown
+	handle := func(payload json.RawMessage) error {
+		var msg transactionStatusMessage
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			return fmt.Errorf("transaction result decoding failed: %w", err)
+		}
+
+		result, err := toTransactionResult(&msg.TransactionResult, c.jsonOptions)
+		if err != nil {
+			return err
+		}
+		sent = true
+
+		if result.Status == status { // statuses are delivered again after reconnecting
+			return nil
+		}
+
+		select {
+		case results <- result:
+			status = result.Status
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if final(result.Status) {
+			return errSubscriptionComplete
+		}
+		return nil
+	}
+
+	errChan, err := c.subscribe(ctx, request, config, handle, func() { close(results) })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, errChan, nil
+}
+
+// accountStatusesMessage is the payload of account status subscriptions.
+type accountStatusesMessage struct {
+	BlockID       string                    `json:"block_id"`
+	Height        string                    `json:"height"`
+	AccountEvents map[string][]models.Event `json:"account_events"`
+}
+
+// SubscribeAccountStatuses subscribes to the core account events (account created, key added or removed,
+// contract added, updated or removed) of the accounts matching the filter, starting at the provided block height.
+//
+// Account statuses are delivered in height order on the returned channel. If the connection is lost,
+// the subscription reconnects and resumes after the last delivered block. Both channels are closed
+// when the subscription ends.
+func (c *BaseClient) SubscribeAccountStatuses(
+	ctx context.Context,
+	startHeight uint64,
+	filter flow.AccountStatusFilter,
+	opts ...SubscribeOption,
+) (<-chan *flow.AccountStatus, <-chan error, error) {
+	config := newSubscribeConfig(opts)
+
+	addresses := make([]string, len(filter.Addresses))
+	for i, address := range filter.Addresses {
+		addresses[i] = address.String()
+	}
+
+	next := startHeight
+	arguments := func() map[string]interface{} {
+		args := map[string]interface{}{
+			"start_block_height": fmt.Sprintf("%d", next),
+		}
+		if len(addresses) > 0 {
+			args["account_addresses"] = addresses
+		}
+		if len(filter.EventTypes) > 0 {
+			args["event_types"] = filter.EventTypes
+		}
+		return args
+	}
+
+	statuses := make(chan *flow.AccountStatus)
+	handle := func(payload json.RawMessage) error {
+		var msg accountStatusesMessage
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			return fmt.Errorf("account statuses decoding failed: %w", err)
+		}
+
+		blockID, err := toID(msg.BlockID, "block ID")
+		if err != nil {
+			return err
+		}
+
+		height, err := toUint(msg.Height, "block height")
+		if err != nil {
+			return err
+		}
+
+		status := &flow.AccountStatus{
+			BlockID:     blockID,
+			BlockHeight: height,
+			Events:      make(map[flow.Address][]flow.Event, len(msg.AccountEvents)),
+		}
+		for address, events := range msg.AccountEvents {
+			converted, err := toEvents(events, c.jsonOptions)
+			if err != nil {
+				return err
+			}
+
+			flowAddress, err := toAddress(address, "account address")
+			if err != nil {
+				return err
+			}
+			status.Events[flowAddress] = converted
+		}
+
+		select {
+		case statuses <- status:
+			next = status.BlockHeight + 1
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	errChan, err := c.subscribe(ctx, topicRequest(accountStatusesTopic, arguments), config, handle, func() { close(statuses) })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return statuses, errChan, nil
+}
+
+// blockDigestMessage is the payload of block digest subscriptions.
+type blockDigestMessage struct {
+	BlockID   string    `json:"block_id"`
+	Height    string    `json:"height"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// SubscribeBlockDigests subscribes to the digests of the blocks with the provided status, starting at the
+// latest block with that status. Digests only contain the block ID, height and timestamp, which makes them
+// suited to follow the progress of the chain.
+//
+// Digests are delivered in height order on the returned channel. If the connection is lost, the subscription
+// reconnects and resumes after the last delivered block. Both channels are closed when the subscription ends.
+func (c *BaseClient) SubscribeBlockDigests(
+	ctx context.Context,
+	blockStatus flow.BlockStatus,
+	opts ...SubscribeOption,
+) (<-chan *flow.BlockDigest, <-chan error, error) {
+	config := newSubscribeConfig(opts)
+
+	var next uint64
+	arguments := func() map[string]interface{} {
+		args := map[string]interface{}{
+			"block_status": blockStatus.String(),
+		}
+		if next > 0 {
+			args["start_block_height"] = fmt.Sprintf("%d", next)
+		}
+		return args
+	}
+
+	digests := make(chan *flow.BlockDigest)
+	handle := func(payload json.RawMessage) error {
+		var msg blockDigestMessage
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			return fmt.Errorf("block digest decoding failed: %w", err)
+		}
+
+		blockID, err := toID(msg.BlockID, "block ID")
+		if err != nil {
+			return err
+		}
+
+		height, err := toUint(msg.Height, "block height")
+		if err != nil {
+			return err
+		}
+
+		digest := &flow.BlockDigest{
+			ID:        blockID,
+			Height:    height,
+			Timestamp: msg.Timestamp,
+		}
+
+		select {
+		case digests <- digest:
+			next = digest.Height + 1
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	errChan, err := c.subscribe(ctx, topicRequest(blockDigestsTopic, arguments), config, handle, func() { close(digests) })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return digests, errChan, nil
+}
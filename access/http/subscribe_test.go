@@ -0,0 +1,429 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/http/models"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// streamServer is a fake access node websocket API, serving the payloads returned by
+// the serve function for each connection and recording the subscription requests.
+type streamServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []subscribeRequest
+}
+
+func newStreamServer(t *testing.T, serve func(conn int, req subscribeRequest) []interface{}) *streamServer {
+	s := &streamServer{}
+	s.Server = httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		var req subscribeRequest
+		require.NoError(t, websocket.JSON.Receive(ws, &req))
+
+		s.mu.Lock()
+		s.requests = append(s.requests, req)
+		conn := len(s.requests)
+		s.mu.Unlock()
+
+		_ = websocket.JSON.Send(ws, subscriptionMessage{SubscriptionID: "1", Action: "subscribe"})
+		for _, payload := range serve(conn, req) {
+			if err, ok := payload.(*models.ModelError); ok {
+				_ = websocket.JSON.Send(ws, subscriptionMessage{SubscriptionID: "1", Error: err})
+				continue
+			}
+
+			data, err := json.Marshal(payload)
+			require.NoError(t, err)
+			_ = websocket.JSON.Send(ws, subscriptionMessage{SubscriptionID: "1", Topic: req.Topic, Payload: data})
+		}
+	}))
+
+	return s
+}
+
+func (s *streamServer) subscribeRequests() []subscribeRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func blockAtHeight(height uint64) models.Block {
+	block := blockFlowFixture()
+	block.Header.Height = fmt.Sprintf("%d", height)
+	return block
+}
+
+func TestBaseClient_SubscribeBlocks(t *testing.T) {
+	t.Run("Resumes After Reconnect", func(t *testing.T) {
+		server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+			if conn == 1 {
+				return []interface{}{blockAtHeight(10), blockAtHeight(11)}
+			}
+			return []interface{}{blockAtHeight(12), &models.ModelError{Code: 400, Message: "invalid"}}
+		})
+		defer server.Close()
+
+		client, err := NewBaseClient(server.URL)
+		require.NoError(t, err)
+
+		blocks, errs, err := client.SubscribeBlocks(
+			context.Background(),
+			10,
+			WithBlockStatus(flow.BlockStatusFinalized),
+			WithReconnect(1, time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		var heights []uint64
+		for block := range blocks {
+			heights = append(heights, block.Height)
+		}
+		assert.Equal(t, []uint64{10, 11, 12}, heights)
+
+		err = <-errs
+		assert.EqualError(t, err, "subscription to blocks failed: invalid")
+
+		requests := server.subscribeRequests()
+		require.Len(t, requests, 2)
+		assert.Equal(t, "blocks", requests[0].Topic)
+		assert.Equal(t, map[string]interface{}{
+			"block_status":       "finalized",
+			"start_block_height": "10",
+		}, requests[0].Arguments)
+		assert.Equal(t, "12", requests[1].Arguments["start_block_height"])
+	})
+
+	t.Run("Context Canceled", func(t *testing.T) {
+		server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+			return []interface{}{blockAtHeight(1), blockAtHeight(2)}
+		})
+		defer server.Close()
+
+		client, err := NewBaseClient(server.URL)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		blocks, errs, err := client.SubscribeBlocks(ctx, 1)
+		require.NoError(t, err)
+
+		<-blocks
+		cancel()
+
+		for range blocks {
+		}
+		assert.NoError(t, <-errs)
+	})
+
+	t.Run("Through Proxy", func(t *testing.T) {
+		server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+			return []interface{}{blockAtHeight(1)}
+		})
+		defer server.Close()
+
+		var connects []string
+		var mu sync.Mutex
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			connects = append(connects, r.Method+" "+r.Host)
+			mu.Unlock()
+
+			upstream, err := net.Dial("tcp", r.Host)
+			require.NoError(t, err)
+			defer upstream.Close()
+
+			conn, rw, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			defer conn.Close()
+			_, err = conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+			require.NoError(t, err)
+
+			go func() { _, _ = io.Copy(upstream, rw) }()
+			_, _ = io.Copy(conn, upstream)
+		}))
+		defer proxy.Close()
+
+		proxyURL, err := url.Parse(proxy.URL)
+		require.NoError(t, err)
+
+		client, err := NewBaseClient(
+			server.URL,
+			WithHTTPClient(&http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		blocks, _, err := client.SubscribeBlocks(ctx, 1)
+		require.NoError(t, err)
+
+		block := <-blocks
+		assert.Equal(t, uint64(1), block.Height)
+
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []string{"CONNECT " + serverURL.Host}, connects)
+	})
+
+	t.Run("Connection Failure", func(t *testing.T) {
+		client, err := NewBaseClient("http://127.0.0.1:1")
+		require.NoError(t, err)
+
+		_, _, err = client.SubscribeBlocks(context.Background(), 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestBaseClient_SubscribeEvents(t *testing.T) {
+	blockEvents := func(height uint64, events int) models.BlockEvents {
+		e := blockEventsFlowFixture()
+		e.BlockHeight = fmt.Sprintf("%d", height)
+		e.Events = eventsFlowFixture(events)
+		return e
+	}
+
+	server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+		if conn == 1 {
+			return []interface{}{blockEvents(5, 2), blockEvents(6, 0)}
+		}
+		return []interface{}{blockEvents(7, 1), &models.ModelError{Code: 400, Message: "invalid"}}
+	})
+	defer server.Close()
+
+	client, err := NewBaseClient(server.URL)
+	require.NoError(t, err)
+
+	filter := flow.EventFilter{
+		EventTypes: []string{"A.0b2a3299cc857e29.TopShot.Deposit"},
+		Contracts:  []string{"A.0b2a3299cc857e29.TopShot"},
+	}
+
+	events, errs, err := client.SubscribeEvents(context.Background(), 5, filter, WithReconnect(1, time.Millisecond))
+	require.NoError(t, err)
+
+	var received []flow.BlockEvents
+	for e := range events {
+		received = append(received, e)
+	}
+	assert.EqualError(t, <-errs, "subscription to events failed: invalid")
+
+	require.Len(t, received, 3)
+	assert.Equal(t, uint64(5), received[0].Height)
+	assert.Len(t, received[0].Events, 2)
+	assert.Len(t, received[1].Events, 0)
+	assert.Equal(t, uint64(7), received[2].Height)
+
+	requests := server.subscribeRequests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "events", requests[0].Topic)
+	assert.Equal(t, map[string]interface{}{
+		"start_block_height": "5",
+		"event_types":        []interface{}{"A.0b2a3299cc857e29.TopShot.Deposit"},
+		"contracts":          []interface{}{"A.0b2a3299cc857e29.TopShot"},
+	}, requests[0].Arguments)
+	assert.Equal(t, "7", requests[1].Arguments["start_block_height"])
+}
+
+func TestBaseClient_SendAndSubscribeTransactionStatuses(t *testing.T) {
+	txResult := func(status models.TransactionStatus) interface{} {
+		result := transactionResultFlowFixture()
+		result.Status = &status
+		return transactionStatusMessage{TransactionResult: result}
+	}
+
+	t.Run("Resumes After Reconnect", func(t *testing.T) {
+		server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+			if conn == 1 {
+				return []interface{}{txResult(models.PENDING_TransactionStatus)}
+			}
+			return []interface{}{
+				txResult(models.PENDING_TransactionStatus),
+				txResult(models.EXECUTED_TransactionStatus),
+				txResult(models.SEALED_TransactionStatus),
+			}
+		})
+		defer server.Close()
+
+		client, err := NewBaseClient(server.URL)
+		require.NoError(t, err)
+
+		tx := test.TransactionGenerator().New()
+		results, errs, err := client.SendAndSubscribeTransactionStatuses(
+			context.Background(),
+			*tx,
+			WithReconnect(1, time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		var statuses []flow.TransactionStatus
+		for result := range results {
+			statuses = append(statuses, result.Status)
+		}
+		assert.NoError(t, <-errs)
+
+		assert.Equal(t, []flow.TransactionStatus{
+			flow.TransactionStatusPending,
+			flow.TransactionStatusExecuted,
+			flow.TransactionStatusSealed,
+		}, statuses)
+
+		requests := server.subscribeRequests()
+		require.Len(t, requests, 2)
+		assert.Equal(t, "send_and_get_transaction_statuses", requests[0].Topic)
+		assert.Equal(t, tx.ReferenceBlockID.String(), requests[0].Arguments["reference_block_id"])
+		assert.Equal(t, "transaction_statuses", requests[1].Topic)
+		assert.Equal(t, map[string]interface{}{"tx_id": tx.ID().String()}, requests[1].Arguments)
+	})
+
+	t.Run("Sends Again Before Status", func(t *testing.T) {
+		server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+			if conn == 1 {
+				return nil
+			}
+			return []interface{}{txResult(models.SEALED_TransactionStatus)}
+		})
+		defer server.Close()
+
+		client, err := NewBaseClient(server.URL)
+		require.NoError(t, err)
+
+		results, errs, err := client.SendAndSubscribeTransactionStatuses(
+			context.Background(),
+			*test.TransactionGenerator().New(),
+			WithReconnect(1, time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		for range results {
+		}
+		assert.NoError(t, <-errs)
+
+		requests := server.subscribeRequests()
+		require.Len(t, requests, 2)
+		assert.Equal(t, "send_and_get_transaction_statuses", requests[0].Topic)
+		assert.Equal(t, "send_and_get_transaction_statuses", requests[1].Topic)
+	})
+}
+
+func TestBaseClient_SubscribeAccountStatuses(t *testing.T) {
+	address := flow.HexToAddress("0x01")
+
+	server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+		return []interface{}{
+			accountStatusesMessage{
+				BlockID:       "0x01",
+				Height:        "42",
+				AccountEvents: map[string][]models.Event{address.String(): eventsFlowFixture(2)},
+			},
+			&models.ModelError{Code: 400, Message: "invalid"},
+		}
+	})
+	defer server.Close()
+
+	client, err := NewBaseClient(server.URL)
+	require.NoError(t, err)
+
+	statuses, errs, err := client.SubscribeAccountStatuses(context.Background(), 40, flow.AccountStatusFilter{
+		Addresses:  []flow.Address{address},
+		EventTypes: []string{flow.EventAccountKeyAdded},
+	})
+	require.NoError(t, err)
+
+	status := <-statuses
+	assert.Equal(t, uint64(42), status.BlockHeight)
+	assert.Len(t, status.Events[address], 2)
+
+	for range statuses {
+	}
+	assert.EqualError(t, <-errs, "subscription to account_statuses failed: invalid")
+
+	requests := server.subscribeRequests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, "account_statuses", requests[0].Topic)
+	assert.Equal(t, map[string]interface{}{
+		"start_block_height": "40",
+		"account_addresses":  []interface{}{address.String()},
+		"event_types":        []interface{}{flow.EventAccountKeyAdded},
+	}, requests[0].Arguments)
+}
+
+func TestBaseClient_SubscribeBlockDigests(t *testing.T) {
+	digest := func(height uint64) blockDigestMessage {
+		return blockDigestMessage{
+			BlockID:   "0x01",
+			Height:    fmt.Sprintf("%d", height),
+			Timestamp: time.Unix(1000, 0).UTC(),
+		}
+	}
+
+	server := newStreamServer(t, func(conn int, req subscribeRequest) []interface{} {
+		if conn == 1 {
+			return []interface{}{digest(100)}
+		}
+		return []interface{}{digest(101), &models.ModelError{Code: 400, Message: "invalid"}}
+	})
+	defer server.Close()
+
+	client, err := NewBaseClient(server.URL)
+	require.NoError(t, err)
+
+	digests, errs, err := client.SubscribeBlockDigests(
+		context.Background(),
+		flow.BlockStatusSealed,
+		WithReconnect(1, time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	var heights []uint64
+	for d := range digests {
+		heights = append(heights, d.Height)
+		assert.Equal(t, time.Unix(1000, 0).UTC(), d.Timestamp)
+	}
+	assert.Error(t, <-errs)
+	assert.Equal(t, []uint64{100, 101}, heights)
+
+	requests := server.subscribeRequests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, map[string]interface{}{"block_status": "sealed"}, requests[0].Arguments)
+	assert.Equal(t, map[string]interface{}{
+		"block_status":       "sealed",
+		"start_block_height": "101",
+	}, requests[1].Arguments)
+}
@@ -0,0 +1,328 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+
+	"github.com/onflow/flow-go-sdk/access/http/models"
+)
+
+// websocketPath is the path of the access node websocket streaming API, relative to the REST API base.
+const websocketPath = "/ws"
+
+// subscribeRequest is a request sent to the access node websocket API.
+type subscribeRequest struct {
+	SubscriptionID string                 `json:"subscription_id,omitempty"`
+	Action         string                 `json:"action"`
+	Topic          string                 `json:"topic,omitempty"`
+	Arguments      map[string]interface{} `json:"arguments,omitempty"`
+}
+
+// subscriptionMessage is a message received from the access node websocket API.
+type subscriptionMessage struct {
+	SubscriptionID string             `json:"subscription_id"`
+	Topic          string             `json:"topic,omitempty"`
+	Action         string             `json:"action,omitempty"`
+	Payload        json.RawMessage    `json:"payload,omitempty"`
+	Error          *models.ModelError `json:"error,omitempty"`
+}
+
+// subscription receives the payloads of a subscription to a streaming topic.
+type subscription interface {
+	// next blocks until the next payload is received.
+	next() (json.RawMessage, error)
+	// close ends the subscription and closes the connection.
+	close() error
+}
+
+// wsSubscription is a subscription over a websocket connection.
+type wsSubscription struct {
+	conn      *websocket.Conn
+	url       string
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ subscription = (*wsSubscription)(nil)
+
+func (s *wsSubscription) next() (json.RawMessage, error) {
+	for {
+		var msg subscriptionMessage
+		err := websocket.JSON.Receive(s.conn, &msg)
+		if err != nil {
+			return nil, err
+		}
+
+		if msg.Error != nil {
+			return nil, HTTPError{
+				Url:     s.url,
+				Code:    int(msg.Error.Code),
+				Message: msg.Error.Message,
+			}
+		}
+
+		if len(msg.Payload) == 0 { // subscription acknowledgements don't carry a payload
+			continue
+		}
+
+		return msg.Payload, nil
+	}
+}
+
+func (s *wsSubscription) close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		err = s.conn.Close()
+	})
+	return err
+}
+
+// websocketURL returns the URL of the websocket streaming API of the access node.
+func (h *httpHandler) websocketURL() (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSuffix(h.base, "/") + websocketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+
+	return u, nil
+}
+
+// transport returns the HTTP transport of the client, so websocket connections use the same
+// dialer, proxy and TLS configuration as the requests to the REST API.
+//
+// Clients with a custom round tripper fall back to the default transport.
+func (h *httpHandler) transport() *http.Transport {
+	if h.client != nil {
+		if transport, ok := h.client.Transport.(*http.Transport); ok {
+			return transport
+		}
+	}
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		return transport
+	}
+	return &http.Transport{}
+}
+
+// dial opens a connection to the host of the URL, using TLS for secure websocket URLs.
+//
+// The connection is made with the dialer, proxy and TLS configuration of the client transport,
+// tunnelling through the proxy with a CONNECT request when one is configured.
+func (h *httpHandler) dial(ctx context.Context, u *url.URL) (net.Conn, error) {
+	transport := h.transport()
+
+	host := u.Host
+	if u.Port() == "" {
+		port := "80"
+		if u.Scheme == "wss" {
+			port = "443"
+		}
+		host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	var proxyURL *url.URL
+	if transport.Proxy != nil {
+		target := *u
+		target.Scheme = "http"
+		if u.Scheme == "wss" {
+			target.Scheme = "https"
+		}
+
+		var err error
+		proxyURL, err = transport.Proxy(&http.Request{Method: http.MethodGet, URL: &target, Header: http.Header{}})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addr := host
+	if proxyURL != nil {
+		addr = proxyURL.Host
+		if proxyURL.Port() == "" {
+			port := "80"
+			if proxyURL.Scheme == "https" {
+				port = "443"
+			}
+			addr = net.JoinHostPort(proxyURL.Hostname(), port)
+		}
+	}
+
+	dialContext := transport.DialContext
+	if dialContext == nil {
+		var dialer net.Dialer
+		dialContext = dialer.DialContext
+	}
+
+	conn, err := dialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if proxyURL != nil {
+		if proxyURL.Scheme == "https" {
+			conn, err = tlsHandshake(ctx, conn, transport.TLSClientConfig, proxyURL.Hostname())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := connectProxy(conn, proxyURL, host); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+
+	if u.Scheme != "wss" {
+		return conn, nil
+	}
+
+	return tlsHandshake(ctx, conn, transport.TLSClientConfig, u.Hostname())
+}
+
+// tlsHandshake wraps the connection in a TLS client connection to the server name,
+// closing the connection if the handshake fails.
+func tlsHandshake(ctx context.Context, conn net.Conn, config *tls.Config, serverName string) (net.Conn, error) {
+	if config == nil {
+		config = &tls.Config{}
+	} else {
+		config = config.Clone()
+	}
+	if config.ServerName == "" {
+		config.ServerName = serverName
+	}
+
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return tlsConn, nil
+}
+
+// connectProxy opens a tunnel to the host through the proxy connection with a CONNECT request.
+func connectProxy(conn net.Conn, proxyURL *url.URL, host string) error {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: host},
+		Host:   host,
+		Header: http.Header{},
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		return err
+	}
+
+	res, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("proxy %s refused the connection: %s", proxyURL.Host, res.Status)
+	}
+
+	return nil
+}
+
+// subscribe opens a websocket connection to the access node and subscribes to the topic with the arguments.
+//
+// The connection is closed when the context is done or when the subscription is closed.
+func (h *httpHandler) subscribe(
+	ctx context.Context,
+	topic string,
+	arguments map[string]interface{},
+) (subscription, error) {
+	u, err := h.websocketURL()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := websocket.NewConfig(u.String(), h.base)
+	if err != nil {
+		return nil, err
+	}
+	config.Header = h.headers.Clone()
+
+	conn, err := h.dial(ctx, u)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("connect to %s failed", u))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	ws, err := websocket.NewClient(config, conn)
+	if err != nil {
+		close(done)
+		_ = conn.Close()
+		return nil, errors.Wrap(err, fmt.Sprintf("websocket handshake with %s failed", u))
+	}
+
+	sub := &wsSubscription{
+		conn: ws,
+		url:  u.String(),
+		done: done,
+	}
+
+	err = websocket.JSON.Send(ws, subscribeRequest{
+		Action:    "subscribe",
+		Topic:     topic,
+		Arguments: arguments,
+	})
+	if err != nil {
+		_ = sub.close()
+		return nil, errors.Wrap(err, fmt.Sprintf("subscribe to %s failed", topic))
+	}
+
+	return sub, nil
+}
@@ -0,0 +1,60 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// ConfirmKeyRotation waits for a key rotation transaction generated with templates.RotateAccountKey
+// to be sealed and fetches the account to confirm the new key was added and the old key revoked.
+//
+// The account key matching the new key is returned, with the index assigned to it.
+func ConfirmKeyRotation(
+	ctx context.Context,
+	client Client,
+	txID flow.Identifier,
+	address flow.Address,
+	newKey *flow.AccountKey,
+	oldKeyIndex int,
+	opts ...WaitOption,
+) (*flow.AccountKey, error) {
+	result, err := WaitForSeal(ctx, client, txID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for key rotation transaction %s: %w", txID, err)
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("key rotation transaction %s failed: %w", txID, result.Error)
+	}
+
+	account, err := client.GetAccount(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", address, err)
+	}
+
+	if err := templates.VerifyAccountKeyRevoked(account, oldKeyIndex); err != nil {
+		return nil, err
+	}
+
+	return templates.VerifyAccountKeyAdded(account, newKey)
+}
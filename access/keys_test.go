@@ -0,0 +1,75 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// rotationClient is a client stub sealing transactions immediately and serving the configured account.
+type rotationClient struct {
+	waitClient
+	account *flow.Account
+}
+
+func (c *rotationClient) GetAccount(context.Context, flow.Address) (*flow.Account, error) {
+	return c.account, nil
+}
+
+func TestConfirmKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	txID := test.IdentifierGenerator().New()
+	address := flow.HexToAddress("01")
+
+	keys := test.AccountKeyGenerator()
+	oldKey := keys.New()
+	newKey := keys.New()
+
+	newClient := func(oldKeyRevoked bool) *rotationClient {
+		old := *oldKey
+		old.Index = 0
+		old.Revoked = oldKeyRevoked
+		added := *newKey
+		added.Index = 1
+
+		return &rotationClient{
+			waitClient: waitClient{statuses: []flow.TransactionStatus{flow.TransactionStatusSealed}},
+			account:    &flow.Account{Address: address, Keys: []*flow.AccountKey{&old, &added}},
+		}
+	}
+
+	t.Run("Rotated", func(t *testing.T) {
+		key, err := ConfirmKeyRotation(ctx, newClient(true), txID, address, newKey, 0, WithPollInterval(time.Millisecond))
+		require.NoError(t, err)
+		assert.Equal(t, 1, key.Index)
+	})
+
+	t.Run("Old key not revoked", func(t *testing.T) {
+		_, err := ConfirmKeyRotation(ctx, newClient(false), txID, address, newKey, 0, WithPollInterval(time.Millisecond))
+		assert.Error(t, err)
+	})
+}
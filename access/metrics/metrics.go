@@ -0,0 +1,233 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics provides instrumentation of the access API clients for metrics collection.
+//
+// The metrics client wraps any access.Client, HTTP or gRPC, and reports the outcome and latency of
+// every call to a Recorder, which can be backed by any metrics system. For example, a Prometheus
+// recorder can be implemented as:
+//
+//	type prometheusRecorder struct {
+//		requests *prometheus.CounterVec
+//		latency  *prometheus.HistogramVec
+//	}
+//
+//	func (r *prometheusRecorder) ObserveCall(method string, endpoint string, duration time.Duration, err error) {
+//		r.requests.WithLabelValues(method, endpoint, strconv.FormatBool(err == nil)).Inc()
+//		r.latency.WithLabelValues(method, endpoint).Observe(duration.Seconds())
+//	}
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// Recorder records the outcome of calls made to an access node.
+//
+// Recorders are called concurrently and must be safe for concurrent use.
+type Recorder interface {
+	// ObserveCall is called after every client call with the client method name, the access node
+	// endpoint the client is connected to, the call duration and the returned error (nil on success).
+	ObserveCall(method string, endpoint string, duration time.Duration, err error)
+}
+
+// Client is an access.Client reporting every call of the wrapped client to a recorder.
+type Client struct {
+	client   access.Client
+	endpoint string
+	recorder Recorder
+}
+
+var _ access.Client = (*Client)(nil)
+var _ access.AccountAtBlockIDClient = (*Client)(nil)
+
+// NewClient creates a metrics client wrapping the provided client.
+//
+// The endpoint is the access node host the wrapped client is connected to, and is passed to the
+// recorder so that calls can be aggregated per access node.
+func NewClient(client access.Client, endpoint string, recorder Recorder) *Client {
+	return &Client{
+		client:   client,
+		endpoint: endpoint,
+		recorder: recorder,
+	}
+}
+
+func (c *Client) observe(method string, start time.Time, err error) {
+	c.recorder.ObserveCall(method, c.endpoint, time.Since(start), err)
+}
+
+func (c *Client) Ping(ctx context.Context) (err error) {
+	defer func(start time.Time) { c.observe("Ping", start, err) }(time.Now())
+	return c.client.Ping(ctx)
+}
+
+func (c *Client) GetLatestBlockHeader(ctx context.Context, isSealed bool) (result *flow.BlockHeader, err error) {
+	defer func(start time.Time) { c.observe("GetLatestBlockHeader", start, err) }(time.Now())
+	return c.client.GetLatestBlockHeader(ctx, isSealed)
+}
+
+func (c *Client) GetBlockHeaderByID(
+	ctx context.Context,
+	blockID flow.Identifier,
+) (result *flow.BlockHeader, err error) {
+	defer func(start time.Time) { c.observe("GetBlockHeaderByID", start, err) }(time.Now())
+	return c.client.GetBlockHeaderByID(ctx, blockID)
+}
+
+func (c *Client) GetBlockHeaderByHeight(ctx context.Context, height uint64) (result *flow.BlockHeader, err error) {
+	defer func(start time.Time) { c.observe("GetBlockHeaderByHeight", start, err) }(time.Now())
+	return c.client.GetBlockHeaderByHeight(ctx, height)
+}
+
+func (c *Client) GetLatestBlock(ctx context.Context, isSealed bool) (result *flow.Block, err error) {
+	defer func(start time.Time) { c.observe("GetLatestBlock", start, err) }(time.Now())
+	return c.client.GetLatestBlock(ctx, isSealed)
+}
+
+func (c *Client) GetBlockByID(ctx context.Context, blockID flow.Identifier) (result *flow.Block, err error) {
+	defer func(start time.Time) { c.observe("GetBlockByID", start, err) }(time.Now())
+	return c.client.GetBlockByID(ctx, blockID)
+}
+
+func (c *Client) GetBlockByHeight(ctx context.Context, height uint64) (result *flow.Block, err error) {
+	defer func(start time.Time) { c.observe("GetBlockByHeight", start, err) }(time.Now())
+	return c.client.GetBlockByHeight(ctx, height)
+}
+
+func (c *Client) GetCollection(ctx context.Context, colID flow.Identifier) (result *flow.Collection, err error) {
+	defer func(start time.Time) { c.observe("GetCollection", start, err) }(time.Now())
+	return c.client.GetCollection(ctx, colID)
+}
+
+func (c *Client) SendTransaction(ctx context.Context, tx flow.Transaction) (err error) {
+	defer func(start time.Time) { c.observe("SendTransaction", start, err) }(time.Now())
+	return c.client.SendTransaction(ctx, tx)
+}
+
+func (c *Client) GetTransaction(ctx context.Context, txID flow.Identifier) (result *flow.Transaction, err error) {
+	defer func(start time.Time) { c.observe("GetTransaction", start, err) }(time.Now())
+	return c.client.GetTransaction(ctx, txID)
+}
+
+func (c *Client) GetTransactionResult(
+	ctx context.Context,
+	txID flow.Identifier,
+) (result *flow.TransactionResult, err error) {
+	defer func(start time.Time) { c.observe("GetTransactionResult", start, err) }(time.Now())
+	return c.client.GetTransactionResult(ctx, txID)
+}
+
+func (c *Client) GetAccount(ctx context.Context, address flow.Address) (result *flow.Account, err error) {
+	defer func(start time.Time) { c.observe("GetAccount", start, err) }(time.Now())
+	return c.client.GetAccount(ctx, address)
+}
+
+func (c *Client) GetAccountAtLatestBlock(ctx context.Context, address flow.Address) (result *flow.Account, err error) {
+	defer func(start time.Time) { c.observe("GetAccountAtLatestBlock", start, err) }(time.Now())
+	return c.client.GetAccountAtLatestBlock(ctx, address)
+}
+
+func (c *Client) GetAccountAtBlockHeight(
+	ctx context.Context,
+	address flow.Address,
+	blockHeight uint64,
+) (result *flow.Account, err error) {
+	defer func(start time.Time) { c.observe("GetAccountAtBlockHeight", start, err) }(time.Now())
+	return c.client.GetAccountAtBlockHeight(ctx, address, blockHeight)
+}
+
+func (c *Client) GetAccountAtBlockID(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+) (result *flow.Account, err error) {
+	defer func(start time.Time) { c.observe("GetAccountAtBlockID", start, err) }(time.Now())
+	return access.GetAccountAtBlockID(ctx, c.client, address, blockID)
+}
+
+func (c *Client) ExecuteScriptAtLatestBlock(
+	ctx context.Context,
+	script []byte,
+	arguments []cadence.Value,
+) (result cadence.Value, err error) {
+	defer func(start time.Time) { c.observe("ExecuteScriptAtLatestBlock", start, err) }(time.Now())
+	return c.client.ExecuteScriptAtLatestBlock(ctx, script, arguments)
+}
+
+func (c *Client) ExecuteScriptAtBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+	script []byte,
+	arguments []cadence.Value,
+) (result cadence.Value, err error) {
+	defer func(start time.Time) { c.observe("ExecuteScriptAtBlockID", start, err) }(time.Now())
+	return c.client.ExecuteScriptAtBlockID(ctx, blockID, script, arguments)
+}
+
+func (c *Client) ExecuteScriptAtBlockHeight(
+	ctx context.Context,
+	height uint64,
+	script []byte,
+	arguments []cadence.Value,
+) (result cadence.Value, err error) {
+	defer func(start time.Time) { c.observe("ExecuteScriptAtBlockHeight", start, err) }(time.Now())
+	return c.client.ExecuteScriptAtBlockHeight(ctx, height, script, arguments)
+}
+
+func (c *Client) GetEventsForHeightRange(
+	ctx context.Context,
+	eventType string,
+	startHeight uint64,
+	endHeight uint64,
+) (result []flow.BlockEvents, err error) {
+	defer func(start time.Time) { c.observe("GetEventsForHeightRange", start, err) }(time.Now())
+	return c.client.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+}
+
+func (c *Client) GetEventsForBlockIDs(
+	ctx context.Context,
+	eventType string,
+	blockIDs []flow.Identifier,
+) (result []flow.BlockEvents, err error) {
+	defer func(start time.Time) { c.observe("GetEventsForBlockIDs", start, err) }(time.Now())
+	return c.client.GetEventsForBlockIDs(ctx, eventType, blockIDs)
+}
+
+func (c *Client) GetLatestProtocolStateSnapshot(ctx context.Context) (result []byte, err error) {
+	defer func(start time.Time) { c.observe("GetLatestProtocolStateSnapshot", start, err) }(time.Now())
+	return c.client.GetLatestProtocolStateSnapshot(ctx)
+}
+
+func (c *Client) GetExecutionResultForBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+) (result *flow.ExecutionResult, err error) {
+	defer func(start time.Time) { c.observe("GetExecutionResultForBlockID", start, err) }(time.Now())
+	return c.client.GetExecutionResultForBlockID(ctx, blockID)
+}
+
+func (c *Client) Close() error {
+	return c.client.Close()
+}
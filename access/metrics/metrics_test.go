@@ -0,0 +1,80 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+type observation struct {
+	method   string
+	endpoint string
+	err      error
+}
+
+type recorder struct {
+	observations []observation
+}
+
+func (r *recorder) ObserveCall(method string, endpoint string, _ time.Duration, err error) {
+	r.observations = append(r.observations, observation{method, endpoint, err})
+}
+
+type stubClient struct {
+	access.Client
+	err error
+}
+
+func (c *stubClient) Ping(context.Context) error {
+	return c.err
+}
+
+func (c *stubClient) GetBlockByHeight(_ context.Context, height uint64) (*flow.Block, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &flow.Block{BlockHeader: flow.BlockHeader{Height: height}}, nil
+}
+
+func TestClient_ObserveCall(t *testing.T) {
+	rec := &recorder{}
+	stub := &stubClient{}
+	client := NewClient(stub, "access.mainnet.nodes.onflow.org:9000", rec)
+
+	block, err := client.GetBlockByHeight(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), block.Height)
+
+	stub.err = fmt.Errorf("unavailable")
+	err = client.Ping(context.Background())
+	assert.EqualError(t, err, "unavailable")
+
+	assert.Equal(t, []observation{
+		{"GetBlockByHeight", "access.mainnet.nodes.onflow.org:9000", nil},
+		{"Ping", "access.mainnet.nodes.onflow.org:9000", stub.err},
+	}, rec.observations)
+}
@@ -0,0 +1,44 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mocks contains mocks of the access API clients, to unit test code using the SDK
+// without running an emulator or an access node.
+//
+// Client mocks the transport independent access.Client interface, and RPCClient mocks the
+// gRPC access API client, which can be wrapped with grpc.NewFromRPCClient to test code
+// depending on the gRPC client types.
+//
+// The mocks are generated with mockery and use testify mock expectations:
+//
+//	client := &mocks.Client{}
+//	client.On("GetLatestBlock", mock.Anything, true).Return(block, nil)
+package mocks
+
+//go:generate go run github.com/vektra/mockery/cmd/mockery --name Client --dir .. --output . --outpkg mocks --case underscore
+//go:generate go run github.com/vektra/mockery/cmd/mockery --name RPCClient --dir ../grpc --output . --outpkg mocks --case underscore
+
+import (
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+)
+
+var (
+	_ access.Client                 = (*Client)(nil)
+	_ access.AccountAtBlockIDClient = (*Client)(nil)
+	_ grpc.RPCClient                = (*RPCClient)(nil)
+)
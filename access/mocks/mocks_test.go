@@ -0,0 +1,86 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow/protobuf/go/flow/access"
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sdkaccess "github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/access/mocks"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	block := test.BlockGenerator().New()
+
+	client := &mocks.Client{}
+	client.On("GetLatestBlock", mock.Anything, true).Return(block, nil)
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, []byte("script"), []cadence.Value(nil)).
+		Return(cadence.NewInt(42), nil)
+	client.On("SendTransaction", mock.Anything, mock.Anything).Return(errors.New("rejected"))
+
+	latest, err := client.GetLatestBlock(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, block, latest)
+
+	var result int
+	err = sdkaccess.ExecuteScriptAtLatestBlockInto(ctx, client, []byte("script"), nil, &result)
+	require.NoError(t, err)
+	assert.Equal(t, 42, result)
+
+	err = client.SendTransaction(ctx, *test.TransactionGenerator().New())
+	assert.EqualError(t, err, "rejected")
+
+	client.AssertExpectations(t)
+}
+
+func TestRPCClient(t *testing.T) {
+	ctx := context.Background()
+	header := test.BlockHeaderGenerator().New()
+
+	rpc := &mocks.RPCClient{}
+	rpc.On("GetLatestBlockHeader", mock.Anything, &access.GetLatestBlockHeaderRequest{IsSealed: true}).
+		Return(&access.BlockHeaderResponse{
+			Block: &entities.BlockHeader{
+				Id:       header.ID.Bytes(),
+				ParentId: header.ParentID.Bytes(),
+				Height:   header.Height,
+			},
+		}, nil)
+
+	client := grpc.NewFromRPCClient(rpc)
+
+	latest, err := client.GetLatestBlockHeader(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, header.ID, latest.ID)
+	assert.Equal(t, header.Height, latest.Height)
+
+	rpc.AssertExpectations(t)
+}
@@ -0,0 +1,56 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// ErrChainIDMismatch is returned when the access node serves a different network than the client
+// expects, for example a client expecting testnet connected to a mainnet access node.
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ChainIDMismatchError reports the chain ID expected by the client and the chain ID of the access node.
+//
+// The error matches ErrChainIDMismatch with errors.Is.
+type ChainIDMismatchError struct {
+	Expected flow.ChainID
+	Actual   flow.ChainID
+}
+
+func (e *ChainIDMismatchError) Error() string {
+	return fmt.Sprintf("%s: access node serves chain %s, expected chain %s", ErrChainIDMismatch, e.Actual, e.Expected)
+}
+
+func (e *ChainIDMismatchError) Is(target error) bool {
+	return target == ErrChainIDMismatch
+}
+
+// CheckChainID returns a ChainIDMismatchError if the chain ID of the access node is not the expected
+// chain ID. No check is done if the expected chain ID is empty.
+func CheckChainID(expected flow.ChainID, actual flow.ChainID) error {
+	if expected == "" || expected == actual {
+		return nil
+	}
+
+	return &ChainIDMismatchError{Expected: expected, Actual: actual}
+}
@@ -0,0 +1,44 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func TestCheckChainID(t *testing.T) {
+	assert.NoError(t, CheckChainID(flow.Testnet, flow.Testnet))
+	assert.NoError(t, CheckChainID("", flow.Mainnet))
+
+	err := CheckChainID(flow.Testnet, flow.Mainnet)
+	assert.ErrorIs(t, fmt.Errorf("wrapped: %w", err), ErrChainIDMismatch)
+
+	var mismatchErr *ChainIDMismatchError
+	require.True(t, errors.As(err, &mismatchErr))
+	assert.Equal(t, flow.Testnet, mismatchErr.Expected)
+	assert.Equal(t, flow.Mainnet, mismatchErr.Actual)
+	assert.EqualError(t, err, "chain ID mismatch: access node serves chain flow-mainnet, expected chain flow-testnet")
+}
@@ -0,0 +1,129 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+// ExecuteScriptAtLatestBlockInto executes a script against the latest sealed execution state and
+// decodes its result into the value pointed to by dest, as cadence.Unmarshal.
+//
+//	var balance string
+//	err := access.ExecuteScriptAtLatestBlockInto(ctx, client, script, args, &balance)
+//
+// Errors returned by the client are returned unchanged, so they can be inspected as the errors
+// returned by ExecuteScriptAtLatestBlock.
+func ExecuteScriptAtLatestBlockInto(
+	ctx context.Context,
+	client Client,
+	script []byte,
+	arguments []cadence.Value,
+	dest interface{},
+) error {
+	value, err := client.ExecuteScriptAtLatestBlock(ctx, script, arguments)
+	if err != nil {
+		return err
+	}
+	return decodeScriptResult(value, dest)
+}
+
+// FinalizedScriptClient is implemented by the clients able to execute scripts against the latest
+// finalized block, such as the HTTP and gRPC clients.
+type FinalizedScriptClient interface {
+	ExecuteScriptAtLatestFinalizedBlock(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error)
+}
+
+// ExecuteScriptAtLatestBlockWithStatus executes a script against the execution state at the latest
+// block with the status, sealed or finalized.
+//
+// Finalized blocks are seconds ahead of sealed blocks, so latency-sensitive reads can use the finalized
+// state, which may not be verified yet. Scripts are executed with ExecuteScriptAtLatestFinalizedBlock if
+// the client implements FinalizedScriptClient, and at the latest finalized block ID otherwise.
+func ExecuteScriptAtLatestBlockWithStatus(
+	ctx context.Context,
+	client Client,
+	status flow.BlockStatus,
+	script []byte,
+	arguments []cadence.Value,
+) (cadence.Value, error) {
+	switch status {
+	case flow.BlockStatusSealed:
+		return client.ExecuteScriptAtLatestBlock(ctx, script, arguments)
+	case flow.BlockStatusFinalized:
+		if finalizedClient, ok := client.(FinalizedScriptClient); ok {
+			return finalizedClient.ExecuteScriptAtLatestFinalizedBlock(ctx, script, arguments)
+		}
+
+		header, err := client.GetLatestBlockHeader(ctx, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest finalized block header: %w", err)
+		}
+		return client.ExecuteScriptAtBlockID(ctx, header.ID, script, arguments)
+	default:
+		return nil, fmt.Errorf("can't execute scripts at the latest block with status %s", status)
+	}
+}
+
+// ExecuteScriptAtBlockIDInto executes a script against the execution state at the block with the
+// given ID and decodes its result into the value pointed to by dest, as cadence.Unmarshal.
+func ExecuteScriptAtBlockIDInto(
+	ctx context.Context,
+	client Client,
+	blockID flow.Identifier,
+	script []byte,
+	arguments []cadence.Value,
+	dest interface{},
+) error {
+	value, err := client.ExecuteScriptAtBlockID(ctx, blockID, script, arguments)
+	if err != nil {
+		return err
+	}
+	return decodeScriptResult(value, dest)
+}
+
+// ExecuteScriptAtBlockHeightInto executes a script against the execution state at the given block
+// height and decodes its result into the value pointed to by dest, as cadence.Unmarshal.
+func ExecuteScriptAtBlockHeightInto(
+	ctx context.Context,
+	client Client,
+	height uint64,
+	script []byte,
+	arguments []cadence.Value,
+	dest interface{},
+) error {
+	value, err := client.ExecuteScriptAtBlockHeight(ctx, height, script, arguments)
+	if err != nil {
+		return err
+	}
+	return decodeScriptResult(value, dest)
+}
+
+func decodeScriptResult(value cadence.Value, dest interface{}) error {
+	if err := sdkcadence.Unmarshal(value, dest); err != nil {
+		return fmt.Errorf("failed to decode script result into %T: %w", dest, err)
+	}
+	return nil
+}
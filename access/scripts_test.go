@@ -0,0 +1,159 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// scriptClient is a client stub returning the same result for all scripts.
+type scriptClient struct {
+	Client
+	value  cadence.Value
+	err    error
+	height uint64
+}
+
+func (c *scriptClient) ExecuteScriptAtLatestBlock(context.Context, []byte, []cadence.Value) (cadence.Value, error) {
+	return c.value, c.err
+}
+
+func (c *scriptClient) ExecuteScriptAtBlockHeight(_ context.Context, height uint64, _ []byte, _ []cadence.Value) (cadence.Value, error) {
+	c.height = height
+	return c.value, c.err
+}
+
+func (c *scriptClient) ExecuteScriptAtBlockID(context.Context, flow.Identifier, []byte, []cadence.Value) (cadence.Value, error) {
+	return c.value, c.err
+}
+
+func TestExecuteScriptInto(t *testing.T) {
+	ctx := context.Background()
+
+	type result struct {
+		Owner   flow.Address `cadence:"owner"`
+		Balance string       `cadence:"balance"`
+	}
+
+	balance, err := cadence.NewUFix64("10.5")
+	require.NoError(t, err)
+
+	value := cadence.NewStruct([]cadence.Value{
+		cadence.NewAddress(flow.HexToAddress("01")),
+		balance,
+	}).WithType(&cadence.StructType{
+		QualifiedIdentifier: "Result",
+		Fields: []cadence.Field{
+			{Identifier: "owner", Type: cadence.AddressType{}},
+			{Identifier: "balance", Type: cadence.UFix64Type{}},
+		},
+	})
+	expected := result{Owner: flow.HexToAddress("01"), Balance: "10.50000000"}
+
+	t.Run("Latest block", func(t *testing.T) {
+		var r result
+		require.NoError(t, ExecuteScriptAtLatestBlockInto(ctx, &scriptClient{value: value}, nil, nil, &r))
+		assert.Equal(t, expected, r)
+	})
+
+	t.Run("Block height", func(t *testing.T) {
+		client := &scriptClient{value: value}
+
+		var r result
+		require.NoError(t, ExecuteScriptAtBlockHeightInto(ctx, client, 42, nil, nil, &r))
+		assert.Equal(t, expected, r)
+		assert.Equal(t, uint64(42), client.height)
+	})
+
+	t.Run("Block ID", func(t *testing.T) {
+		var r result
+		require.NoError(t, ExecuteScriptAtBlockIDInto(ctx, &scriptClient{value: value}, flow.EmptyID, nil, nil, &r))
+		assert.Equal(t, expected, r)
+	})
+
+	t.Run("Type mismatch", func(t *testing.T) {
+		var r int
+		err := ExecuteScriptAtLatestBlockInto(ctx, &scriptClient{value: value}, nil, nil, &r)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to decode script result into *int")
+	})
+
+	t.Run("Execution error", func(t *testing.T) {
+		executionErr := errors.New("execution failed")
+
+		var r result
+		err := ExecuteScriptAtLatestBlockInto(ctx, &scriptClient{err: executionErr}, nil, nil, &r)
+		assert.Equal(t, executionErr, err)
+	})
+}
+
+// finalizedClient is a client stub executing scripts against the latest finalized block by ID.
+type finalizedClient struct {
+	scriptClient
+	header  flow.BlockHeader
+	blockID flow.Identifier
+}
+
+func (c *finalizedClient) GetLatestBlockHeader(_ context.Context, isSealed bool) (*flow.BlockHeader, error) {
+	if isSealed {
+		return nil, errors.New("sealed header requested")
+	}
+	return &c.header, nil
+}
+
+func (c *finalizedClient) ExecuteScriptAtBlockID(_ context.Context, blockID flow.Identifier, _ []byte, _ []cadence.Value) (cadence.Value, error) {
+	c.blockID = blockID
+	return c.value, c.err
+}
+
+func TestExecuteScriptAtLatestBlockWithStatus(t *testing.T) {
+	ctx := context.Background()
+	value := cadence.NewInt(42)
+
+	t.Run("Sealed", func(t *testing.T) {
+		result, err := ExecuteScriptAtLatestBlockWithStatus(ctx, &scriptClient{value: value}, flow.BlockStatusSealed, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, value, result)
+	})
+
+	t.Run("Finalized", func(t *testing.T) {
+		client := &finalizedClient{
+			scriptClient: scriptClient{value: value},
+			header:       flow.BlockHeader{ID: flow.HexToID("01")},
+		}
+
+		result, err := ExecuteScriptAtLatestBlockWithStatus(ctx, client, flow.BlockStatusFinalized, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, value, result)
+		assert.Equal(t, flow.HexToID("01"), client.blockID)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := ExecuteScriptAtLatestBlockWithStatus(ctx, &scriptClient{value: value}, flow.BlockStatusUnknown, nil, nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,220 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// invalidSequenceNumberErrorCode is the code of the execution error of transactions proposed
+// with a sequence number which doesn't match the proposal key sequence number.
+const invalidSequenceNumberErrorCode = "[Error Code: 1007]"
+
+// IsInvalidSequenceNumberError returns true if the error is caused by a transaction proposed with
+// an outdated or future proposal key sequence number.
+func IsInvalidSequenceNumberError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	return strings.Contains(msg, invalidSequenceNumberErrorCode) ||
+		strings.Contains(msg, "invalid proposal key") && strings.Contains(msg, "sequence number")
+}
+
+type proposalKeyID struct {
+	address  flow.Address
+	keyIndex int
+}
+
+// SequenceNumberTracker tracks the sequence numbers of proposal keys across transactions.
+//
+// The sequence number of a key is fetched from its account the first time it is used and then
+// incremented locally for every transaction, so transactions can be proposed in quick succession
+// without waiting for the previous ones to be sealed. The sequence number is fetched again after
+// a transaction fails with an invalid sequence number error. Sequence numbers reserved by transactions
+// that could not be sent are released, and reserved again by the next transactions.
+type SequenceNumberTracker struct {
+	client Client
+	mu     sync.Mutex
+	keys   map[proposalKeyID]*proposalKeyState
+}
+
+// proposalKeyState is the tracked sequence number of a proposal key. Its lock is held while the
+// sequence number is fetched, so fetching a key doesn't block the other keys.
+type proposalKeyState struct {
+	mu     sync.Mutex
+	synced bool
+	next   uint64
+	// released are the sequence numbers below next which were released, in increasing order
+	released []uint64
+}
+
+// NewSequenceNumberTracker creates a sequence number tracker fetching the accounts with the client.
+func NewSequenceNumberTracker(client Client) *SequenceNumberTracker {
+	return &SequenceNumberTracker{
+		client: client,
+		keys:   make(map[proposalKeyID]*proposalKeyState),
+	}
+}
+
+// keyState returns the state of the proposal key, creating it if the key is not tracked yet.
+func (t *SequenceNumberTracker) keyState(address flow.Address, keyIndex int) *proposalKeyState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := proposalKeyID{address: address, keyIndex: keyIndex}
+
+	state, ok := t.keys[id]
+	if !ok {
+		state = &proposalKeyState{}
+		t.keys[id] = state
+	}
+	return state
+}
+
+// Next returns the sequence number of the next transaction proposed with the key, reserving it.
+func (t *SequenceNumberTracker) Next(ctx context.Context, address flow.Address, keyIndex int) (uint64, error) {
+	state := t.keyState(address, keyIndex)
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if !state.synced {
+		account, err := t.client.GetAccount(ctx, address)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get proposer account %s: %w", address, err)
+		}
+
+		key, err := findAccountKey(account, keyIndex)
+		if err != nil {
+			return 0, err
+		}
+		state.next = key.SequenceNumber
+		state.released = nil
+		state.synced = true
+	}
+
+	if len(state.released) > 0 {
+		next := state.released[0]
+		state.released = state.released[1:]
+		return next, nil
+	}
+
+	next := state.next
+	state.next++
+	return next, nil
+}
+
+// Release gives back a sequence number reserved with Next which was not used by a sent transaction.
+//
+// If no later sequence number was reserved the key sequence number is rolled back, otherwise the
+// released sequence number is reserved by the next transaction proposed with the key, so the
+// transactions sent with the later sequence numbers can still be executed.
+func (t *SequenceNumberTracker) Release(address flow.Address, keyIndex int, sequenceNumber uint64) {
+	state := t.keyState(address, keyIndex)
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if !state.synced || sequenceNumber >= state.next {
+		return
+	}
+
+	i := sort.Search(len(state.released), func(i int) bool { return state.released[i] >= sequenceNumber })
+	if i < len(state.released) && state.released[i] == sequenceNumber {
+		return
+	}
+	state.released = append(state.released, 0)
+	copy(state.released[i+1:], state.released[i:])
+	state.released[i] = sequenceNumber
+
+	// roll back the sequence number over the released sequence numbers reserved last
+	for len(state.released) > 0 && state.released[len(state.released)-1] == state.next-1 {
+		state.released = state.released[:len(state.released)-1]
+		state.next--
+	}
+}
+
+// Resync makes the next transaction proposed with the key fetch the key sequence number again.
+func (t *SequenceNumberTracker) Resync(address flow.Address, keyIndex int) {
+	state := t.keyState(address, keyIndex)
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.synced = false
+}
+
+// CheckResult resyncs the proposal key of the transaction if it failed with an invalid sequence number error.
+func (t *SequenceNumberTracker) CheckResult(tx *flow.Transaction, result *flow.TransactionResult) {
+	if IsInvalidSequenceNumberError(result.Error) {
+		t.Resync(tx.ProposalKey.Address, tx.ProposalKey.KeyIndex)
+	}
+}
+
+// SendTransaction sets the next sequence number of the transaction proposal key, signs the transaction
+// with the sign function and sends it.
+//
+// If the transaction can't be signed or sent its sequence number is released, since it was not used.
+func (t *SequenceNumberTracker) SendTransaction(
+	ctx context.Context,
+	tx *flow.Transaction,
+	sign func(tx *flow.Transaction) error,
+) error {
+	key := tx.ProposalKey
+	if key.Address == flow.EmptyAddress {
+		return errors.New("transaction proposal key is not set")
+	}
+
+	sequenceNumber, err := t.Next(ctx, key.Address, key.KeyIndex)
+	if err != nil {
+		return err
+	}
+
+	tx.SetProposalKey(key.Address, key.KeyIndex, sequenceNumber)
+
+	if err := sign(tx); err != nil {
+		t.Release(key.Address, key.KeyIndex, sequenceNumber)
+		return err
+	}
+
+	if err := t.client.SendTransaction(ctx, *tx); err != nil {
+		t.Release(key.Address, key.KeyIndex, sequenceNumber)
+		return err
+	}
+
+	return nil
+}
+
+func findAccountKey(account *flow.Account, keyIndex int) (*flow.AccountKey, error) {
+	for _, key := range account.Keys {
+		if key.Index == keyIndex {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("account %s has no key with index %d", account.Address, keyIndex)
+}
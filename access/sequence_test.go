@@ -0,0 +1,214 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// sequenceClient is a client stub serving an account with a single key and recording the sent transactions.
+type sequenceClient struct {
+	Client
+	account      *flow.Account
+	accountCalls int
+	sent         []flow.Transaction
+	sendErr      error
+}
+
+func (c *sequenceClient) GetAccount(context.Context, flow.Address) (*flow.Account, error) {
+	c.accountCalls++
+	return c.account, nil
+}
+
+func (c *sequenceClient) SendTransaction(_ context.Context, tx flow.Transaction) error {
+	if c.sendErr != nil {
+		return c.sendErr
+	}
+	c.sent = append(c.sent, tx)
+	return nil
+}
+
+// blockingAccountClient is a client stub serving accounts with a single key, blocking the account
+// fetches of the blocked address until released.
+type blockingAccountClient struct {
+	Client
+	blocked flow.Address
+	started chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingAccountClient) GetAccount(_ context.Context, address flow.Address) (*flow.Account, error) {
+	if address == c.blocked {
+		close(c.started)
+		<-c.release
+	}
+	return &flow.Account{
+		Address: address,
+		Keys:    []*flow.AccountKey{{Index: 0, Weight: 1000, SequenceNumber: 10}},
+	}, nil
+}
+
+func TestSequenceNumberTracker(t *testing.T) {
+	ctx := context.Background()
+	address := test.AddressGenerator().New()
+
+	newClient := func() *sequenceClient {
+		return &sequenceClient{
+			account: &flow.Account{
+				Address: address,
+				Keys:    []*flow.AccountKey{{Index: 0, Weight: 1000, SequenceNumber: 10}},
+			},
+		}
+	}
+
+	noSign := func(*flow.Transaction) error { return nil }
+
+	t.Run("Increments locally", func(t *testing.T) {
+		client := newClient()
+		tracker := NewSequenceNumberTracker(client)
+
+		for i := uint64(0); i < 3; i++ {
+			tx := flow.NewTransaction().SetProposalKey(address, 0, 0)
+			require.NoError(t, tracker.SendTransaction(ctx, tx, noSign))
+			assert.Equal(t, 10+i, tx.ProposalKey.SequenceNumber)
+		}
+
+		assert.Equal(t, 1, client.accountCalls)
+		assert.Len(t, client.sent, 3)
+	})
+
+	t.Run("Resync on invalid sequence number", func(t *testing.T) {
+		client := newClient()
+		tracker := NewSequenceNumberTracker(client)
+
+		tx := flow.NewTransaction().SetProposalKey(address, 0, 0)
+		require.NoError(t, tracker.SendTransaction(ctx, tx, noSign))
+
+		tracker.CheckResult(tx, &flow.TransactionResult{Error: errors.New("execution failed")})
+		next, err := tracker.Next(ctx, address, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(11), next)
+
+		client.account.Keys[0].SequenceNumber = 20
+		tracker.CheckResult(tx, &flow.TransactionResult{
+			Error: errors.New("[Error Code: 1007] invalid proposal key: public key 0 on account has sequence number 20, but given 12"),
+		})
+
+		next, err = tracker.Next(ctx, address, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(20), next)
+		assert.Equal(t, 2, client.accountCalls)
+	})
+
+	t.Run("Rolls back on send error", func(t *testing.T) {
+		client := newClient()
+		client.sendErr = errors.New("unavailable")
+		tracker := NewSequenceNumberTracker(client)
+
+		tx := flow.NewTransaction().SetProposalKey(address, 0, 0)
+		assert.Error(t, tracker.SendTransaction(ctx, tx, noSign))
+
+		client.sendErr = nil
+		require.NoError(t, tracker.SendTransaction(ctx, tx, noSign))
+		assert.Equal(t, uint64(10), tx.ProposalKey.SequenceNumber)
+		assert.Equal(t, 1, client.accountCalls)
+	})
+
+	t.Run("Releases on concurrent failure", func(t *testing.T) {
+		client := newClient()
+		tracker := NewSequenceNumberTracker(client)
+
+		reserved := make(chan struct{})
+		fail := make(chan struct{})
+		failed := make(chan error)
+		go func() {
+			tx := flow.NewTransaction().SetProposalKey(address, 0, 0)
+			failed <- tracker.SendTransaction(ctx, tx, func(*flow.Transaction) error {
+				close(reserved)
+				<-fail
+				return errors.New("signing failed")
+			})
+		}()
+		<-reserved
+
+		// a transaction is sent while the first one is in flight
+		tx := flow.NewTransaction().SetProposalKey(address, 0, 0)
+		require.NoError(t, tracker.SendTransaction(ctx, tx, noSign))
+		assert.Equal(t, uint64(11), tx.ProposalKey.SequenceNumber)
+
+		close(fail)
+		assert.Error(t, <-failed)
+
+		// the released sequence number is reused without resyncing, then the tracked one
+		for _, expected := range []uint64{10, 12} {
+			tx := flow.NewTransaction().SetProposalKey(address, 0, 0)
+			require.NoError(t, tracker.SendTransaction(ctx, tx, noSign))
+			assert.Equal(t, expected, tx.ProposalKey.SequenceNumber)
+		}
+		assert.Equal(t, 1, client.accountCalls)
+	})
+
+	t.Run("Fetches keys independently", func(t *testing.T) {
+		addresses := test.AddressGenerator()
+		blocked, other := addresses.New(), addresses.New()
+
+		client := &blockingAccountClient{blocked: blocked, started: make(chan struct{}), release: make(chan struct{})}
+		tracker := NewSequenceNumberTracker(client)
+
+		done := make(chan uint64)
+		go func() {
+			next, _ := tracker.Next(ctx, blocked, 0)
+			done <- next
+		}()
+		<-client.started
+
+		// the other key is not blocked by the pending fetch
+		next, err := tracker.Next(ctx, other, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), next)
+
+		close(client.release)
+		assert.Equal(t, uint64(10), <-done)
+
+		next, err = tracker.Next(ctx, blocked, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(11), next)
+	})
+
+	t.Run("Missing key", func(t *testing.T) {
+		tracker := NewSequenceNumberTracker(newClient())
+
+		_, err := tracker.Next(ctx, address, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing proposal key", func(t *testing.T) {
+		tracker := NewSequenceNumberTracker(newClient())
+
+		assert.Error(t, tracker.SendTransaction(ctx, flow.NewTransaction(), noSign))
+	})
+}
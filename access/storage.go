@@ -0,0 +1,142 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// getAccountStorageScript lists the values stored by the account and the capabilities it publishes.
+const getAccountStorageScript = `
+access(all) struct Item {
+	access(all) let path: String
+	access(all) let type: String
+	access(all) let isResource: Bool
+
+	init(path: Path, type: Type) {
+		self.path = path.toString()
+		self.type = type.identifier
+		self.isResource = type.isSubtype(of: Type<@AnyResource>())
+	}
+}
+
+access(all) struct Storage {
+	access(all) let used: UInt64
+	access(all) let capacity: UInt64
+	access(all) let stored: [Item]
+	access(all) let public: [Item]
+
+	init(used: UInt64, capacity: UInt64, stored: [Item], public: [Item]) {
+		self.used = used
+		self.capacity = capacity
+		self.stored = stored
+		self.public = public
+	}
+}
+
+access(all) fun main(address: Address): Storage {
+	let account = getAuthAccount<auth(Storage) &Account>(address)
+
+	var stored: [Item] = []
+	account.storage.forEachStored(fun (path: StoragePath, type: Type): Bool {
+		stored.append(Item(path: path, type: type))
+		return true
+	})
+
+	var public: [Item] = []
+	account.storage.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+		public.append(Item(path: path, type: type))
+		return true
+	})
+
+	return Storage(used: account.storage.used, capacity: account.storage.capacity, stored: stored, public: public)
+}
+`
+
+// AccountStorage describes the storage of an account: how much of it is used and what is stored in it.
+type AccountStorage struct {
+	Address flow.Address `cadence:"-"`
+	// Used and Capacity are the storage used by the account and the storage it can use, in bytes.
+	Used     uint64 `cadence:"used"`
+	Capacity uint64 `cadence:"capacity"`
+	// Stored are the values stored by the account, sorted by path.
+	Stored []StorageItem `cadence:"stored"`
+	// Public are the capabilities published by the account, sorted by path.
+	Public []StorageItem `cadence:"public"`
+}
+
+// A StorageItem is a value stored at a storage path, or a capability published at a public path.
+type StorageItem struct {
+	// Path is the path of the item, such as "/storage/flowTokenVault".
+	Path string `cadence:"path"`
+	// Type is the type identifier of the stored value, such as "A.1654653399040a61.FlowToken.Vault",
+	// or of the published capability.
+	Type       string `cadence:"type"`
+	IsResource bool   `cadence:"isResource"`
+}
+
+// Available returns the storage still available to the account in bytes.
+func (s *AccountStorage) Available() uint64 {
+	if s.Used >= s.Capacity {
+		return 0
+	}
+	return s.Capacity - s.Used
+}
+
+// ResourceTypes returns the number of resources stored by the account for each resource type.
+func (s *AccountStorage) ResourceTypes() map[string]int {
+	types := make(map[string]int)
+	for _, item := range s.Stored {
+		if item.IsResource {
+			types[item.Type]++
+		}
+	}
+	return types
+}
+
+// GetAccountStorage reports the storage used by the account at the latest sealed block, the values it
+// stores and the capabilities it publishes, using a Cadence 1.0 script.
+//
+// The script iterates the whole storage of the account, so it may exceed the computation limit of
+// scripts for accounts storing a large number of values.
+func GetAccountStorage(ctx context.Context, client Client, address flow.Address) (*AccountStorage, error) {
+	var storage AccountStorage
+	err := ExecuteScriptAtLatestBlockInto(
+		ctx,
+		client,
+		[]byte(getAccountStorageScript),
+		[]cadence.Value{cadence.Address(address)},
+		&storage,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage of account %s: %w", address, err)
+	}
+
+	storage.Address = address
+	sort.Slice(storage.Stored, func(i, j int) bool { return storage.Stored[i].Path < storage.Stored[j].Path })
+	sort.Slice(storage.Public, func(i, j int) bool { return storage.Public[i].Path < storage.Public[j].Path })
+
+	return &storage, nil
+}
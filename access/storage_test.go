@@ -0,0 +1,104 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func TestGetAccountStorage(t *testing.T) {
+	ctx := context.Background()
+	address := flow.HexToAddress("01")
+
+	itemType := &cadence.StructType{
+		QualifiedIdentifier: "Item",
+		Fields: []cadence.Field{
+			{Identifier: "path", Type: cadence.StringType{}},
+			{Identifier: "type", Type: cadence.StringType{}},
+			{Identifier: "isResource", Type: cadence.BoolType{}},
+		},
+	}
+	item := func(path string, typeID string, isResource bool) cadence.Value {
+		return cadence.NewStruct([]cadence.Value{
+			cadence.String(path),
+			cadence.String(typeID),
+			cadence.NewBool(isResource),
+		}).WithType(itemType)
+	}
+
+	value := cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt64(1000),
+		cadence.NewUInt64(100000),
+		cadence.NewArray([]cadence.Value{
+			item("/storage/topShotCollection", "A.0b2a3299cc857e29.TopShot.Collection", true),
+			item("/storage/flowTokenVault", "A.1654653399040a61.FlowToken.Vault", true),
+			item("/storage/usdcFlowVault", "A.f1ab99c82dee3526.USDCFlow.Vault", true),
+			item("/storage/settings", "{String: String}", false),
+		}),
+		cadence.NewArray([]cadence.Value{
+			item("/public/flowTokenReceiver", "Capability<&A.1654653399040a61.FlowToken.Vault>", false),
+		}),
+	}).WithType(&cadence.StructType{
+		QualifiedIdentifier: "Storage",
+		Fields: []cadence.Field{
+			{Identifier: "used", Type: cadence.UInt64Type{}},
+			{Identifier: "capacity", Type: cadence.UInt64Type{}},
+			{Identifier: "stored", Type: &cadence.VariableSizedArrayType{ElementType: itemType}},
+			{Identifier: "public", Type: &cadence.VariableSizedArrayType{ElementType: itemType}},
+		},
+	})
+
+	storage, err := GetAccountStorage(ctx, &scriptClient{value: value}, address)
+	require.NoError(t, err)
+
+	assert.Equal(t, address, storage.Address)
+	assert.Equal(t, uint64(1000), storage.Used)
+	assert.Equal(t, uint64(99000), storage.Available())
+	require.Len(t, storage.Stored, 4)
+	assert.Equal(t, "/storage/flowTokenVault", storage.Stored[0].Path)
+	assert.Equal(t, "/storage/usdcFlowVault", storage.Stored[3].Path)
+	assert.Equal(t, []StorageItem{{
+		Path: "/public/flowTokenReceiver",
+		Type: "Capability<&A.1654653399040a61.FlowToken.Vault>",
+	}}, storage.Public)
+	assert.Equal(t, map[string]int{
+		"A.0b2a3299cc857e29.TopShot.Collection": 1,
+		"A.1654653399040a61.FlowToken.Vault":    1,
+		"A.f1ab99c82dee3526.USDCFlow.Vault":     1,
+	}, storage.ResourceTypes())
+
+	t.Run("Over capacity", func(t *testing.T) {
+		storage := &AccountStorage{Used: 200, Capacity: 100}
+		assert.Equal(t, uint64(0), storage.Available())
+	})
+
+	t.Run("Script error", func(t *testing.T) {
+		scriptErr := errors.New("computation limit exceeded")
+		_, err := GetAccountStorage(ctx, &scriptClient{err: scriptErr}, address)
+		assert.ErrorIs(t, err, scriptErr)
+	})
+}
@@ -0,0 +1,134 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// BlockTicker delivers the headers of the newly sealed blocks, see NewBlockTicker.
+type BlockTicker struct {
+	// C is the channel the headers are delivered on, in order of height. It is closed when the
+	// ticker stops.
+	C <-chan *flow.BlockHeader
+
+	cancel context.CancelFunc
+
+	mu  sync.Mutex
+	err error
+}
+
+// NewBlockTicker returns a ticker delivering the header of each block sealed after its creation
+// exactly once, in order of height.
+//
+// The ticker polls the latest sealed block header at the interval, which works with all the clients,
+// and fetches the headers of the blocks sealed between two polls so no block is skipped. Requests failing
+// with retryable errors are retried at the next tick, other errors stop the ticker and are returned by Err.
+// The ticker stops when the context is done or Stop is called.
+func NewBlockTicker(ctx context.Context, client Client, interval time.Duration) *BlockTicker {
+	ctx, cancel := context.WithCancel(ctx)
+	headers := make(chan *flow.BlockHeader)
+
+	t := &BlockTicker{
+		C:      headers,
+		cancel: cancel,
+	}
+
+	go t.run(ctx, client, interval, headers)
+
+	return t
+}
+
+// Stop stops the ticker, which closes the channel.
+func (t *BlockTicker) Stop() {
+	t.cancel()
+}
+
+// Err returns the error which stopped the ticker, or nil if the ticker is running or was stopped.
+func (t *BlockTicker) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+func (t *BlockTicker) run(ctx context.Context, client Client, interval time.Duration, headers chan<- *flow.BlockHeader) {
+	defer close(headers)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *flow.BlockHeader
+	for {
+		var err error
+		last, err = t.poll(ctx, client, last, headers)
+		if err != nil && ctx.Err() == nil && !IsRetryable(err) {
+			t.mu.Lock()
+			t.err = err
+			t.mu.Unlock()
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll delivers the headers of the blocks sealed after the last delivered header and returns the new
+// last delivered header. The first poll only records the latest sealed block.
+func (t *BlockTicker) poll(
+	ctx context.Context,
+	client Client,
+	last *flow.BlockHeader,
+	headers chan<- *flow.BlockHeader,
+) (*flow.BlockHeader, error) {
+	latest, err := client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return last, err
+	}
+
+	if last == nil {
+		return latest, nil
+	}
+
+	for height := last.Height + 1; height <= latest.Height; height++ {
+		header := latest
+		if height < latest.Height {
+			header, err = client.GetBlockHeaderByHeight(ctx, height)
+			if err != nil {
+				return last, err
+			}
+		}
+
+		select {
+		case headers <- header:
+			last = header
+		case <-ctx.Done():
+			return last, ctx.Err()
+		}
+	}
+
+	return last, nil
+}
@@ -0,0 +1,139 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// tickerClient is a client stub serving the headers up to the sealed height.
+type tickerClient struct {
+	Client
+
+	mu     sync.Mutex
+	sealed uint64
+	err    error
+}
+
+func (c *tickerClient) GetLatestBlockHeader(context.Context, bool) (*flow.BlockHeader, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &flow.BlockHeader{Height: c.sealed}, nil
+}
+
+func (c *tickerClient) GetBlockHeaderByHeight(_ context.Context, height uint64) (*flow.BlockHeader, error) {
+	return &flow.BlockHeader{Height: height}, nil
+}
+
+func (c *tickerClient) seal(height uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sealed = height
+}
+
+func (c *tickerClient) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func nextHeader(t *testing.T, ticker *BlockTicker) *flow.BlockHeader {
+	select {
+	case header := <-ticker.C:
+		return header
+	case <-time.After(time.Second):
+		require.FailNow(t, "no header delivered")
+		return nil
+	}
+}
+
+func TestBlockTicker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("New blocks", func(t *testing.T) {
+		client := &tickerClient{sealed: 10}
+		ticker := NewBlockTicker(ctx, client, time.Millisecond)
+		defer ticker.Stop()
+
+		// wait for the first poll before sealing new blocks
+		time.Sleep(10 * time.Millisecond)
+		client.seal(11)
+		assert.Equal(t, uint64(11), nextHeader(t, ticker).Height)
+
+		// the blocks sealed between two polls are all delivered
+		client.seal(14)
+		for height := uint64(12); height <= 14; height++ {
+			assert.Equal(t, height, nextHeader(t, ticker).Height)
+		}
+
+		select {
+		case header := <-ticker.C:
+			assert.Failf(t, "unexpected header", "height %d", header.Height)
+		case <-time.After(10 * time.Millisecond):
+		}
+	})
+
+	t.Run("Retryable error", func(t *testing.T) {
+		client := &tickerClient{sealed: 10}
+		ticker := NewBlockTicker(ctx, client, time.Millisecond)
+		defer ticker.Stop()
+
+		time.Sleep(10 * time.Millisecond)
+		client.fail(fmt.Errorf("poll: %w", ErrUnavailable))
+		time.Sleep(10 * time.Millisecond)
+		client.fail(nil)
+		client.seal(11)
+
+		assert.Equal(t, uint64(11), nextHeader(t, ticker).Height)
+		assert.NoError(t, ticker.Err())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		client := &tickerClient{sealed: 10}
+		client.fail(errors.New("failed"))
+		ticker := NewBlockTicker(ctx, client, time.Millisecond)
+
+		_, ok := <-ticker.C
+		assert.False(t, ok)
+		assert.EqualError(t, ticker.Err(), "failed")
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		ticker := NewBlockTicker(ctx, &tickerClient{sealed: 10}, time.Millisecond)
+		ticker.Stop()
+
+		_, ok := <-ticker.C
+		assert.False(t, ok)
+		assert.NoError(t, ticker.Err())
+	})
+}
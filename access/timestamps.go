@@ -0,0 +1,282 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// BlockTimestampIndex finds sealed blocks by timestamp, with a binary search over the block heights.
+//
+// The timestamps of the block headers fetched by the searches are cached and narrow the following
+// searches, so translating many close timestamps, such as the bounds of time ranges, needs few requests.
+//
+// The search starts at the first block the access node has data for if the client implements
+// NodeVersionInfoClient, and at height 0 otherwise. The heights the access node reports as not found
+// or out of range are considered older than all the available blocks.
+type BlockTimestampIndex struct {
+	client Client
+
+	mu         sync.Mutex
+	rootHeight *uint64
+	// timestamps are the cached block timestamps sorted by height, the least recently used
+	// timestamps are evicted once there are more than maxCachedTimestamps.
+	timestamps []cachedTimestamp
+	uses       uint64
+}
+
+// maxCachedTimestamps is the maximum number of block timestamps cached by a BlockTimestampIndex.
+const maxCachedTimestamps = 1024
+
+type cachedTimestamp struct {
+	height    uint64
+	timestamp time.Time
+	lastUse   uint64
+}
+
+// NewBlockTimestampIndex creates an index finding blocks by timestamp with the client.
+func NewBlockTimestampIndex(client Client) *BlockTimestampIndex {
+	return &BlockTimestampIndex{
+		client: client,
+	}
+}
+
+// GetBlockByTimestamp returns the last sealed block with a timestamp before or at the time, which is
+// the latest block of the chain at that time.
+//
+// An error matching ErrNotFound is returned if the time is before the first block available.
+func (x *BlockTimestampIndex) GetBlockByTimestamp(ctx context.Context, t time.Time) (*flow.Block, error) {
+	height, err := x.GetHeightByTimestamp(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return x.client.GetBlockByHeight(ctx, height)
+}
+
+// GetHeightByTimestamp returns the height of the last sealed block with a timestamp before or at the time.
+//
+// An error matching ErrNotFound is returned if the time is before the first block available.
+func (x *BlockTimestampIndex) GetHeightByTimestamp(ctx context.Context, t time.Time) (uint64, error) {
+	root, sealed, err := x.bounds(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := x.firstHeightAfter(ctx, t, root, sealed)
+	if err != nil {
+		return 0, err
+	}
+	notFoundErr := fmt.Errorf("no block at or before %s: %w", t.Format(time.RFC3339Nano), ErrNotFound)
+	if height == root {
+		return 0, notFoundErr
+	}
+
+	timestamp, err := x.timestamp(ctx, height-1)
+	if err != nil {
+		return 0, err
+	}
+	if timestamp.IsZero() {
+		return 0, notFoundErr
+	}
+
+	return height - 1, nil
+}
+
+// GetHeightRange returns the heights of the first and the last sealed blocks with a timestamp in the
+// time range, including the start and excluding the end, to query the blocks of a wall-clock range.
+//
+// An error matching ErrNotFound is returned if no sealed block is in the time range.
+func (x *BlockTimestampIndex) GetHeightRange(ctx context.Context, start time.Time, end time.Time) (uint64, uint64, error) {
+	root, sealed, err := x.bounds(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// block timestamps have a nanosecond resolution, so the first block after the previous
+	// nanosecond is the first block at or after the time
+	first, err := x.firstHeightAfter(ctx, start.Add(-time.Nanosecond), root, sealed)
+	if err != nil {
+		return 0, 0, err
+	}
+	next, err := x.firstHeightAfter(ctx, end.Add(-time.Nanosecond), first, sealed)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if next == first {
+		return 0, 0, fmt.Errorf(
+			"no block between %s and %s: %w",
+			start.Format(time.RFC3339Nano),
+			end.Format(time.RFC3339Nano),
+			ErrNotFound,
+		)
+	}
+
+	return first, next - 1, nil
+}
+
+// bounds returns the height of the first block available and the height of the latest sealed block.
+func (x *BlockTimestampIndex) bounds(ctx context.Context) (uint64, uint64, error) {
+	x.mu.Lock()
+	rootHeight := x.rootHeight
+	x.mu.Unlock()
+
+	if rootHeight == nil {
+		var root uint64
+		if versionClient, ok := x.client.(NodeVersionInfoClient); ok {
+			info, err := versionClient.GetNodeVersionInfo(ctx)
+			if err != nil {
+				return 0, 0, fmt.Errorf("failed to get node version info: %w", err)
+			}
+
+			root = info.SporkRootBlockHeight
+			if info.NodeRootBlockHeight > root {
+				root = info.NodeRootBlockHeight
+			}
+		}
+
+		x.mu.Lock()
+		x.rootHeight = &root
+		x.mu.Unlock()
+		rootHeight = &root
+	}
+
+	header, err := x.client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get latest sealed block header: %w", err)
+	}
+	x.cache(header)
+
+	if header.Height < *rootHeight {
+		return header.Height, header.Height, nil
+	}
+
+	return *rootHeight, header.Height, nil
+}
+
+// firstHeightAfter returns the height of the first block between the low and high heights with a
+// timestamp after the time, or the high height plus one if there is no such block.
+func (x *BlockTimestampIndex) firstHeightAfter(ctx context.Context, t time.Time, low uint64, high uint64) (uint64, error) {
+	// the searched height is in [low, high], narrowed with the cached timestamps
+	high++
+
+	x.mu.Lock()
+	cached := x.timestamps[x.search(low):x.search(high)]
+	i := sort.Search(len(cached), func(i int) bool {
+		return cached[i].timestamp.After(t)
+	})
+	if i < len(cached) {
+		high = cached[i].height
+		x.use(&cached[i])
+	}
+	if i > 0 {
+		low = cached[i-1].height + 1
+		x.use(&cached[i-1])
+	}
+	x.mu.Unlock()
+
+	for low < high {
+		mid := low + (high-low)/2
+
+		timestamp, err := x.timestamp(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+
+		if timestamp.After(t) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low, nil
+}
+
+// timestamp returns the timestamp of the block at the height, fetching its header if not cached.
+//
+// The zero time is returned if the block is not available on the access node.
+func (x *BlockTimestampIndex) timestamp(ctx context.Context, height uint64) (time.Time, error) {
+	x.mu.Lock()
+	if i := x.search(height); i < len(x.timestamps) && x.timestamps[i].height == height {
+		x.use(&x.timestamps[i])
+		timestamp := x.timestamps[i].timestamp
+		x.mu.Unlock()
+		return timestamp, nil
+	}
+	x.mu.Unlock()
+
+	header, err := x.client.GetBlockHeaderByHeight(ctx, height)
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrOutOfRange) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get block header at height %d: %w", height, err)
+	}
+	x.cache(header)
+
+	return header.Timestamp, nil
+}
+
+// search returns the index of the first cached timestamp at or above the height.
+func (x *BlockTimestampIndex) search(height uint64) int {
+	return sort.Search(len(x.timestamps), func(i int) bool {
+		return x.timestamps[i].height >= height
+	})
+}
+
+func (x *BlockTimestampIndex) use(cached *cachedTimestamp) {
+	x.uses++
+	cached.lastUse = x.uses
+}
+
+func (x *BlockTimestampIndex) cache(header *flow.BlockHeader) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	i := x.search(header.Height)
+	if i < len(x.timestamps) && x.timestamps[i].height == header.Height {
+		x.timestamps[i].timestamp = header.Timestamp
+		x.use(&x.timestamps[i])
+		return
+	}
+
+	x.timestamps = append(x.timestamps, cachedTimestamp{})
+	copy(x.timestamps[i+1:], x.timestamps[i:])
+	x.timestamps[i] = cachedTimestamp{height: header.Height, timestamp: header.Timestamp}
+	x.use(&x.timestamps[i])
+
+	if len(x.timestamps) > maxCachedTimestamps {
+		lru := 0
+		for j := range x.timestamps {
+			if x.timestamps[j].lastUse < x.timestamps[lru].lastUse {
+				lru = j
+			}
+		}
+		x.timestamps = append(x.timestamps[:lru], x.timestamps[lru+1:]...)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+var genesisTime = time.Date(2024, 9, 4, 0, 0, 0, 0, time.UTC)
+
+// timestampClient is a client stub serving a block per second from the genesis time, up to the
+// sealed height, and failing for the blocks before the first available height.
+type timestampClient struct {
+	Client
+
+	sealed    uint64
+	available uint64
+	requests  int
+}
+
+func (c *timestampClient) header(height uint64) *flow.BlockHeader {
+	return &flow.BlockHeader{
+		Height:    height,
+		Timestamp: genesisTime.Add(time.Duration(height) * time.Second),
+	}
+}
+
+func (c *timestampClient) GetLatestBlockHeader(context.Context, bool) (*flow.BlockHeader, error) {
+	return c.header(c.sealed), nil
+}
+
+func (c *timestampClient) GetBlockHeaderByHeight(_ context.Context, height uint64) (*flow.BlockHeader, error) {
+	c.requests++
+	if height < c.available || height > c.sealed {
+		return nil, fmt.Errorf("block %d: %w", height, ErrNotFound)
+	}
+	return c.header(height), nil
+}
+
+func (c *timestampClient) GetBlockByHeight(_ context.Context, height uint64) (*flow.Block, error) {
+	return &flow.Block{BlockHeader: *c.header(height)}, nil
+}
+
+func TestBlockTimestampIndex_GetBlockByTimestamp(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Found", func(t *testing.T) {
+		index := NewBlockTimestampIndex(&timestampClient{sealed: 1000})
+
+		block, err := index.GetBlockByTimestamp(ctx, genesisTime.Add(500*time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(500), block.Height)
+
+		// the latest block at the time is the last block before it
+		block, err = index.GetBlockByTimestamp(ctx, genesisTime.Add(500*time.Second+500*time.Millisecond))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(500), block.Height)
+	})
+
+	t.Run("After latest sealed block", func(t *testing.T) {
+		index := NewBlockTimestampIndex(&timestampClient{sealed: 1000})
+
+		height, err := index.GetHeightByTimestamp(ctx, genesisTime.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1000), height)
+	})
+
+	t.Run("Before first available block", func(t *testing.T) {
+		index := NewBlockTimestampIndex(&timestampClient{sealed: 1000, available: 100})
+
+		height, err := index.GetHeightByTimestamp(ctx, genesisTime.Add(100*time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), height)
+
+		_, err = index.GetHeightByTimestamp(ctx, genesisTime.Add(99*time.Second))
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		client := &timestampClient{sealed: 1 << 20}
+		index := NewBlockTimestampIndex(client)
+
+		_, err := index.GetHeightByTimestamp(ctx, genesisTime.Add(1000*time.Second))
+		require.NoError(t, err)
+		first := client.requests
+
+		_, err = index.GetHeightByTimestamp(ctx, genesisTime.Add(1000*time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, first, client.requests)
+
+		_, err = index.GetHeightByTimestamp(ctx, genesisTime.Add(1010*time.Second))
+		require.NoError(t, err)
+		assert.Less(t, client.requests-first, first)
+	})
+}
+
+func TestBlockTimestampIndex_CacheBounded(t *testing.T) {
+	ctx := context.Background()
+	client := &timestampClient{sealed: 1 << 20}
+	index := NewBlockTimestampIndex(client)
+
+	for i := 0; i < 200; i++ {
+		_, err := index.GetHeightByTimestamp(ctx, genesisTime.Add(time.Duration(i*5000+1)*time.Second))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, index.timestamps, maxCachedTimestamps)
+	for i := 1; i < len(index.timestamps); i++ {
+		assert.Less(t, index.timestamps[i-1].height, index.timestamps[i].height)
+	}
+
+	// the recently searched timestamps are still cached
+	requests := client.requests
+	height, err := index.GetHeightByTimestamp(ctx, genesisTime.Add(199*5000*time.Second+time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(199*5000+1), height)
+	assert.Equal(t, requests, client.requests)
+}
+
+func TestBlockTimestampIndex_GetHeightRange(t *testing.T) {
+	ctx := context.Background()
+	index := NewBlockTimestampIndex(&timestampClient{sealed: 1000})
+
+	start, end, err := index.GetHeightRange(ctx, genesisTime.Add(100*time.Second), genesisTime.Add(200*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), start)
+	assert.Equal(t, uint64(199), end)
+
+	start, end, err = index.GetHeightRange(ctx, genesisTime.Add(99500*time.Millisecond), genesisTime.Add(100500*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), start)
+	assert.Equal(t, uint64(100), end)
+
+	_, _, err = index.GetHeightRange(ctx, genesisTime.Add(100100*time.Millisecond), genesisTime.Add(100900*time.Millisecond))
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, _, err = index.GetHeightRange(ctx, genesisTime.Add(time.Hour), genesisTime.Add(2*time.Hour))
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,329 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tracing provides OpenTelemetry instrumentation for the access API clients.
+//
+// The tracing client wraps any access.Client, HTTP or gRPC, and creates a span for
+// every call made to the access node:
+//
+//	flowClient, err := http.NewClient(http.MainnetHost)
+//	tracedClient := tracing.NewClient(flowClient, otel.Tracer("flow"))
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/onflow/cadence"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http"
+)
+
+const spanPrefix = "flow.access."
+
+// Span attribute keys set by the tracing client.
+const (
+	MethodKey        = attribute.Key("flow.method")
+	BlockIDKey       = attribute.Key("flow.block_id")
+	BlockHeightKey   = attribute.Key("flow.block_height")
+	StartHeightKey   = attribute.Key("flow.start_height")
+	EndHeightKey     = attribute.Key("flow.end_height")
+	IsSealedKey      = attribute.Key("flow.is_sealed")
+	AddressKey       = attribute.Key("flow.address")
+	TransactionIDKey = attribute.Key("flow.transaction_id")
+	CollectionIDKey  = attribute.Key("flow.collection_id")
+	EventTypeKey     = attribute.Key("flow.event_type")
+	StatusKey        = attribute.Key("flow.status")
+)
+
+// Client is an access.Client creating a span around every call of the wrapped client.
+type Client struct {
+	client access.Client
+	tracer trace.Tracer
+}
+
+var _ access.Client = (*Client)(nil)
+var _ access.AccountAtBlockIDClient = (*Client)(nil)
+
+// NewClient creates a tracing client wrapping the provided client, spans are created with the provided tracer.
+func NewClient(client access.Client, tracer trace.Tracer) *Client {
+	return &Client{
+		client: client,
+		tracer: tracer,
+	}
+}
+
+func (c *Client) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, MethodKey.String(method))
+	return c.tracer.Start(
+		ctx,
+		spanPrefix+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// end records the outcome of the call on the span and ends it.
+func end(span trace.Span, err error) {
+	defer span.End()
+
+	if err == nil {
+		span.SetAttributes(StatusKey.String("ok"))
+		span.SetStatus(codes.Ok, "")
+		return
+	}
+
+	var httpErr http.HTTPError
+	if errors.As(err, &httpErr) {
+		span.SetAttributes(StatusKey.String(strconv.Itoa(httpErr.Code)))
+	} else if s, ok := status.FromError(err); ok {
+		span.SetAttributes(StatusKey.String(s.Code().String()))
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+func blockID(id flow.Identifier) attribute.KeyValue {
+	return BlockIDKey.String(id.String())
+}
+
+func blockHeight(height uint64) attribute.KeyValue {
+	return BlockHeightKey.Int64(int64(height))
+}
+
+func address(address flow.Address) attribute.KeyValue {
+	return AddressKey.String(address.String())
+}
+
+func transactionID(id flow.Identifier) attribute.KeyValue {
+	return TransactionIDKey.String(id.String())
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	ctx, span := c.start(ctx, "Ping")
+	err := c.client.Ping(ctx)
+	end(span, err)
+	return err
+}
+
+func (c *Client) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
+	ctx, span := c.start(ctx, "GetLatestBlockHeader", IsSealedKey.Bool(isSealed))
+	header, err := c.client.GetLatestBlockHeader(ctx, isSealed)
+	if err == nil {
+		span.SetAttributes(blockHeight(header.Height), blockID(header.ID))
+	}
+	end(span, err)
+	return header, err
+}
+
+func (c *Client) GetBlockHeaderByID(ctx context.Context, id flow.Identifier) (*flow.BlockHeader, error) {
+	ctx, span := c.start(ctx, "GetBlockHeaderByID", blockID(id))
+	header, err := c.client.GetBlockHeaderByID(ctx, id)
+	end(span, err)
+	return header, err
+}
+
+func (c *Client) GetBlockHeaderByHeight(ctx context.Context, height uint64) (*flow.BlockHeader, error) {
+	ctx, span := c.start(ctx, "GetBlockHeaderByHeight", blockHeight(height))
+	header, err := c.client.GetBlockHeaderByHeight(ctx, height)
+	end(span, err)
+	return header, err
+}
+
+func (c *Client) GetLatestBlock(ctx context.Context, isSealed bool) (*flow.Block, error) {
+	ctx, span := c.start(ctx, "GetLatestBlock", IsSealedKey.Bool(isSealed))
+	block, err := c.client.GetLatestBlock(ctx, isSealed)
+	if err == nil {
+		span.SetAttributes(blockHeight(block.Height), blockID(block.ID))
+	}
+	end(span, err)
+	return block, err
+}
+
+func (c *Client) GetBlockByID(ctx context.Context, id flow.Identifier) (*flow.Block, error) {
+	ctx, span := c.start(ctx, "GetBlockByID", blockID(id))
+	block, err := c.client.GetBlockByID(ctx, id)
+	end(span, err)
+	return block, err
+}
+
+func (c *Client) GetBlockByHeight(ctx context.Context, height uint64) (*flow.Block, error) {
+	ctx, span := c.start(ctx, "GetBlockByHeight", blockHeight(height))
+	block, err := c.client.GetBlockByHeight(ctx, height)
+	end(span, err)
+	return block, err
+}
+
+func (c *Client) GetCollection(ctx context.Context, colID flow.Identifier) (*flow.Collection, error) {
+	ctx, span := c.start(ctx, "GetCollection", CollectionIDKey.String(colID.String()))
+	collection, err := c.client.GetCollection(ctx, colID)
+	end(span, err)
+	return collection, err
+}
+
+func (c *Client) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	ctx, span := c.start(ctx, "SendTransaction", transactionID(tx.ID()))
+	err := c.client.SendTransaction(ctx, tx)
+	end(span, err)
+	return err
+}
+
+func (c *Client) GetTransaction(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+	ctx, span := c.start(ctx, "GetTransaction", transactionID(txID))
+	tx, err := c.client.GetTransaction(ctx, txID)
+	end(span, err)
+	return tx, err
+}
+
+func (c *Client) GetTransactionResult(ctx context.Context, txID flow.Identifier) (*flow.TransactionResult, error) {
+	ctx, span := c.start(ctx, "GetTransactionResult", transactionID(txID))
+	result, err := c.client.GetTransactionResult(ctx, txID)
+	if err == nil {
+		span.SetAttributes(attribute.String("flow.transaction_status", result.Status.String()))
+	}
+	end(span, err)
+	return result, err
+}
+
+func (c *Client) GetAccount(ctx context.Context, addr flow.Address) (*flow.Account, error) {
+	ctx, span := c.start(ctx, "GetAccount", address(addr))
+	account, err := c.client.GetAccount(ctx, addr)
+	end(span, err)
+	return account, err
+}
+
+func (c *Client) GetAccountAtLatestBlock(ctx context.Context, addr flow.Address) (*flow.Account, error) {
+	ctx, span := c.start(ctx, "GetAccountAtLatestBlock", address(addr))
+	account, err := c.client.GetAccountAtLatestBlock(ctx, addr)
+	end(span, err)
+	return account, err
+}
+
+func (c *Client) GetAccountAtBlockHeight(ctx context.Context, addr flow.Address, height uint64) (*flow.Account, error) {
+	ctx, span := c.start(ctx, "GetAccountAtBlockHeight", address(addr), blockHeight(height))
+	account, err := c.client.GetAccountAtBlockHeight(ctx, addr, height)
+	end(span, err)
+	return account, err
+}
+
+func (c *Client) GetAccountAtBlockID(ctx context.Context, addr flow.Address, id flow.Identifier) (*flow.Account, error) {
+	ctx, span := c.start(ctx, "GetAccountAtBlockID", address(addr), blockID(id))
+	account, err := access.GetAccountAtBlockID(ctx, c.client, addr, id)
+	end(span, err)
+	return account, err
+}
+
+func (c *Client) ExecuteScriptAtLatestBlock(
+	ctx context.Context,
+	script []byte,
+	arguments []cadence.Value,
+) (cadence.Value, error) {
+	ctx, span := c.start(ctx, "ExecuteScriptAtLatestBlock")
+	value, err := c.client.ExecuteScriptAtLatestBlock(ctx, script, arguments)
+	end(span, err)
+	return value, err
+}
+
+func (c *Client) ExecuteScriptAtBlockID(
+	ctx context.Context,
+	id flow.Identifier,
+	script []byte,
+	arguments []cadence.Value,
+) (cadence.Value, error) {
+	ctx, span := c.start(ctx, "ExecuteScriptAtBlockID", blockID(id))
+	value, err := c.client.ExecuteScriptAtBlockID(ctx, id, script, arguments)
+	end(span, err)
+	return value, err
+}
+
+func (c *Client) ExecuteScriptAtBlockHeight(
+	ctx context.Context,
+	height uint64,
+	script []byte,
+	arguments []cadence.Value,
+) (cadence.Value, error) {
+	ctx, span := c.start(ctx, "ExecuteScriptAtBlockHeight", blockHeight(height))
+	value, err := c.client.ExecuteScriptAtBlockHeight(ctx, height, script, arguments)
+	end(span, err)
+	return value, err
+}
+
+func (c *Client) GetEventsForHeightRange(
+	ctx context.Context,
+	eventType string,
+	startHeight uint64,
+	endHeight uint64,
+) ([]flow.BlockEvents, error) {
+	ctx, span := c.start(
+		ctx,
+		"GetEventsForHeightRange",
+		EventTypeKey.String(eventType),
+		StartHeightKey.Int64(int64(startHeight)),
+		EndHeightKey.Int64(int64(endHeight)),
+	)
+	events, err := c.client.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+	end(span, err)
+	return events, err
+}
+
+func (c *Client) GetEventsForBlockIDs(
+	ctx context.Context,
+	eventType string,
+	blockIDs []flow.Identifier,
+) ([]flow.BlockEvents, error) {
+	ids := make([]string, len(blockIDs))
+	for i, id := range blockIDs {
+		ids[i] = id.String()
+	}
+
+	ctx, span := c.start(
+		ctx,
+		"GetEventsForBlockIDs",
+		EventTypeKey.String(eventType),
+		BlockIDKey.StringSlice(ids),
+	)
+	events, err := c.client.GetEventsForBlockIDs(ctx, eventType, blockIDs)
+	end(span, err)
+	return events, err
+}
+
+func (c *Client) GetLatestProtocolStateSnapshot(ctx context.Context) ([]byte, error) {
+	ctx, span := c.start(ctx, "GetLatestProtocolStateSnapshot")
+	snapshot, err := c.client.GetLatestProtocolStateSnapshot(ctx)
+	end(span, err)
+	return snapshot, err
+}
+
+func (c *Client) GetExecutionResultForBlockID(ctx context.Context, id flow.Identifier) (*flow.ExecutionResult, error) {
+	ctx, span := c.start(ctx, "GetExecutionResultForBlockID", blockID(id))
+	result, err := c.client.GetExecutionResultForBlockID(ctx, id)
+	end(span, err)
+	return result, err
+}
+
+func (c *Client) Close() error {
+	return c.client.Close()
+}
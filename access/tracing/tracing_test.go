@@ -0,0 +1,133 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/http"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+type recordedSpan struct {
+	trace.Span
+	name       string
+	attributes []attribute.KeyValue
+	status     codes.Code
+	ended      bool
+}
+
+func (s *recordedSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.attributes = append(s.attributes, kv...)
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, _ string) {
+	s.status = code
+}
+
+func (s *recordedSpan) RecordError(error, ...trace.EventOption) {}
+
+func (s *recordedSpan) End(...trace.SpanEndOption) {
+	s.ended = true
+}
+
+func (s *recordedSpan) attribute(key attribute.Key) attribute.Value {
+	for _, kv := range s.attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(
+	ctx context.Context,
+	name string,
+	opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	_, noop := trace.NewNoopTracerProvider().Tracer("").Start(ctx, name)
+	config := trace.NewSpanStartConfig(opts...)
+
+	span := &recordedSpan{
+		Span:       noop,
+		name:       name,
+		attributes: config.Attributes(),
+	}
+	t.spans = append(t.spans, span)
+
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type stubClient struct {
+	access.Client
+	account *flow.Account
+	err     error
+}
+
+func (c *stubClient) GetAccountAtBlockHeight(context.Context, flow.Address, uint64) (*flow.Account, error) {
+	return c.account, c.err
+}
+
+func TestClient_Spans(t *testing.T) {
+	account := test.AccountGenerator().New()
+
+	t.Run("Success", func(t *testing.T) {
+		tracer := &recordingTracer{}
+		client := NewClient(&stubClient{account: account}, tracer)
+
+		result, err := client.GetAccountAtBlockHeight(context.Background(), account.Address, 42)
+		assert.NoError(t, err)
+		assert.Equal(t, account, result)
+
+		assert.Len(t, tracer.spans, 1)
+		span := tracer.spans[0]
+		assert.Equal(t, "flow.access.GetAccountAtBlockHeight", span.name)
+		assert.Equal(t, "GetAccountAtBlockHeight", span.attribute(MethodKey).AsString())
+		assert.Equal(t, account.Address.String(), span.attribute(AddressKey).AsString())
+		assert.Equal(t, int64(42), span.attribute(BlockHeightKey).AsInt64())
+		assert.Equal(t, "ok", span.attribute(StatusKey).AsString())
+		assert.Equal(t, codes.Ok, span.status)
+		assert.True(t, span.ended)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		tracer := &recordingTracer{}
+		client := NewClient(&stubClient{err: http.HTTPError{Code: 404, Message: "not found"}}, tracer)
+
+		_, err := client.GetAccountAtBlockHeight(context.Background(), account.Address, 42)
+		assert.EqualError(t, err, "not found")
+
+		span := tracer.spans[0]
+		assert.Equal(t, "404", span.attribute(StatusKey).AsString())
+		assert.Equal(t, codes.Error, span.status)
+		assert.True(t, span.ended)
+	})
+}
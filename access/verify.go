@@ -0,0 +1,78 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// VerifyAccountProof fetches the account and checks that the signatures prove its ownership for
+// the app and the nonce, following the FCL account proof scheme.
+//
+// The signatures must be produced by keys of the account reaching the account key weight threshold.
+func VerifyAccountProof(
+	ctx context.Context,
+	client Client,
+	address flow.Address,
+	appID string,
+	nonceHex string,
+	signatures []flow.CompositeSignature,
+) (bool, error) {
+	account, err := client.GetAccount(ctx, address)
+	if err != nil {
+		return false, fmt.Errorf("failed to get account %s: %w", address, err)
+	}
+
+	return flow.VerifyAccountProof(account, appID, nonceHex, signatures)
+}
+
+// VerifyUserSignatures fetches the account and checks that the signatures are valid signatures of
+// the user message produced with keys of the account reaching the account key weight threshold.
+//
+// The message must not include the user domain tag, which is added before verifying the signatures
+// as done by crypto.SignUserMessage.
+func VerifyUserSignatures(
+	ctx context.Context,
+	client Client,
+	address flow.Address,
+	message []byte,
+	signatures []flow.CompositeSignature,
+) (bool, error) {
+	account, err := client.GetAccount(ctx, address)
+	if err != nil {
+		return false, fmt.Errorf("failed to get account %s: %w", address, err)
+	}
+
+	return flow.VerifyUserSignatures(account, message, signatures)
+}
+
+// VerifyTransactionSignatures fetches the signer accounts of the transaction and checks its payload
+// and envelope signatures against their current keys, as done by flow.VerifyTransactionSignatures.
+func VerifyTransactionSignatures(
+	ctx context.Context,
+	client Client,
+	tx *flow.Transaction,
+) (*flow.TransactionSignaturesVerification, error) {
+	return flow.VerifyTransactionSignatures(tx, func(address flow.Address) (*flow.Account, error) {
+		return client.GetAccount(ctx, address)
+	})
+}
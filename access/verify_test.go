@@ -0,0 +1,109 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// accountClient is a client stub serving a single account.
+type accountClient struct {
+	Client
+	account *flow.Account
+}
+
+func (c *accountClient) GetAccount(context.Context, flow.Address) (*flow.Account, error) {
+	return c.account, nil
+}
+
+func TestVerifyAccountProof(t *testing.T) {
+	ctx := context.Background()
+	address := test.AddressGenerator().New()
+	appID := "AWESOME-APP-ID"
+	nonce := "3037366134636339643564623330316636626239323161663465346131393662"
+
+	key, signer := test.AccountKeyGenerator().NewWithSigner()
+	client := &accountClient{account: &flow.Account{Address: address, Keys: []*flow.AccountKey{key}}}
+
+	message, err := flow.AccountProofSignableMessage(address, appID, nonce)
+	require.NoError(t, err)
+
+	sig, err := signer.Sign(message)
+	require.NoError(t, err)
+
+	signatures := []flow.CompositeSignature{{Address: address, KeyIndex: key.Index, Signature: sig}}
+
+	valid, err := VerifyAccountProof(ctx, client, address, appID, nonce, signatures)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = VerifyAccountProof(ctx, client, address, "OTHER-APP-ID", nonce, signatures)
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestVerifyUserSignatures(t *testing.T) {
+	ctx := context.Background()
+	address := test.AddressGenerator().New()
+
+	key, signer := test.AccountKeyGenerator().NewWithSigner()
+	client := &accountClient{account: &flow.Account{Address: address, Keys: []*flow.AccountKey{key}}}
+
+	message := []byte("hello")
+	sig, err := crypto.SignUserMessage(signer, message)
+	require.NoError(t, err)
+
+	signatures := []flow.CompositeSignature{{Address: address, KeyIndex: key.Index, Signature: sig}}
+
+	valid, err := VerifyUserSignatures(ctx, client, address, message, signatures)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = VerifyUserSignatures(ctx, client, address, []byte("other"), signatures)
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestVerifyTransactionSignatures(t *testing.T) {
+	ctx := context.Background()
+	address := test.AddressGenerator().New()
+
+	key, signer := test.AccountKeyGenerator().NewWithSigner()
+	key.Index = 0
+	key.Weight = flow.AccountKeyWeightThreshold
+	client := &accountClient{account: &flow.Account{Address: address, Keys: []*flow.AccountKey{key}}}
+
+	tx := flow.NewTransaction().
+		SetScript([]byte("transaction {}")).
+		SetProposalKey(address, 0, 0).
+		SetPayer(address)
+	require.NoError(t, tx.SignEnvelope(address, 0, signer))
+
+	result, err := VerifyTransactionSignatures(ctx, client, tx)
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+}
@@ -0,0 +1,169 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// TransactionExpiry is the number of blocks after its reference block a transaction expires.
+const TransactionExpiry uint64 = 600
+
+// ErrTransactionExpired is returned when a transaction expired before being sealed.
+var ErrTransactionExpired = errors.New("transaction expired")
+
+// WaitOption configures how WaitForSeal waits for a transaction.
+type WaitOption func(c *waitConfig)
+
+type waitConfig struct {
+	pollInterval time.Duration
+	maxWait      time.Duration
+	jitter       float64
+	onStatus     func(result *flow.TransactionResult)
+}
+
+// WithPollInterval sets the interval between transaction result requests, 1 second by default.
+func WithPollInterval(interval time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		c.pollInterval = interval
+	}
+}
+
+// WithMaxWait sets the maximum time to wait for the transaction to be sealed, there is no limit by default.
+func WithMaxWait(maxWait time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		c.maxWait = maxWait
+	}
+}
+
+// WithJitter sets the fraction (between 0 and 1) of the poll interval that is randomized,
+// to spread the requests of many concurrent waits.
+func WithJitter(jitter float64) WaitOption {
+	return func(c *waitConfig) {
+		c.jitter = jitter
+	}
+}
+
+// WithStatusCallback sets a function called with the transaction result every time the transaction status changes.
+func WithStatusCallback(onStatus func(result *flow.TransactionResult)) WaitOption {
+	return func(c *waitConfig) {
+		c.onStatus = onStatus
+	}
+}
+
+func (c *waitConfig) interval() time.Duration {
+	interval := float64(c.pollInterval)
+	if c.jitter > 0 {
+		interval += interval * c.jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(interval)
+}
+
+// WaitForSeal waits until the transaction with the given ID is sealed and returns its result.
+//
+// The transaction result is polled until it is sealed, the context is done or the maximum wait time elapsed.
+// While the transaction is pending, the latest finalized block is compared to the transaction reference block
+// to detect expiry, in which case ErrTransactionExpired is returned along with the last result.
+//
+// A sealed transaction can still have failed, the execution error is available in the result Error field.
+func WaitForSeal(
+	ctx context.Context,
+	client Client,
+	txID flow.Identifier,
+	opts ...WaitOption,
+) (*flow.TransactionResult, error) {
+	config := waitConfig{pollInterval: time.Second}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if config.maxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.maxWait)
+		defer cancel()
+	}
+
+	var expiryHeight uint64
+	status := flow.TransactionStatusUnknown
+
+	for {
+		result, err := client.GetTransactionResult(ctx, txID)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Status != status {
+			status = result.Status
+			if config.onStatus != nil {
+				config.onStatus(result)
+			}
+		}
+
+		switch result.Status {
+		case flow.TransactionStatusSealed:
+			return result, nil
+		case flow.TransactionStatusExpired:
+			return result, ErrTransactionExpired
+		case flow.TransactionStatusUnknown, flow.TransactionStatusPending:
+			if expiryHeight == 0 {
+				expiryHeight, err = transactionExpiryHeight(ctx, client, txID)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			latest, err := client.GetLatestBlockHeader(ctx, false)
+			if err != nil {
+				return nil, err
+			}
+
+			if latest.Height > expiryHeight {
+				return result, ErrTransactionExpired
+			}
+		}
+
+		timer := time.NewTimer(config.interval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// transactionExpiryHeight returns the last block height the transaction can be included at.
+func transactionExpiryHeight(ctx context.Context, client Client, txID flow.Identifier) (uint64, error) {
+	tx, err := client.GetTransaction(ctx, txID)
+	if err != nil {
+		return 0, err
+	}
+
+	reference, err := client.GetBlockHeaderByID(ctx, tx.ReferenceBlockID)
+	if err != nil {
+		return 0, err
+	}
+
+	return reference.Height + TransactionExpiry, nil
+}
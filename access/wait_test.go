@@ -0,0 +1,116 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// waitClient is a client stub returning the configured transaction statuses in order,
+// with the latest block height increasing by one block with every request.
+type waitClient struct {
+	Client
+	statuses []flow.TransactionStatus
+	height   uint64
+}
+
+func (c *waitClient) GetTransactionResult(context.Context, flow.Identifier) (*flow.TransactionResult, error) {
+	status := c.statuses[0]
+	if len(c.statuses) > 1 {
+		c.statuses = c.statuses[1:]
+	}
+	return &flow.TransactionResult{Status: status}, nil
+}
+
+func (c *waitClient) GetTransaction(context.Context, flow.Identifier) (*flow.Transaction, error) {
+	return &flow.Transaction{ReferenceBlockID: flow.HexToID("0x01")}, nil
+}
+
+func (c *waitClient) GetBlockHeaderByID(context.Context, flow.Identifier) (*flow.BlockHeader, error) {
+	return &flow.BlockHeader{Height: 100}, nil
+}
+
+func (c *waitClient) GetLatestBlockHeader(context.Context, bool) (*flow.BlockHeader, error) {
+	c.height++
+	return &flow.BlockHeader{Height: c.height}, nil
+}
+
+func TestWaitForSeal(t *testing.T) {
+	ctx := context.Background()
+	txID := test.IdentifierGenerator().New()
+
+	t.Run("Sealed", func(t *testing.T) {
+		client := &waitClient{
+			statuses: []flow.TransactionStatus{
+				flow.TransactionStatusPending,
+				flow.TransactionStatusPending,
+				flow.TransactionStatusFinalized,
+				flow.TransactionStatusExecuted,
+				flow.TransactionStatusSealed,
+			},
+			height: 100,
+		}
+
+		var statuses []flow.TransactionStatus
+		result, err := WaitForSeal(ctx, client, txID,
+			WithPollInterval(time.Millisecond),
+			WithJitter(0.5),
+			WithStatusCallback(func(result *flow.TransactionResult) {
+				statuses = append(statuses, result.Status)
+			}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, flow.TransactionStatusSealed, result.Status)
+		assert.Equal(t, []flow.TransactionStatus{
+			flow.TransactionStatusPending,
+			flow.TransactionStatusFinalized,
+			flow.TransactionStatusExecuted,
+			flow.TransactionStatusSealed,
+		}, statuses)
+	})
+
+	t.Run("Expired By Reference Block", func(t *testing.T) {
+		client := &waitClient{
+			statuses: []flow.TransactionStatus{flow.TransactionStatusPending},
+			height:   100 + TransactionExpiry - 2,
+		}
+
+		result, err := WaitForSeal(ctx, client, txID, WithPollInterval(time.Millisecond))
+		assert.ErrorIs(t, err, ErrTransactionExpired)
+		assert.Equal(t, flow.TransactionStatusPending, result.Status)
+		assert.Equal(t, 100+TransactionExpiry+1, client.height)
+	})
+
+	t.Run("Max Wait", func(t *testing.T) {
+		client := &waitClient{
+			statuses: []flow.TransactionStatus{flow.TransactionStatusExecuted},
+		}
+
+		_, err := WaitForSeal(ctx, client, txID, WithPollInterval(time.Millisecond), WithMaxWait(10*time.Millisecond))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
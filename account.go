@@ -33,6 +33,23 @@ type Account struct {
 	Contracts map[string][]byte
 }
 
+// AccountStatus is the set of core account events emitted in a block for the accounts followed by an account status subscription.
+type AccountStatus struct {
+	BlockID     Identifier
+	BlockHeight uint64
+	// Events are the account events emitted in the block, by account address.
+	Events map[Address][]Event
+}
+
+// AccountStatusFilter selects the accounts and the core account events delivered by an account status subscription.
+type AccountStatusFilter struct {
+	// Addresses are the addresses of the followed accounts, all accounts are followed if empty.
+	Addresses []Address
+	// EventTypes are the core account event types to deliver, such as EventAccountKeyAdded,
+	// all core account events are delivered if empty.
+	EventTypes []string
+}
+
 // AccountKeyWeightThreshold is the total key weight required to authorize access to an account.
 const AccountKeyWeightThreshold int = 1000
 
@@ -70,3 +70,27 @@ func EncodeAccountProofMessage(address Address, appID, nonceHex string) ([]byte,
 
 	return msg, nil
 }
+
+// AccountProofSignableMessage returns the account proof message signed by the wallet,
+// which is the encoded account proof message prefixed with the account proof domain tag.
+func AccountProofSignableMessage(address Address, appID, nonceHex string) ([]byte, error) {
+	msg, err := EncodeAccountProofMessage(address, appID, nonceHex)
+	if err != nil {
+		return nil, err
+	}
+
+	return DomainTaggedMessage(AccountProofDomainTag, msg), nil
+}
+
+// VerifyAccountProof checks that the signatures prove the ownership of the account for the app
+// and the nonce, following the FCL account proof scheme.
+//
+// See VerifyAccountSignatures for how the signatures are verified.
+func VerifyAccountProof(account *Account, appID, nonceHex string, signatures []CompositeSignature) (bool, error) {
+	message, err := AccountProofSignableMessage(account.Address, appID, nonceHex)
+	if err != nil {
+		return false, err
+	}
+
+	return VerifyAccountSignatures(account, message, signatures)
+}
@@ -19,11 +19,15 @@
 package flow
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
 )
 
 func TestEncodeAccountProofMessage(t *testing.T) {
@@ -74,3 +78,89 @@ func TestEncodeAccountProofMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyAccountProof(t *testing.T) {
+	address := HexToAddress("ABC123DEF456")
+	appID := "AWESOME-APP-ID"
+	nonce := "3037366134636339643564623330316636626239323161663465346131393662"
+
+	newKey := func(index int, weight int, seed byte) (*AccountKey, crypto.Signer) {
+		privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, bytes.Repeat([]byte{seed}, crypto.MinSeedLength))
+		require.NoError(t, err)
+
+		signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+		require.NoError(t, err)
+
+		key := NewAccountKey().FromPrivateKey(privateKey).SetHashAlgo(crypto.SHA3_256).SetWeight(weight)
+		key.Index = index
+		return key, signer
+	}
+
+	fullKey, fullSigner := newKey(0, AccountKeyWeightThreshold, 1)
+	halfKey, halfSigner := newKey(1, AccountKeyWeightThreshold/2, 2)
+	otherHalfKey, otherHalfSigner := newKey(2, AccountKeyWeightThreshold/2, 3)
+
+	account := &Account{
+		Address: address,
+		Keys:    []*AccountKey{fullKey, halfKey, otherHalfKey},
+	}
+
+	message, err := AccountProofSignableMessage(address, appID, nonce)
+	require.NoError(t, err)
+
+	sign := func(keyIndex int, signer crypto.Signer) CompositeSignature {
+		sig, err := signer.Sign(message)
+		require.NoError(t, err)
+		return CompositeSignature{Address: address, KeyIndex: keyIndex, Signature: sig}
+	}
+
+	t.Run("Full weight key", func(t *testing.T) {
+		valid, err := VerifyAccountProof(account, appID, nonce, []CompositeSignature{sign(0, fullSigner)})
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("Aggregated weight", func(t *testing.T) {
+		valid, err := VerifyAccountProof(account, appID, nonce, []CompositeSignature{
+			sign(1, halfSigner),
+			sign(2, otherHalfSigner),
+		})
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("Insufficient weight", func(t *testing.T) {
+		valid, err := VerifyAccountProof(account, appID, nonce, []CompositeSignature{
+			sign(1, halfSigner),
+			sign(1, halfSigner),
+		})
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("Wrong signer", func(t *testing.T) {
+		valid, err := VerifyAccountProof(account, appID, nonce, []CompositeSignature{sign(0, halfSigner)})
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("Different app", func(t *testing.T) {
+		valid, err := VerifyAccountProof(account, "OTHER-APP-ID", nonce, []CompositeSignature{sign(0, fullSigner)})
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("Revoked key", func(t *testing.T) {
+		revoked := *fullKey
+		revoked.Revoked = true
+		account := &Account{Address: address, Keys: []*AccountKey{&revoked}}
+
+		_, err := VerifyAccountProof(account, appID, nonce, []CompositeSignature{sign(0, fullSigner)})
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown key", func(t *testing.T) {
+		_, err := VerifyAccountProof(account, appID, nonce, []CompositeSignature{sign(3, fullSigner)})
+		assert.Error(t, err)
+	})
+}
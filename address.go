@@ -108,7 +108,7 @@ func chainCustomizer(chain ChainID) uint64 {
 		return 0
 	case Testnet:
 		return invalidCodeTestNetwork
-	case Stagingnet:
+	case Stagingnet, Canary:
 		return invalidCodeStagingNetwork
 	case Emulator, Localnet, Benchnet, BftTestnet:
 		return invalidCodeTransientNetwork
@@ -260,6 +260,149 @@ func (a *Address) IsValid(chain ChainID) bool {
 	return parity == 0
 }
 
+// linearCodeChains are the chains with distinct address spaces, in the order addresses are matched
+// against them. Chains sharing the address space of one of them are omitted.
+var linearCodeChains = []ChainID{Mainnet, Testnet, Canary, Emulator}
+
+// supportsLinearCode returns true if addresses of the chain are generated with the linear code.
+func supportsLinearCode(chain ChainID) bool {
+	switch chain {
+	case Mainnet, Testnet, Stagingnet, Canary, Emulator, Localnet, Benchnet, BftTestnet:
+		return true
+	default:
+		return false
+	}
+}
+
+// AddressAtIndex returns the address generated at the index of the chain.
+//
+// Indexes start at 1, the index of the service account, and increase with each account created on the chain.
+func AddressAtIndex(chain ChainID, index uint64) (Address, error) {
+	if !supportsLinearCode(chain) {
+		return EmptyAddress, fmt.Errorf("chain ID [%s] does not support linear code address generation", chain)
+	}
+	if index == 0 || index > maxState {
+		return EmptyAddress, fmt.Errorf("address index must be between 1 and %d, got %d", maxState, index)
+	}
+
+	return generateAddress(chain, addressState(index)), nil
+}
+
+// Index returns the index the address is generated at on the chain, the inverse of AddressAtIndex.
+//
+// An error is returned if the address is not a valid address of the chain.
+func (a Address) Index(chain ChainID) (uint64, error) {
+	if !supportsLinearCode(chain) {
+		return 0, fmt.Errorf("chain ID [%s] does not support linear code address generation", chain)
+	}
+	if !a.IsValid(chain) {
+		return 0, fmt.Errorf("address %s is not a valid address of chain %s", a.Hex(), chain)
+	}
+
+	codeWord := a.uint64() ^ chainCustomizer(chain)
+	index := uint64(0)
+	for _, row := range addressIndexBasis {
+		if codeWord&(1<<row.pivot) != 0 {
+			codeWord ^= row.codeWord
+			index ^= row.index
+		}
+	}
+
+	return index, nil
+}
+
+// Chain returns the chain the address is a valid address of, checking the address spaces of mainnet,
+// testnet, canary and the emulator. The emulator address space is shared by the transient networks,
+// such as localnet, and the canary address space by stagingnet.
+//
+// An error is returned if the address is not valid on any of these chains.
+func (a Address) Chain() (ChainID, error) {
+	for _, chain := range linearCodeChains {
+		if a.IsValid(chain) {
+			return chain, nil
+		}
+	}
+	return "", fmt.Errorf("address %s is not a valid address of any known chain", a.Hex())
+}
+
+// Validate returns an error if the address is not a valid address of the chain, naming the chain the
+// address belongs to if any, for example to check a recipient address before sending funds.
+func (a Address) Validate(chain ChainID) error {
+	if !supportsLinearCode(chain) {
+		return fmt.Errorf("chain ID [%s] does not support linear code address generation", chain)
+	}
+	if a.IsValid(chain) {
+		return nil
+	}
+
+	if other, err := a.Chain(); err == nil {
+		return fmt.Errorf("address %s is not a valid address of chain %s, it is an address of chain %s", a.Hex(), chain, other)
+	}
+	return fmt.Errorf("address %s is not a valid address of chain %s", a.Hex(), chain)
+}
+
+// ForEachAddress calls the function with the addresses of the chain from the start index to the end index
+// (inclusive), in order, until the function returns false.
+func ForEachAddress(chain ChainID, start uint64, end uint64, fn func(index uint64, address Address) bool) error {
+	if _, err := AddressAtIndex(chain, start); err != nil {
+		return err
+	}
+	if _, err := AddressAtIndex(chain, end); err != nil {
+		return err
+	}
+
+	for index := start; index <= end; index++ {
+		if !fn(index, generateAddress(chain, addressState(index))) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// addressBasisRow is a row of the reduced generator matrix, the code word of the index combination.
+type addressBasisRow struct {
+	pivot    uint
+	codeWord uint64
+	index    uint64
+}
+
+// addressIndexBasis is the generator matrix in reduced row echelon form, each row tracking the combination
+// of indexes it is generated from, so the index of a code word is recovered by eliminating its pivot bits.
+var addressIndexBasis = func() []addressBasisRow {
+	rows := make([]addressBasisRow, linearCodeK)
+	for i, row := range generatorMatrixRows {
+		rows[i] = addressBasisRow{codeWord: row, index: 1 << i}
+	}
+
+	next := 0
+	for bit := linearCodeN - 1; bit >= 0 && next < len(rows); bit-- {
+		mask := uint64(1) << bit
+
+		pivot := -1
+		for i := next; i < len(rows); i++ {
+			if rows[i].codeWord&mask != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+
+		rows[next], rows[pivot] = rows[pivot], rows[next]
+		rows[next].pivot = uint(bit)
+		for i := range rows {
+			if i != next && rows[i].codeWord&mask != 0 {
+				rows[i].codeWord ^= rows[next].codeWord
+				rows[i].index ^= rows[next].index
+			}
+		}
+		next++
+	}
+
+	return rows
+}()
+
 // invalid code-words in the [64,45] code
 // these constants are used to generate non-Flow-Mainnet addresses
 const (
@@ -74,6 +74,7 @@ func TestAddressConstants(t *testing.T) {
 	networks := []ChainID{
 		Mainnet,
 		Testnet,
+		Canary,
 		Emulator,
 	}
 
@@ -301,3 +302,74 @@ func TestAddressesIntersection(t *testing.T) {
 		}
 	}
 }
+
+func TestAddressAtIndex(t *testing.T) {
+	for _, chain := range []ChainID{Mainnet, Testnet, Emulator} {
+		t.Run(chain.String(), func(t *testing.T) {
+			address, err := AddressAtIndex(chain, 1)
+			require.NoError(t, err)
+			assert.Equal(t, ServiceAddress(chain), address)
+
+			for _, index := range []uint64{1, 2, 100, 1 << 20, maxState} {
+				address, err := AddressAtIndex(chain, index)
+				require.NoError(t, err)
+				assert.True(t, address.IsValid(chain))
+				assert.NoError(t, address.Validate(chain))
+
+				decoded, err := address.Index(chain)
+				require.NoError(t, err)
+				assert.Equal(t, index, decoded)
+			}
+		})
+	}
+
+	_, err := AddressAtIndex(Mainnet, 0)
+	assert.Error(t, err)
+	_, err = AddressAtIndex(Mainnet, maxState+1)
+	assert.Error(t, err)
+	_, err = AddressAtIndex(MonotonicEmulator, 1)
+	assert.Error(t, err)
+}
+
+func TestAddressChain(t *testing.T) {
+	mainnet := HexToAddress("1654653399040a61")
+	testnet := HexToAddress("7e60df042a9c0868")
+	emulator := HexToAddress("f8d6e0586b0a20c7")
+
+	for address, expected := range map[Address]ChainID{
+		mainnet:  Mainnet,
+		testnet:  Testnet,
+		emulator: Emulator,
+	} {
+		chain, err := address.Chain()
+		require.NoError(t, err)
+		assert.Equal(t, expected, chain)
+	}
+
+	_, err := HexToAddress("01").Chain()
+	assert.Error(t, err)
+
+	err = testnet.Validate(Mainnet)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "it is an address of chain flow-testnet")
+
+	_, err = testnet.Index(Mainnet)
+	assert.Error(t, err)
+}
+
+func TestForEachAddress(t *testing.T) {
+	var indexes []uint64
+	err := ForEachAddress(Testnet, 1, 10, func(index uint64, address Address) bool {
+		expected, err := AddressAtIndex(Testnet, index)
+		require.NoError(t, err)
+		assert.Equal(t, expected, address)
+
+		indexes = append(indexes, index)
+		return index < 5
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, indexes)
+
+	err = ForEachAddress(Testnet, 0, 10, func(uint64, Address) bool { return true })
+	assert.Error(t, err)
+}
@@ -0,0 +1,175 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package arguments provides helpers creating Cadence values with the correct types
+// from Go values, to be used as transaction and script arguments.
+package arguments
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// Value converts a Go value to the Cadence value with the matching type.
+//
+// See flow.ToCadenceValue for how Go values are converted.
+func Value(v interface{}) (cadence.Value, error) {
+	return flow.ToCadenceValue(v)
+}
+
+// Values converts a list of Go values to Cadence values.
+func Values(values ...interface{}) ([]cadence.Value, error) {
+	converted := make([]cadence.Value, len(values))
+	for i, v := range values {
+		value, err := flow.ToCadenceValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert argument %d: %w", i, err)
+		}
+		converted[i] = value
+	}
+	return converted, nil
+}
+
+// Bool returns a Cadence Bool.
+func Bool(v bool) cadence.Bool {
+	return cadence.NewBool(v)
+}
+
+// String returns a Cadence String, or an error if the string is not valid UTF-8.
+func String(v string) (cadence.String, error) {
+	return cadence.NewString(v)
+}
+
+// Address returns a Cadence Address.
+func Address(address flow.Address) cadence.Address {
+	return cadence.NewAddress(address)
+}
+
+// Int returns a Cadence Int.
+func Int(v int) cadence.Int {
+	return cadence.NewInt(v)
+}
+
+// Int8 returns a Cadence Int8.
+func Int8(v int8) cadence.Int8 {
+	return cadence.NewInt8(v)
+}
+
+// Int16 returns a Cadence Int16.
+func Int16(v int16) cadence.Int16 {
+	return cadence.NewInt16(v)
+}
+
+// Int32 returns a Cadence Int32.
+func Int32(v int32) cadence.Int32 {
+	return cadence.NewInt32(v)
+}
+
+// Int64 returns a Cadence Int64.
+func Int64(v int64) cadence.Int64 {
+	return cadence.NewInt64(v)
+}
+
+// UInt returns a Cadence UInt.
+func UInt(v uint) cadence.UInt {
+	return cadence.NewUInt(v)
+}
+
+// UInt8 returns a Cadence UInt8.
+func UInt8(v uint8) cadence.UInt8 {
+	return cadence.NewUInt8(v)
+}
+
+// UInt16 returns a Cadence UInt16.
+func UInt16(v uint16) cadence.UInt16 {
+	return cadence.NewUInt16(v)
+}
+
+// UInt32 returns a Cadence UInt32.
+func UInt32(v uint32) cadence.UInt32 {
+	return cadence.NewUInt32(v)
+}
+
+// UInt64 returns a Cadence UInt64.
+func UInt64(v uint64) cadence.UInt64 {
+	return cadence.NewUInt64(v)
+}
+
+// UFix64 parses a decimal string (e.g. "10.5") into a Cadence UFix64.
+//
+// Fixed-point values are parsed from strings rather than converted from floats,
+// which can't represent most decimal fractions exactly.
+func UFix64(v string) (cadence.UFix64, error) {
+	return cadence.NewUFix64(v)
+}
+
+// MustUFix64 parses a decimal string into a Cadence UFix64, panicking if the string is invalid.
+func MustUFix64(v string) cadence.UFix64 {
+	value, err := UFix64(v)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Fix64 parses a decimal string (e.g. "-10.5") into a Cadence Fix64.
+func Fix64(v string) (cadence.Fix64, error) {
+	return cadence.NewFix64(v)
+}
+
+// MustFix64 parses a decimal string into a Cadence Fix64, panicking if the string is invalid.
+func MustFix64(v string) cadence.Fix64 {
+	value, err := Fix64(v)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Optional returns a Cadence optional containing the value.
+func Optional(v cadence.Value) cadence.Optional {
+	return cadence.NewOptional(v)
+}
+
+// Nil returns an empty Cadence optional.
+func Nil() cadence.Optional {
+	return cadence.NewOptional(nil)
+}
+
+// Array returns a Cadence array of the values.
+func Array(values ...cadence.Value) cadence.Array {
+	return cadence.NewArray(values)
+}
+
+// StoragePath returns a Cadence path in the storage domain.
+func StoragePath(identifier string) cadence.Path {
+	return cadence.NewPath("storage", identifier)
+}
+
+// PublicPath returns a Cadence path in the public domain.
+func PublicPath(identifier string) cadence.Path {
+	return cadence.NewPath("public", identifier)
+}
+
+// PrivatePath returns a Cadence path in the private domain.
+func PrivatePath(identifier string) cadence.Path {
+	return cadence.NewPath("private", identifier)
+}
@@ -0,0 +1,80 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package arguments_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/arguments"
+)
+
+func TestUFix64(t *testing.T) {
+	value, err := arguments.UFix64("10.5")
+	require.NoError(t, err)
+	assert.Equal(t, cadence.UFix64(1_050_000_000), value)
+
+	_, err = arguments.UFix64("-1.0")
+	assert.Error(t, err)
+
+	assert.Panics(t, func() { arguments.MustUFix64("foo") })
+	assert.Equal(t, cadence.Fix64(-150_000_000), arguments.MustFix64("-1.5"))
+}
+
+func TestEncoding(t *testing.T) {
+	tests := []struct {
+		value    cadence.Value
+		expected string
+	}{
+		{arguments.UInt64(5), `{"type":"UInt64","value":"5"}`},
+		{arguments.Int8(-5), `{"type":"Int8","value":"-5"}`},
+		{arguments.MustUFix64("1.0"), `{"type":"UFix64","value":"1.00000000"}`},
+		{arguments.Address(flow.HexToAddress("01")), `{"type":"Address","value":"0x0000000000000001"}`},
+		{arguments.Nil(), `{"type":"Optional","value":null}`},
+		{arguments.StoragePath("vault"), `{"type":"Path","value":{"domain":"storage","identifier":"vault"}}`},
+		{
+			arguments.Array(arguments.Bool(true), arguments.UInt8(1)),
+			`{"type":"Array","value":[{"type":"Bool","value":true},{"type":"UInt8","value":"1"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		encoded, err := jsoncdc.Encode(tt.value)
+		require.NoError(t, err)
+		assert.JSONEq(t, tt.expected, string(encoded))
+	}
+}
+
+func TestValues(t *testing.T) {
+	values, err := arguments.Values(uint64(1), "foo", flow.HexToAddress("01"))
+	require.NoError(t, err)
+	assert.Equal(t, []cadence.Value{
+		cadence.NewUInt64(1),
+		cadence.String("foo"),
+		cadence.NewAddress(flow.HexToAddress("01")),
+	}, values)
+
+	_, err = arguments.Values(1.5)
+	assert.Error(t, err)
+}
@@ -32,6 +32,8 @@ type BlockHeader struct {
 	ParentID  Identifier
 	Height    uint64
 	Timestamp time.Time
+	// ParentVoterSigData is the aggregated signature of the consensus nodes that voted for the parent block.
+	ParentVoterSigData []byte
 }
 
 // BlockPayload is the full contents of a block.
@@ -42,6 +44,35 @@ type BlockPayload struct {
 	Seals                []*BlockSeal
 }
 
+// BlockDigest is the minimal description of a block, used to follow the chain progress.
+type BlockDigest struct {
+	ID        Identifier
+	Height    uint64
+	Timestamp time.Time
+}
+
+// BlockStatus is the status of a block in the chain.
+type BlockStatus int
+
+const (
+	BlockStatusUnknown BlockStatus = iota
+	// BlockStatusFinalized is the status of blocks that are part of the chain but whose execution is not yet verified.
+	BlockStatusFinalized
+	// BlockStatusSealed is the status of blocks whose execution results have been verified.
+	BlockStatusSealed
+)
+
+func (s BlockStatus) String() string {
+	switch s {
+	case BlockStatusFinalized:
+		return "finalized"
+	case BlockStatusSealed:
+		return "sealed"
+	default:
+		return "unknown"
+	}
+}
+
 // BlockSeal is the attestation by verification nodes that the transactions in a previously
 // executed block have been verified.
 type BlockSeal struct {
@@ -51,4 +82,23 @@ type BlockSeal struct {
 	// The ID of the execution receipt generated by the Verifier nodes; the work of verifying a
 	// block produces the same receipt among all verifying nodes
 	ExecutionReceiptID Identifier
+
+	// The ID of the execution result being sealed
+	ResultID Identifier
+
+	// The state commitment of the execution state after the sealed block
+	FinalState []byte
+
+	// The signatures of the verifier nodes that approved each chunk of the execution result, in
+	// the order of the chunks
+	AggregatedApprovalSigs []*AggregatedSignature
+}
+
+// AggregatedSignature is the set of signatures of the verifier nodes that approved a chunk of an execution result.
+type AggregatedSignature struct {
+	// The signatures of the verifier nodes
+	VerifierSignatures [][]byte
+
+	// The IDs of the verifier nodes, in the order of their signatures
+	SignerIDs []Identifier
 }
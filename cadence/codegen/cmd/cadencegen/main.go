@@ -0,0 +1,122 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command cadencegen generates Go bindings for a Cadence contract.
+//
+// Usage:
+//
+//	cadencegen -contract ExampleToken.cdc -package exampletoken [-out exampletoken.go] [-script get_balance.cdc] [transaction.cdc ...]
+//
+// The transactions and scripts are named after their file names, so transfer_tokens.cdc generates
+// the NewTransferTokensTransaction method. The code is written to the standard output if no output
+// file is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk/cadence/codegen"
+)
+
+// files is a flag collecting file names.
+type files []string
+
+func (f *files) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *files) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	var scripts files
+
+	contract := flag.String("contract", "", "path of the Cadence contract")
+	pkg := flag.String("package", "", "name of the package of the generated code")
+	out := flag.String("out", "", "path of the generated file, defaults to the standard output")
+	flag.Var(&scripts, "script", "path of a script using the contract, may be repeated")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: cadencegen -contract FILE -package NAME [-out FILE] [-script FILE]... [TRANSACTION FILE]...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*contract, *pkg, *out, scripts, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "cadencegen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(contract string, pkg string, out string, scripts []string, transactions []string) error {
+	if contract == "" || pkg == "" {
+		flag.Usage()
+		return fmt.Errorf("contract and package are required")
+	}
+
+	code, err := os.ReadFile(contract)
+	if err != nil {
+		return err
+	}
+
+	config := codegen.Config{
+		Package:  pkg,
+		Contract: code,
+	}
+
+	if config.Scripts, err = readFiles(scripts); err != nil {
+		return err
+	}
+	if config.Transactions, err = readFiles(transactions); err != nil {
+		return err
+	}
+
+	generated, err := codegen.Generate(config)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(generated)
+		return err
+	}
+	return os.WriteFile(out, generated, 0644)
+}
+
+// readFiles reads the files by name, the name of a file being its base name without extension.
+func readFiles(paths []string) (map[string][]byte, error) {
+	contents := make(map[string][]byte, len(paths))
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if _, ok := contents[name]; ok {
+			return nil, fmt.Errorf("duplicate file name %s", name)
+		}
+		contents[name] = content
+	}
+	return contents, nil
+}
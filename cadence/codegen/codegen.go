@@ -0,0 +1,627 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package codegen generates Go bindings for Cadence contracts.
+//
+// The generated code contains Go types for the events and structs declared by a contract, and a
+// client with typed methods executing scripts that call the public functions and read the public
+// fields of the contract, and creating the transactions and scripts shipped with the contract:
+//
+//	//go:generate go run github.com/onflow/flow-go-sdk/cadence/codegen/cmd/cadencegen -contract ExampleToken.cdc -package exampletoken -out exampletoken.go transactions/transfer_tokens.cdc
+//
+// Values are decoded from Cadence with the cadence package of the SDK, so the generated types can
+// be used with cadence.Unmarshal and cadence.EventRegistry.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+// Config is the input of the code generator.
+type Config struct {
+	// Package is the name of the package of the generated code.
+	Package string
+	// Contract is the source code of the contract.
+	Contract []byte
+	// Transactions are the source code of the transactions using the contract, by name.
+	Transactions map[string][]byte
+	// Scripts are the source code of the scripts using the contract, by name.
+	Scripts map[string][]byte
+}
+
+// Generate generates the Go bindings of the contract.
+func Generate(config Config) ([]byte, error) {
+	if config.Package == "" {
+		return nil, fmt.Errorf("codegen: package name is required")
+	}
+
+	b, err := newBindings(config)
+	if err != nil {
+		return nil, fmt.Errorf("codegen: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := bindingsTemplate.Execute(&buf, b); err != nil {
+		return nil, fmt.Errorf("codegen: failed to generate code: %w", err)
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("codegen: failed to format generated code: %w", err)
+	}
+	return code, nil
+}
+
+// bindings is the model of the generated code.
+type bindings struct {
+	Package      string
+	Contract     string
+	UsesBig      bool
+	Structs      []goStruct
+	Events       []goStruct
+	Getters      []method
+	Scripts      []method
+	Transactions []method
+}
+
+type goStruct struct {
+	Name       string
+	Identifier string
+	Summary    string
+	Doc        []string
+	Fields     []goField
+}
+
+type goField struct {
+	Name       string
+	Identifier string
+	Type       string
+}
+
+// method is a generated client method, executing a script or creating a transaction.
+type method struct {
+	Name     string
+	Receiver string
+	Summary  string
+	Doc      []string
+	// Zero is the value returned with errors.
+	Zero string
+	// Constant is the name of the constant holding the code of the script or transaction.
+	Constant   string
+	Code       string
+	Parameters []parameter
+	ReturnType string
+}
+
+type parameter struct {
+	Name       string
+	Identifier string
+	Type       string
+	Expression string
+	Fallible   bool
+}
+
+func newBindings(config Config) (*bindings, error) {
+	program, err := parse(config.Contract)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract: %w", err)
+	}
+
+	contract := program.SoleContractDeclaration()
+	if contract == nil {
+		return nil, fmt.Errorf("code must declare exactly one contract")
+	}
+
+	b := &bindings{
+		Package:  config.Package,
+		Contract: contract.Identifier.Identifier,
+	}
+
+	mapper := &typeMapper{
+		contract: b.Contract,
+		declared: make(map[string]bool),
+		structs:  make(map[string]string),
+	}
+	for _, composite := range contract.Members.Composites() {
+		mapper.declared[composite.Identifier.Identifier] = true
+		if composite.CompositeKind == common.CompositeKindStructure {
+			mapper.structs[composite.Identifier.Identifier] = exported(composite.Identifier.Identifier)
+		}
+	}
+	for _, iface := range contract.Members.Interfaces() {
+		mapper.declared[iface.Identifier.Identifier] = true
+	}
+
+	for _, composite := range contract.Members.Composites() {
+		switch composite.CompositeKind {
+		case common.CompositeKindStructure:
+			b.Structs = append(b.Structs, newStruct(mapper, composite, ""))
+		case common.CompositeKindEvent:
+			b.Events = append(b.Events, newStruct(mapper, composite, "Event"))
+		}
+	}
+
+	for _, field := range contract.Members.Fields() {
+		if !public(field.Access) || field.TypeAnnotation.IsResource {
+			continue
+		}
+
+		name := exported(field.Identifier.Identifier)
+		b.Getters = append(b.Getters, method{
+			Name:       "Get" + name,
+			Receiver:   b.Contract + "Client",
+			Summary:    fmt.Sprintf("Get%s returns the %s field of the %s contract.", name, field.Identifier.Identifier, b.Contract),
+			Doc:        docLines(field.DocString),
+			Zero:       "result",
+			Constant:   unexported(b.Contract) + "Get" + name + "Script",
+			Code:       getterScript(mapper, mapper.cadenceType(field.TypeAnnotation.Type), nil, b.Contract+"."+field.Identifier.Identifier),
+			ReturnType: mapper.goType(field.TypeAnnotation.Type),
+		})
+	}
+
+	for _, function := range contract.Members.Functions() {
+		if !public(function.Access) || !returnsValue(function) || function.ReturnTypeAnnotation.IsResource {
+			continue
+		}
+
+		parameters, ok := newParameters(mapper, function.ParameterList)
+		if !ok {
+			continue
+		}
+
+		name := exported(function.Identifier.Identifier)
+		b.Getters = append(b.Getters, method{
+			Name:       name,
+			Receiver:   b.Contract + "Client",
+			Summary:    fmt.Sprintf("%s calls the %s function of the %s contract.", name, function.Identifier.Identifier, b.Contract),
+			Doc:        docLines(function.DocString),
+			Zero:       "result",
+			Constant:   unexported(b.Contract) + name + "Script",
+			Code:       getterScript(mapper, mapper.cadenceType(function.ReturnTypeAnnotation.Type), function.ParameterList, b.Contract+"."+function.Identifier.Identifier),
+			Parameters: parameters,
+			ReturnType: mapper.goType(function.ReturnTypeAnnotation.Type),
+		})
+	}
+
+	for _, name := range sortedNames(config.Scripts) {
+		script, err := newScript(mapper, b.Contract, name, config.Scripts[name])
+		if err != nil {
+			return nil, err
+		}
+		b.Scripts = append(b.Scripts, script)
+	}
+
+	for _, name := range sortedNames(config.Transactions) {
+		transaction, err := newTransaction(mapper, b.Contract, name, config.Transactions[name])
+		if err != nil {
+			return nil, err
+		}
+		b.Transactions = append(b.Transactions, transaction)
+	}
+
+	b.UsesBig = mapper.usesBig
+	return b, nil
+}
+
+func newStruct(mapper *typeMapper, composite *ast.CompositeDeclaration, suffix string) goStruct {
+	s := goStruct{
+		Name:       exported(composite.Identifier.Identifier) + suffix,
+		Identifier: composite.Identifier.Identifier,
+		Doc:        docLines(composite.DocString),
+	}
+	s.Summary = fmt.Sprintf("%s is the %s %s of the %s contract.", s.Name, s.Identifier, composite.CompositeKind.Keyword(), mapper.contract)
+
+	if composite.CompositeKind == common.CompositeKindEvent {
+		// the fields of events are the parameters of their initializer
+		for _, initializer := range composite.Members.Initializers() {
+			for _, param := range initializer.FunctionDeclaration.ParameterList.Parameters {
+				s.Fields = append(s.Fields, goField{
+					Name:       exported(param.Identifier.Identifier),
+					Identifier: param.Identifier.Identifier,
+					Type:       mapper.goType(param.TypeAnnotation.Type),
+				})
+			}
+		}
+		return s
+	}
+
+	for _, field := range composite.Members.Fields() {
+		s.Fields = append(s.Fields, goField{
+			Name:       exported(field.Identifier.Identifier),
+			Identifier: field.Identifier.Identifier,
+			Type:       mapper.goType(field.TypeAnnotation.Type),
+		})
+	}
+	return s
+}
+
+func newScript(mapper *typeMapper, contract string, name string, code []byte) (method, error) {
+	program, err := parse(code)
+	if err != nil {
+		return method{}, fmt.Errorf("failed to parse script %s: %w", name, err)
+	}
+
+	var main *ast.FunctionDeclaration
+	for _, function := range program.FunctionDeclarations() {
+		if function.Identifier.Identifier == "main" {
+			main = function
+		}
+	}
+	if main == nil || !returnsValue(main) {
+		return method{}, fmt.Errorf("script %s must declare a main function returning a value", name)
+	}
+
+	parameters, ok := newParameters(mapper, main.ParameterList)
+	if !ok {
+		return method{}, fmt.Errorf("script %s has resource parameters", name)
+	}
+
+	goName := exported(camelCase(name))
+	return method{
+		Name:       goName,
+		Receiver:   contract + "Client",
+		Summary:    fmt.Sprintf("%s executes the %s script.", goName, name),
+		Doc:        docLines(main.DocString),
+		Zero:       "result",
+		Constant:   unexported(contract) + goName + "Script",
+		Code:       string(code),
+		Parameters: parameters,
+		ReturnType: mapper.goType(main.ReturnTypeAnnotation.Type),
+	}, nil
+}
+
+func newTransaction(mapper *typeMapper, contract string, name string, code []byte) (method, error) {
+	program, err := parse(code)
+	if err != nil {
+		return method{}, fmt.Errorf("failed to parse transaction %s: %w", name, err)
+	}
+
+	transaction := program.SoleTransactionDeclaration()
+	if transaction == nil {
+		return method{}, fmt.Errorf("code of transaction %s must declare exactly one transaction", name)
+	}
+
+	parameters, ok := newParameters(mapper, transaction.ParameterList)
+	if !ok {
+		return method{}, fmt.Errorf("transaction %s has resource parameters", name)
+	}
+
+	goName := exported(camelCase(name))
+	return method{
+		Name:       "New" + goName + "Transaction",
+		Receiver:   contract + "Client",
+		Summary:    fmt.Sprintf("New%sTransaction creates a %s transaction.", goName, name),
+		Doc:        docLines(transaction.DocString),
+		Zero:       "nil",
+		Constant:   unexported(contract) + goName + "Transaction",
+		Code:       string(code),
+		Parameters: parameters,
+	}, nil
+}
+
+// reservedNames are the names used by the generated methods, which can't be used as parameter names.
+var reservedNames = map[string]bool{
+	"c":      true,
+	"ctx":    true,
+	"args":   true,
+	"arg":    true,
+	"err":    true,
+	"result": true,
+	"tx":     true,
+	"code":   true,
+}
+
+// newParameters returns the parameters of the generated method, and false if a parameter is a resource.
+func newParameters(mapper *typeMapper, list *ast.ParameterList) ([]parameter, bool) {
+	if list == nil {
+		return nil, true
+	}
+
+	parameters := make([]parameter, 0, len(list.Parameters))
+	for _, param := range list.Parameters {
+		if param.TypeAnnotation.IsResource {
+			return nil, false
+		}
+
+		name := unexported(param.Identifier.Identifier)
+		if reservedNames[name] || token.IsKeyword(name) {
+			name += "Arg"
+		}
+
+		goType, expression, fallible := mapper.argument(param.TypeAnnotation.Type, name)
+		parameters = append(parameters, parameter{
+			Name:       name,
+			Identifier: param.Identifier.Identifier,
+			Type:       goType,
+			Expression: expression,
+			Fallible:   fallible,
+		})
+	}
+	return parameters, true
+}
+
+// getterScript returns a script returning the value of the expression, calling it with the parameters
+// of the function if any.
+func getterScript(mapper *typeMapper, returnType string, list *ast.ParameterList, expression string) string {
+	var params, args []string
+	if list != nil {
+		for _, param := range list.Parameters {
+			params = append(params, fmt.Sprintf("%s: %s", param.Identifier.Identifier, mapper.cadenceType(param.TypeAnnotation.Type)))
+			switch param.Label {
+			case "_":
+				args = append(args, param.Identifier.Identifier)
+			case "":
+				args = append(args, fmt.Sprintf("%s: %s", param.Identifier.Identifier, param.Identifier.Identifier))
+			default:
+				args = append(args, fmt.Sprintf("%s: %s", param.Label, param.Identifier.Identifier))
+			}
+		}
+		expression += "(" + strings.Join(args, ", ") + ")"
+	}
+
+	return fmt.Sprintf(
+		"import %s\n\npub fun main(%s): %s {\n\treturn %s\n}\n",
+		mapper.contract,
+		strings.Join(params, ", "),
+		returnType,
+		expression,
+	)
+}
+
+// importPattern matches import declarations, including imports from placeholders such as
+// 0xFUNGIBLETOKENADDRESS which are not valid Cadence.
+var importPattern = regexp.MustCompile(`(?m)^[ \t]*import[ \t].*$`)
+
+// parse parses the code, ignoring its imports which are resolved when the code is executed.
+func parse(code []byte) (*ast.Program, error) {
+	return parser.ParseProgram(importPattern.ReplaceAll(code, nil), nil)
+}
+
+func public(access ast.Access) bool {
+	return access == ast.AccessPublic || access == ast.AccessPublicSettable
+}
+
+func returnsValue(function *ast.FunctionDeclaration) bool {
+	annotation := function.ReturnTypeAnnotation
+	if annotation == nil || annotation.Type == nil {
+		return false
+	}
+	nominal, ok := annotation.Type.(*ast.NominalType)
+	return !ok || nominal.Identifier.Identifier != "" && nominal.Identifier.Identifier != "Void"
+}
+
+func docLines(docString string) []string {
+	docString = strings.TrimSpace(docString)
+	if docString == "" {
+		return nil
+	}
+
+	lines := strings.Split(docString, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return lines
+}
+
+func sortedNames(code map[string][]byte) []string {
+	names := make([]string, 0, len(code))
+	for name := range code {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// camelCase converts names such as "transfer_tokens" and "get-balance" to camel case.
+func camelCase(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i := 1; i < len(words); i++ {
+		words[i] = exported(words[i])
+	}
+	return strings.Join(words, "")
+}
+
+// initialisms are the suffixes of names written in upper case in Go.
+var initialisms = []string{"Id", "Uuid", "Url", "Uri"}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+
+	name = strings.ToUpper(name[:1]) + name[1:]
+	for _, initialism := range initialisms {
+		if strings.HasSuffix(name, initialism) {
+			return strings.TrimSuffix(name, initialism) + strings.ToUpper(initialism)
+		}
+	}
+	return name
+}
+
+func unexported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// quote returns the code as a Go string literal, using a raw string literal when possible.
+func quote(code string) string {
+	if strings.Contains(code, "`") || strings.Contains(code, "\r") {
+		return strconv.Quote(code)
+	}
+	return "`" + code + "`"
+}
+
+var bindingsTemplate = template.Must(template.New("bindings").Funcs(template.FuncMap{"quote": quote}).Parse(`// Code generated by cadencegen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"fmt"
+{{- if .UsesBig}}
+	"math/big"
+{{- end}}
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// {{.Contract}}Contract is the name of the {{.Contract}} contract.
+const {{.Contract}}Contract = "{{.Contract}}"
+{{range .Structs}}
+{{template "struct" .}}
+{{end}}
+{{- range .Events}}
+{{template "struct" .}}
+{{end}}
+// {{.Contract}}Client executes the scripts and creates the transactions of the {{.Contract}} contract.
+type {{.Contract}}Client struct {
+	client   access.Client
+	address  flow.Address
+	resolver *templates.ImportResolver
+}
+
+// New{{.Contract}}Client creates a client for the {{.Contract}} contract deployed to the address.
+//
+// The contracts are the addresses of the other contracts imported by the scripts and transactions,
+// such as the addresses returned by templates.CoreContractAddresses.
+func New{{.Contract}}Client(client access.Client, address flow.Address, contracts templates.ContractAddresses) *{{.Contract}}Client {
+	return &{{.Contract}}Client{
+		client:   client,
+		address:  address,
+		resolver: templates.NewImportResolverWithContracts(contracts).WithContract({{.Contract}}Contract, address),
+	}
+}
+
+// Address returns the address the contract is deployed to.
+func (c *{{.Contract}}Client) Address() flow.Address {
+	return c.address
+}
+
+// EventType returns the qualified type of the event of the contract with the given name.
+func (c *{{.Contract}}Client) EventType(event string) string {
+	return fmt.Sprintf("A.%s.%s.%s", c.address.Hex(), {{.Contract}}Contract, event)
+}
+
+// RegisterEvents registers the events of the contract with their Go types.
+func (c *{{.Contract}}Client) RegisterEvents(registry *sdkcadence.EventRegistry) error {
+{{- range .Events}}
+	if err := registry.Register(c.EventType("{{.Identifier}}"), {{.Name}}{}); err != nil {
+		return err
+	}
+{{- end}}
+	return nil
+}
+{{range .Getters}}
+{{template "script" .}}
+{{end}}
+{{- range .Scripts}}
+{{template "script" .}}
+{{end}}
+{{- range .Transactions}}
+{{template "doc" .}}
+func (c *{{.Receiver}}) {{.Name}}({{range $i, $p := .Parameters}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) (*flow.Transaction, error) {
+	code, err := c.resolver.Resolve([]byte({{.Constant}}))
+	if err != nil {
+		return nil, err
+	}
+{{template "args" .}}
+	tx := flow.NewTransaction().SetScript(code)
+	for _, arg := range args {
+		if err := tx.AddArgument(arg); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+{{end}}
+func (c *{{.Contract}}Client) executeScript(ctx context.Context, script string, args []cadence.Value, result interface{}) error {
+	code, err := c.resolver.Resolve([]byte(script))
+	if err != nil {
+		return err
+	}
+
+	return access.ExecuteScriptAtLatestBlockInto(ctx, c.client, code, args, result)
+}
+{{range .Getters}}
+const {{.Constant}} = {{quote .Code}}
+{{end}}
+{{- range .Scripts}}
+const {{.Constant}} = {{quote .Code}}
+{{end}}
+{{- range .Transactions}}
+const {{.Constant}} = {{quote .Code}}
+{{end}}
+{{- define "doc"}}// {{.Summary}}{{if .Doc}}
+//{{range .Doc}}
+//{{if .}} {{.}}{{end}}{{end}}{{end}}{{end}}
+
+{{- define "struct"}}{{template "doc" .}}
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`" + `cadence:"{{.Identifier}}"` + "`" + `
+{{- end}}
+}{{end}}
+
+{{- define "script"}}{{template "doc" .}}
+func (c *{{.Receiver}}) {{.Name}}(ctx context.Context{{range .Parameters}}, {{.Name}} {{.Type}}{{end}}) ({{.ReturnType}}, error) {
+	var result {{.ReturnType}}
+{{template "args" .}}
+	if err := c.executeScript(ctx, {{.Constant}}, args, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}{{end}}
+
+{{- define "args"}}
+	args := make([]cadence.Value, 0, {{len .Parameters}})
+{{- $zero := .Zero}}
+{{- range .Parameters}}
+{{- if .Fallible}}
+	{{.Name}}Value, err := {{.Expression}}
+	if err != nil {
+		return {{$zero}}, fmt.Errorf("invalid argument {{.Identifier}}: %w", err)
+	}
+	args = append(args, {{.Name}}Value)
+{{- else}}
+	args = append(args, {{.Expression}})
+{{- end}}
+{{- end}}
+{{end}}
+`))
@@ -0,0 +1,86 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/cadence/codegen"
+)
+
+func readFile(t *testing.T, path string) []byte {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return content
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("Bindings", func(t *testing.T) {
+		code, err := codegen.Generate(codegen.Config{
+			Package:  "exampletoken",
+			Contract: readFile(t, "testdata/ExampleToken.cdc"),
+			Scripts: map[string][]byte{
+				"get_balance": readFile(t, "testdata/get_balance.cdc"),
+			},
+			Transactions: map[string][]byte{
+				"transfer_tokens": readFile(t, "testdata/transfer_tokens.cdc"),
+			},
+		})
+		require.NoError(t, err)
+
+		// the generated bindings are checked in, so they are compiled and tested with the rest of the tree
+		assert.Equal(t, string(readFile(t, "internal/exampletoken/exampletoken.go")), string(code),
+			"bindings are out of date, run go generate ./cadence/codegen/...")
+	})
+
+	t.Run("Missing package", func(t *testing.T) {
+		_, err := codegen.Generate(codegen.Config{Contract: readFile(t, "testdata/ExampleToken.cdc")})
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid contract", func(t *testing.T) {
+		_, err := codegen.Generate(codegen.Config{Package: "test", Contract: []byte("pub contract {")})
+		assert.Error(t, err)
+
+		_, err = codegen.Generate(codegen.Config{Package: "test", Contract: []byte("pub fun main() {}")})
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid transaction", func(t *testing.T) {
+		_, err := codegen.Generate(codegen.Config{
+			Package:      "test",
+			Contract:     readFile(t, "testdata/ExampleToken.cdc"),
+			Transactions: map[string][]byte{"script": readFile(t, "testdata/get_balance.cdc")},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid script", func(t *testing.T) {
+		_, err := codegen.Generate(codegen.Config{
+			Package:  "test",
+			Contract: readFile(t, "testdata/ExampleToken.cdc"),
+			Scripts:  map[string][]byte{"transaction": readFile(t, "testdata/transfer_tokens.cdc")},
+		})
+		assert.Error(t, err)
+	})
+}
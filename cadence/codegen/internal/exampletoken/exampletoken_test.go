@@ -0,0 +1,115 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exampletoken_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/cadence/codegen/internal/exampletoken"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// scriptClient is a client stub returning the same value for all scripts.
+type scriptClient struct {
+	access.Client
+	value     cadence.Value
+	script    []byte
+	arguments []cadence.Value
+}
+
+func (c *scriptClient) ExecuteScriptAtLatestBlock(_ context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	c.script = script
+	c.arguments = arguments
+	return c.value, nil
+}
+
+var (
+	contractAddress = flow.HexToAddress("01")
+	tokenAddress    = flow.HexToAddress("02")
+)
+
+func TestExampleTokenClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Getter", func(t *testing.T) {
+		holderType := &cadence.StructType{
+			QualifiedIdentifier: "ExampleToken.Holder",
+			Fields: []cadence.Field{
+				{Identifier: "address", Type: cadence.AddressType{}},
+				{Identifier: "balance", Type: cadence.UFix64Type{}},
+				{Identifier: "tags", Type: cadence.NewVariableSizedArrayType(cadence.StringType{})},
+			},
+		}
+		balance, err := cadence.NewUFix64("1.5")
+		require.NoError(t, err)
+
+		stub := &scriptClient{value: cadence.NewOptional(cadence.NewStruct([]cadence.Value{
+			cadence.NewAddress(tokenAddress),
+			balance,
+			cadence.NewArray([]cadence.Value{cadence.String("whale")}),
+		}).WithType(holderType))}
+		client := exampletoken.NewExampleTokenClient(stub, contractAddress, nil)
+
+		holder, err := client.Holder(ctx, tokenAddress, "1.0", 10)
+		require.NoError(t, err)
+		assert.Equal(t, &exampletoken.Holder{Address: tokenAddress, Balance: "1.50000000", Tags: []string{"whale"}}, holder)
+
+		assert.True(t, strings.HasPrefix(string(stub.script), "import ExampleToken from 0x0000000000000001"))
+		require.Len(t, stub.arguments, 3)
+		assert.Equal(t, cadence.NewAddress(tokenAddress), stub.arguments[0])
+		assert.Equal(t, cadence.NewWord8(10), stub.arguments[2])
+
+		_, err = client.Holder(ctx, tokenAddress, "not a number", 10)
+		assert.Error(t, err)
+	})
+
+	t.Run("Transaction", func(t *testing.T) {
+		client := exampletoken.NewExampleTokenClient(&scriptClient{}, contractAddress, templates.ContractAddresses{
+			"FungibleToken": tokenAddress,
+		})
+
+		tx, err := client.NewTransferTokensTransaction("10.0", tokenAddress, "vault")
+		require.NoError(t, err)
+		assert.Contains(t, string(tx.Script), "import FungibleToken from 0x0000000000000002")
+		assert.Contains(t, string(tx.Script), "import ExampleToken from 0x0000000000000001")
+		assert.Len(t, tx.Arguments, 3)
+
+		_, err = exampletoken.NewExampleTokenClient(&scriptClient{}, contractAddress, nil).
+			NewTransferTokensTransaction("10.0", tokenAddress, "vault")
+		assert.Error(t, err)
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		client := exampletoken.NewExampleTokenClient(&scriptClient{}, contractAddress, nil)
+
+		var registry sdkcadence.EventRegistry
+		require.NoError(t, client.RegisterEvents(&registry))
+		assert.True(t, registry.Registered("A.0000000000000001.ExampleToken.TokensDeposited"))
+		assert.True(t, registry.Registered(client.EventType("TokensMinted")))
+	})
+}
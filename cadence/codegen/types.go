@@ -0,0 +1,207 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// valueType is the Go type of Cadence values that don't have a more specific Go type.
+const valueType = "cadence.Value"
+
+// fixedSizeIntegers are the Cadence integer types with the Go integer type they are decoded into.
+var fixedSizeIntegers = map[string]string{
+	"Int8":   "int8",
+	"Int16":  "int16",
+	"Int32":  "int32",
+	"Int64":  "int64",
+	"UInt8":  "uint8",
+	"UInt16": "uint16",
+	"UInt32": "uint32",
+	"UInt64": "uint64",
+	"Word8":  "uint8",
+	"Word16": "uint16",
+	"Word32": "uint32",
+	"Word64": "uint64",
+}
+
+// bigIntegers are the arbitrary precision Cadence integer types, decoded into *big.Int, with the
+// constructor of their Cadence value and whether the constructor returns an error.
+var bigIntegers = map[string]struct {
+	constructor string
+	fallible    bool
+}{
+	"Int":     {"cadence.NewIntFromBig", false},
+	"UInt":    {"cadence.NewUIntFromBig", true},
+	"Int128":  {"cadence.NewInt128FromBig", true},
+	"Int256":  {"cadence.NewInt256FromBig", true},
+	"UInt128": {"cadence.NewUInt128FromBig", true},
+	"UInt256": {"cadence.NewUInt256FromBig", true},
+}
+
+var stringTypes = map[string]bool{
+	"String":         true,
+	"Character":      true,
+	"Path":           true,
+	"StoragePath":    true,
+	"PublicPath":     true,
+	"PrivatePath":    true,
+	"CapabilityPath": true,
+}
+
+// typeMapper maps the Cadence types used by a contract to Go types.
+type typeMapper struct {
+	contract string
+	// declared are the names of the types declared by the contract.
+	declared map[string]bool
+	// structs are the Go types of the structs declared by the contract.
+	structs map[string]string
+	// usesBig is set once a type is mapped to *big.Int.
+	usesBig bool
+}
+
+// goType returns the Go type Cadence values of the type are decoded into.
+func (m *typeMapper) goType(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := m.nominalName(t)
+		if goType, ok := fixedSizeIntegers[name]; ok {
+			return goType
+		}
+		if _, ok := bigIntegers[name]; ok {
+			m.usesBig = true
+			return "*big.Int"
+		}
+		if goType, ok := m.structs[name]; ok {
+			return goType
+		}
+		switch {
+		case stringTypes[name], name == "UFix64", name == "Fix64":
+			return "string"
+		case name == "Bool":
+			return "bool"
+		case name == "Address":
+			return "flow.Address"
+		}
+	case *ast.OptionalType:
+		elem := m.goType(t.Type)
+		if elem == valueType || strings.HasPrefix(elem, "*") {
+			return elem
+		}
+		return "*" + elem
+	case *ast.VariableSizedType:
+		return "[]" + m.goType(t.Type)
+	case *ast.ConstantSizedType:
+		if t.Size != nil && t.Size.Value != nil {
+			return fmt.Sprintf("[%s]%s", t.Size.Value, m.goType(t.Type))
+		}
+	case *ast.DictionaryType:
+		key := m.goType(t.KeyType)
+		if key == valueType || strings.HasPrefix(key, "*") {
+			return valueType
+		}
+		return fmt.Sprintf("map[%s]%s", key, m.goType(t.ValueType))
+	}
+
+	return valueType
+}
+
+// argument returns the Go type of arguments of the type, with the expression converting the Go value
+// of the named variable to a Cadence value and whether the expression returns an error.
+func (m *typeMapper) argument(t ast.Type, variable string) (goType string, expression string, fallible bool) {
+	if m.marshalable(t) {
+		return m.goType(t), fmt.Sprintf("sdkcadence.Marshal(%s)", variable), true
+	}
+
+	if t, ok := t.(*ast.NominalType); ok {
+		name := m.nominalName(t)
+		switch name {
+		case "UFix64", "Fix64":
+			return "string", fmt.Sprintf("cadence.New%s(%s)", name, variable), true
+		case "Word8", "Word16", "Word32", "Word64":
+			return fixedSizeIntegers[name], fmt.Sprintf("cadence.New%s(%s)", name, variable), false
+		}
+		if integer, ok := bigIntegers[name]; ok {
+			m.usesBig = true
+			return "*big.Int", fmt.Sprintf("%s(%s)", integer.constructor, variable), integer.fallible
+		}
+	}
+
+	return valueType, variable, false
+}
+
+// marshalable returns true if the Go values of the type are encoded to the Cadence type by sdkcadence.Marshal.
+func (m *typeMapper) marshalable(t ast.Type) bool {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := m.nominalName(t)
+		if strings.HasPrefix(name, "Word") {
+			return false
+		}
+		_, integer := fixedSizeIntegers[name]
+		return integer || name == "String" || name == "Bool" || name == "Address"
+	case *ast.OptionalType:
+		return m.marshalable(t.Type)
+	case *ast.VariableSizedType:
+		return m.marshalable(t.Type)
+	case *ast.DictionaryType:
+		return m.marshalable(t.KeyType) && m.marshalable(t.ValueType)
+	}
+	return false
+}
+
+// cadenceType returns the Cadence type as written in scripts importing the contract, qualifying the
+// types declared by the contract with its name.
+func (m *typeMapper) cadenceType(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := m.nominalName(t)
+		if m.declared[name] {
+			return m.contract + "." + name
+		}
+		return name
+	case *ast.OptionalType:
+		return m.cadenceType(t.Type) + "?"
+	case *ast.VariableSizedType:
+		return "[" + m.cadenceType(t.Type) + "]"
+	case *ast.ConstantSizedType:
+		if t.Size != nil && t.Size.Value != nil {
+			return fmt.Sprintf("[%s; %s]", m.cadenceType(t.Type), t.Size.Value)
+		}
+	case *ast.DictionaryType:
+		return fmt.Sprintf("{%s: %s}", m.cadenceType(t.KeyType), m.cadenceType(t.ValueType))
+	}
+	return t.String()
+}
+
+// nominalName returns the name of the type, without the contract name for the types declared by the contract.
+func (m *typeMapper) nominalName(t *ast.NominalType) string {
+	identifiers := []string{t.Identifier.Identifier}
+	for _, nested := range t.NestedIdentifiers {
+		identifiers = append(identifiers, nested.Identifier)
+	}
+
+	if len(identifiers) > 1 && identifiers[0] == m.contract {
+		identifiers = identifiers[1:]
+	}
+	return strings.Join(identifiers, ".")
+}
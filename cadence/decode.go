@@ -0,0 +1,424 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cadence maps Cadence values to Go values and back.
+//
+// Unmarshal decodes script results and event fields into Go structs, slices, maps and primitives,
+// matching the fields of Cadence composites with the `cadence` tags of the struct fields:
+//
+//	type Vault struct {
+//		Balance string       `cadence:"balance"`
+//		Owner   flow.Address `cadence:"owner"`
+//	}
+//
+//	var vault Vault
+//	err := cadence.Unmarshal(result, &vault)
+//
+// The package is named after the Cadence values it works with, so it is usually imported with an
+// alias next to the github.com/onflow/cadence package.
+package cadence
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// tagName is the name of the struct field tag holding the Cadence field name.
+const tagName = "cadence"
+
+var (
+	addressType    = reflect.TypeOf(flow.Address{})
+	bigIntType     = reflect.TypeOf(big.Int{})
+	cadenceValues  = reflect.TypeOf((*cadence.Value)(nil)).Elem()
+	emptyInterface = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// Unmarshal decodes a Cadence value into the Go value pointed to by target.
+//
+// Values are decoded as follows:
+//   - Composites (structs, resources, events, contracts and enums) into structs, matching each Cadence
+//     field with the struct field tagged with its name, or with the same name ignoring case if there
+//     is no tag. Fields tagged with "-" are skipped, and fields missing on either side are ignored.
+//   - Arrays into slices and arrays, and dictionaries into maps.
+//   - Optionals into pointers, nil optionals leaving the target to its zero value. Optionals are also
+//     decoded into non-pointer targets when they are not nil.
+//   - Integers into Go integers, failing if the value overflows, and into big.Int.
+//   - Fixed-point numbers into strings, holding their exact decimal representation, and into float64.
+//   - Strings and characters into strings, booleans into bools and addresses into flow.Address
+//     and into strings, such as "0xf8d6e0586b0a20c7".
+//   - Paths into strings, such as "/storage/flowTokenVault".
+//
+// Any value can be decoded into a Cadence value type of the same type, such as cadence.UFix64, and into
+// an empty interface which holds composites as map[string]interface{}, dictionaries as
+// map[interface{}]interface{} and arrays as []interface{}. Enum dictionary keys are held as their
+// Cadence string representation, such as "Color(rawValue: 1)", since maps can't be keys.
+func Unmarshal(value cadence.Value, target interface{}) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("cadence: Unmarshal target must be a non-nil pointer, got %T", target)
+	}
+
+	if err := decode(value, v.Elem()); err != nil {
+		return fmt.Errorf("cadence: %w", err)
+	}
+	return nil
+}
+
+func decode(value cadence.Value, dst reflect.Value) error {
+	if value == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	// Cadence value targets
+	if dst.Type() != emptyInterface && dst.Type().Implements(cadenceValues) || dst.Type() == cadenceValues {
+		v := reflect.ValueOf(value)
+		if !v.Type().AssignableTo(dst.Type()) {
+			return fmt.Errorf("can't decode %s into %s", value.Type().ID(), dst.Type())
+		}
+		dst.Set(v)
+		return nil
+	}
+
+	if dst.Kind() == reflect.Interface {
+		if dst.NumMethod() != 0 {
+			return fmt.Errorf("can't decode %s into %s", value.Type().ID(), dst.Type())
+		}
+		goValue := toGoValue(value)
+		if goValue == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+		} else {
+			dst.Set(reflect.ValueOf(goValue))
+		}
+		return nil
+	}
+
+	if optional, ok := value.(cadence.Optional); ok {
+		if optional.Value == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		return decode(optional.Value, dst)
+	}
+
+	if dst.Kind() == reflect.Pointer {
+		elem := reflect.New(dst.Type().Elem())
+		if err := decode(value, elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	switch v := value.(type) {
+	case cadence.Bool:
+		if dst.Kind() == reflect.Bool {
+			dst.SetBool(bool(v))
+			return nil
+		}
+	case cadence.String:
+		if dst.Kind() == reflect.String {
+			dst.SetString(string(v))
+			return nil
+		}
+	case cadence.Character:
+		if dst.Kind() == reflect.String {
+			dst.SetString(string(v))
+			return nil
+		}
+	case cadence.Path:
+		if dst.Kind() == reflect.String {
+			dst.SetString(v.String())
+			return nil
+		}
+	case cadence.Address:
+		if dst.Type() == addressType {
+			dst.Set(reflect.ValueOf(flow.Address(v)))
+			return nil
+		}
+		if dst.Kind() == reflect.String {
+			dst.SetString(v.String())
+			return nil
+		}
+	case cadence.UFix64, cadence.Fix64:
+		return decodeFixedPoint(value, dst)
+	case cadence.Array:
+		return decodeArray(v, dst)
+	case cadence.Dictionary:
+		return decodeDictionary(v, dst)
+	}
+
+	if integer, ok := integerValue(value); ok {
+		return decodeInteger(value, integer, dst)
+	}
+
+	if compositeType, fields, ok := compositeFields(value); ok && dst.Kind() == reflect.Struct {
+		return decodeComposite(compositeType, fields, dst)
+	}
+
+	return fmt.Errorf("can't decode %s into %s", value.Type().ID(), dst.Type())
+}
+
+func decodeInteger(value cadence.Value, integer *big.Int, dst reflect.Value) error {
+	overflow := fmt.Errorf("value %s of type %s overflows %s", integer, value.Type().ID(), dst.Type())
+
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !integer.IsInt64() || dst.OverflowInt(integer.Int64()) {
+			return overflow
+		}
+		dst.SetInt(integer.Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !integer.IsUint64() || dst.OverflowUint(integer.Uint64()) {
+			return overflow
+		}
+		dst.SetUint(integer.Uint64())
+		return nil
+	case reflect.String:
+		dst.SetString(integer.String())
+		return nil
+	}
+
+	if dst.Type() == bigIntType {
+		dst.Set(reflect.ValueOf(*new(big.Int).Set(integer)))
+		return nil
+	}
+
+	return fmt.Errorf("can't decode %s into %s", value.Type().ID(), dst.Type())
+}
+
+func decodeFixedPoint(value cadence.Value, dst reflect.Value) error {
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(value.String())
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, _, err := big.ParseFloat(value.String(), 10, 64, big.ToNearestEven)
+		if err != nil {
+			return err
+		}
+		f64, _ := f.Float64()
+		if dst.Kind() == reflect.Float32 && math.Abs(f64) > math.MaxFloat32 {
+			return fmt.Errorf("value %s overflows %s", value, dst.Type())
+		}
+		dst.SetFloat(f64)
+		return nil
+	}
+
+	return fmt.Errorf("can't decode %s into %s", value.Type().ID(), dst.Type())
+}
+
+func decodeArray(array cadence.Array, dst reflect.Value) error {
+	switch dst.Kind() {
+	case reflect.Slice:
+		slice := reflect.MakeSlice(dst.Type(), len(array.Values), len(array.Values))
+		for i, element := range array.Values {
+			if err := decode(element, slice.Index(i)); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		dst.Set(slice)
+		return nil
+	case reflect.Array:
+		if dst.Len() != len(array.Values) {
+			return fmt.Errorf("can't decode array of %d elements into %s", len(array.Values), dst.Type())
+		}
+		for i, element := range array.Values {
+			if err := decode(element, dst.Index(i)); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("can't decode %s into %s", array.Type().ID(), dst.Type())
+}
+
+func decodeDictionary(dictionary cadence.Dictionary, dst reflect.Value) error {
+	if dst.Kind() != reflect.Map {
+		return fmt.Errorf("can't decode %s into %s", dictionary.Type().ID(), dst.Type())
+	}
+
+	m := reflect.MakeMapWithSize(dst.Type(), len(dictionary.Pairs))
+	for _, pair := range dictionary.Pairs {
+		key := reflect.New(dst.Type().Key()).Elem()
+		if err := decode(pair.Key, key); err != nil {
+			return fmt.Errorf("key %s: %w", pair.Key, err)
+		}
+
+		value := reflect.New(dst.Type().Elem()).Elem()
+		if err := decode(pair.Value, value); err != nil {
+			return fmt.Errorf("value of key %s: %w", pair.Key, err)
+		}
+
+		m.SetMapIndex(key, value)
+	}
+	dst.Set(m)
+	return nil
+}
+
+func decodeComposite(compositeType cadence.CompositeType, fields []cadence.Value, dst reflect.Value) error {
+	fieldTypes := compositeType.CompositeFields()
+
+	for i := 0; i < dst.NumField(); i++ {
+		structField := dst.Type().Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name, ok := fieldName(structField)
+		if !ok {
+			continue
+		}
+
+		for j, fieldType := range fieldTypes {
+			if j >= len(fields) {
+				break
+			}
+			if fieldType.Identifier == name || !hasTag(structField) && strings.EqualFold(fieldType.Identifier, name) {
+				if err := decode(fields[j], dst.Field(i)); err != nil {
+					return fmt.Errorf("field %s: %w", fieldType.Identifier, err)
+				}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// fieldName returns the Cadence field name of the struct field, and false if the field is skipped.
+func fieldName(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup(tagName)
+	if !ok {
+		return field.Name, true
+	}
+
+	name := strings.Split(tag, ",")[0]
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		return field.Name, true
+	}
+	return name, true
+}
+
+func hasTag(field reflect.StructField) bool {
+	tag, ok := field.Tag.Lookup(tagName)
+	return ok && strings.Split(tag, ",")[0] != ""
+}
+
+// compositeFields returns the type and the field values of composite values.
+func compositeFields(value cadence.Value) (cadence.CompositeType, []cadence.Value, bool) {
+	switch v := value.(type) {
+	case cadence.Struct:
+		return v.StructType, v.Fields, v.StructType != nil
+	case cadence.Resource:
+		return v.ResourceType, v.Fields, v.ResourceType != nil
+	case cadence.Event:
+		return v.EventType, v.Fields, v.EventType != nil
+	case cadence.Contract:
+		return v.ContractType, v.Fields, v.ContractType != nil
+	case cadence.Enum:
+		return v.EnumType, v.Fields, v.EnumType != nil
+	}
+	return nil, nil, false
+}
+
+// integerValue returns the value of Cadence integers.
+func integerValue(value cadence.Value) (*big.Int, bool) {
+	switch v := value.ToGoValue().(type) {
+	case *big.Int:
+		return v, true
+	case int8:
+		return big.NewInt(int64(v)), true
+	case int16:
+		return big.NewInt(int64(v)), true
+	case int32:
+		return big.NewInt(int64(v)), true
+	case int64:
+		return big.NewInt(v), true
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), true
+	case uint64:
+		return new(big.Int).SetUint64(v), true
+	}
+	return nil, false
+}
+
+// toGoValue converts a Cadence value to the Go value held by empty interface targets.
+func toGoValue(value cadence.Value) interface{} {
+	switch v := value.(type) {
+	case cadence.Optional:
+		if v.Value == nil {
+			return nil
+		}
+		return toGoValue(v.Value)
+	case cadence.Address:
+		return flow.Address(v)
+	case cadence.UFix64, cadence.Fix64, cadence.Path:
+		return v.String()
+	case cadence.Array:
+		values := make([]interface{}, len(v.Values))
+		for i, element := range v.Values {
+			values[i] = toGoValue(element)
+		}
+		return values
+	case cadence.Dictionary:
+		m := make(map[interface{}]interface{}, len(v.Pairs))
+		for _, pair := range v.Pairs {
+			m[toGoKey(pair.Key)] = toGoValue(pair.Value)
+		}
+		return m
+	}
+
+	if compositeType, fields, ok := compositeFields(value); ok {
+		m := make(map[string]interface{}, len(fields))
+		for i, field := range compositeType.CompositeFields() {
+			if i < len(fields) {
+				m[field.Identifier] = toGoValue(fields[i])
+			}
+		}
+		return m
+	}
+
+	return value.ToGoValue()
+}
+
+// toGoKey converts a Cadence dictionary key to a Go map key. Keys which don't convert to a comparable
+// Go value, like enums which convert to maps, are converted to their Cadence string representation.
+func toGoKey(value cadence.Value) interface{} {
+	key := toGoValue(value)
+	if key != nil && !reflect.TypeOf(key).Comparable() {
+		return value.String()
+	}
+	return key
+}
@@ -0,0 +1,172 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+type testNFT struct {
+	ID       uint64            `cadence:"id"`
+	Name     string            `cadence:"name"`
+	Price    string            `cadence:"price"`
+	Owner    *flow.Address     `cadence:"owner"`
+	Tags     []string          `cadence:"tags"`
+	Royalty  map[string]uint8  `cadence:"royalties"`
+	Rarity   *big.Int          `cadence:"rarity"`
+	Metadata interface{}       `cadence:"metadata"`
+	Ignored  string            `cadence:"-"`
+	Raw      cadence.UFix64    `cadence:"price"`
+	Missing  string            `cadence:"missing"`
+	Extra    map[string]string `cadence:"extra"`
+}
+
+func newTestNFT(t *testing.T, owner cadence.Value) cadence.Struct {
+	price, err := cadence.NewUFix64("12.50000000")
+	require.NoError(t, err)
+
+	metadataType := &cadence.StructType{
+		QualifiedIdentifier: "Metadata",
+		Fields:              []cadence.Field{{Identifier: "edition", Type: cadence.UInt8Type{}}},
+	}
+
+	return cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt64(42),
+		cadence.String("Flow Punk"),
+		price,
+		owner,
+		cadence.NewArray([]cadence.Value{cadence.String("rare"), cadence.String("punk")}),
+		cadence.NewDictionary([]cadence.KeyValuePair{
+			{Key: cadence.String("creator"), Value: cadence.NewUInt8(5)},
+		}),
+		cadence.NewInt(7),
+		cadence.NewStruct([]cadence.Value{cadence.NewUInt8(1)}).WithType(metadataType),
+		cadence.NewOptional(nil),
+	}).WithType(&cadence.StructType{
+		QualifiedIdentifier: "NFT",
+		Fields: []cadence.Field{
+			{Identifier: "id", Type: cadence.UInt64Type{}},
+			{Identifier: "name", Type: cadence.StringType{}},
+			{Identifier: "price", Type: cadence.UFix64Type{}},
+			{Identifier: "owner", Type: &cadence.OptionalType{Type: cadence.AddressType{}}},
+			{Identifier: "tags", Type: &cadence.VariableSizedArrayType{ElementType: cadence.StringType{}}},
+			{Identifier: "royalties", Type: &cadence.DictionaryType{KeyType: cadence.StringType{}, ElementType: cadence.UInt8Type{}}},
+			{Identifier: "rarity", Type: cadence.IntType{}},
+			{Identifier: "metadata", Type: metadataType},
+			{Identifier: "extra", Type: &cadence.OptionalType{Type: &cadence.DictionaryType{KeyType: cadence.StringType{}, ElementType: cadence.StringType{}}}},
+		},
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	owner := flow.HexToAddress("01")
+
+	t.Run("Struct", func(t *testing.T) {
+		value := newTestNFT(t, cadence.NewOptional(cadence.NewAddress(owner)))
+
+		var nft testNFT
+		require.NoError(t, sdkcadence.Unmarshal(value, &nft))
+
+		assert.Equal(t, uint64(42), nft.ID)
+		assert.Equal(t, "Flow Punk", nft.Name)
+		assert.Equal(t, "12.50000000", nft.Price)
+		assert.Equal(t, &owner, nft.Owner)
+		assert.Equal(t, []string{"rare", "punk"}, nft.Tags)
+		assert.Equal(t, map[string]uint8{"creator": 5}, nft.Royalty)
+		assert.Equal(t, big.NewInt(7), nft.Rarity)
+		assert.Equal(t, map[string]interface{}{"edition": uint8(1)}, nft.Metadata)
+		assert.Equal(t, cadence.UFix64(1_250_000_000), nft.Raw)
+		assert.Empty(t, nft.Ignored)
+		assert.Empty(t, nft.Missing)
+		assert.Nil(t, nft.Extra)
+	})
+
+	t.Run("Nil optional", func(t *testing.T) {
+		value := newTestNFT(t, cadence.NewOptional(nil))
+
+		var nft testNFT
+		require.NoError(t, sdkcadence.Unmarshal(value, &nft))
+		assert.Nil(t, nft.Owner)
+	})
+
+	t.Run("Untagged fields", func(t *testing.T) {
+		value := newTestNFT(t, cadence.NewOptional(nil))
+
+		var nft struct {
+			ID   uint64
+			Name string
+		}
+		require.NoError(t, sdkcadence.Unmarshal(value, &nft))
+		assert.Equal(t, uint64(42), nft.ID)
+		assert.Equal(t, "Flow Punk", nft.Name)
+	})
+
+	t.Run("Primitives", func(t *testing.T) {
+		var i int8
+		require.NoError(t, sdkcadence.Unmarshal(cadence.NewInt(-12), &i))
+		assert.Equal(t, int8(-12), i)
+
+		var f float64
+		require.NoError(t, sdkcadence.Unmarshal(cadence.UFix64(150_000_000), &f))
+		assert.Equal(t, 1.5, f)
+
+		var path string
+		require.NoError(t, sdkcadence.Unmarshal(cadence.NewPath("storage", "flowTokenVault"), &path))
+		assert.Equal(t, "/storage/flowTokenVault", path)
+
+		var addresses [1]flow.Address
+		require.NoError(t, sdkcadence.Unmarshal(cadence.NewArray([]cadence.Value{cadence.NewAddress(owner)}), &addresses))
+		assert.Equal(t, owner, addresses[0])
+	})
+
+	t.Run("Enum dictionary keys", func(t *testing.T) {
+		color := cadence.NewEnum([]cadence.Value{cadence.NewUInt8(1)}).WithType(&cadence.EnumType{
+			QualifiedIdentifier: "Color",
+			RawType:             cadence.UInt8Type{},
+			Fields:              []cadence.Field{{Identifier: "rawValue", Type: cadence.UInt8Type{}}},
+		})
+		value := cadence.NewDictionary([]cadence.KeyValuePair{{Key: color, Value: cadence.String("green")}})
+
+		var m interface{}
+		require.NoError(t, sdkcadence.Unmarshal(value, &m))
+		assert.Equal(t, map[interface{}]interface{}{color.String(): "green"}, m)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		var u uint8
+		assert.Error(t, sdkcadence.Unmarshal(cadence.NewInt(256), &u))
+		assert.Error(t, sdkcadence.Unmarshal(cadence.NewInt(-1), &u))
+
+		var s string
+		assert.Error(t, sdkcadence.Unmarshal(cadence.NewBool(true), &s))
+		assert.Error(t, sdkcadence.Unmarshal(cadence.NewBool(true), s))
+
+		var nft testNFT
+		err := sdkcadence.Unmarshal(newTestNFT(t, cadence.NewOptional(cadence.String("not an address"))), &nft)
+		assert.ErrorContains(t, err, "field owner")
+	})
+}
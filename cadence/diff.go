@@ -0,0 +1,192 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+)
+
+// A Difference is a difference between two Cadence values, see Diff.
+type Difference struct {
+	// Path is the location of the difference in the values, such as "owner.tags[1]" or `royalties["artist"]`.
+	// The path is empty if the values themselves differ.
+	Path string
+	// A is the value of the first value at the path, nil if the path doesn't exist in the first value.
+	A cadence.Value
+	// B is the value of the second value at the path, nil if the path doesn't exist in the second value.
+	B cadence.Value
+}
+
+func (d Difference) String() string {
+	path := d.Path
+	if path == "" {
+		path = "value"
+	}
+	a, b := formatInline(d.A), formatInline(d.B)
+	if a == b {
+		// the values differ by their types
+		a = fmt.Sprintf("%s (%s)", a, typeID(d.A))
+		b = fmt.Sprintf("%s (%s)", b, typeID(d.B))
+	}
+	return fmt.Sprintf("%s: %s != %s", path, a, b)
+}
+
+// Diff returns the structural differences between two values, as paths to the nested values that differ.
+//
+// Composites are compared field by field, arrays element by element and dictionaries entry by entry,
+// dictionary entries being matched by key. Values of different types always differ, and the other values
+// are compared by their Cadence representation. No differences are returned if the values are equal.
+func Diff(a cadence.Value, b cadence.Value) []Difference {
+	var differences []Difference
+	diff(a, b, "", &differences)
+	return differences
+}
+
+// DiffString returns the differences between two values, one difference per line.
+func DiffString(a cadence.Value, b cadence.Value) string {
+	differences := Diff(a, b)
+
+	lines := make([]string, len(differences))
+	for i, difference := range differences {
+		lines[i] = difference.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func diff(a cadence.Value, b cadence.Value, path string, differences *[]Difference) {
+	different := func() {
+		*differences = append(*differences, Difference{Path: path, A: a, B: b})
+	}
+
+	if a == nil || b == nil {
+		if a != nil || b != nil {
+			different()
+		}
+		return
+	}
+
+	if typeID(a) != typeID(b) {
+		different()
+		return
+	}
+
+	switch a := a.(type) {
+	case cadence.Optional:
+		b := b.(cadence.Optional)
+		if a.Value == nil || b.Value == nil {
+			if a.Value != nil || b.Value != nil {
+				different()
+			}
+			return
+		}
+		diff(a.Value, b.Value, path, differences)
+	case cadence.Array:
+		b := b.(cadence.Array)
+		for i := 0; i < len(a.Values) || i < len(b.Values); i++ {
+			diff(element(a.Values, i), element(b.Values, i), fmt.Sprintf("%s[%d]", path, i), differences)
+		}
+	case cadence.Dictionary:
+		b := b.(cadence.Dictionary)
+		entries := make(map[string]cadence.Value, len(b.Pairs))
+		for _, pair := range b.Pairs {
+			entries[pair.Key.String()] = pair.Value
+		}
+		for _, pair := range sortedPairs(a) {
+			key := pair.Key.String()
+			diff(pair.Value, entries[key], fmt.Sprintf("%s[%s]", path, key), differences)
+			delete(entries, key)
+		}
+		for _, pair := range sortedPairs(b) {
+			if value, ok := entries[pair.Key.String()]; ok {
+				diff(nil, value, fmt.Sprintf("%s[%s]", path, pair.Key), differences)
+			}
+		}
+	default:
+		aType, aFields, ok := compositeFields(a)
+		if !ok {
+			if a.String() != b.String() {
+				different()
+			}
+			return
+		}
+
+		bType, bFields, _ := compositeFields(b)
+		bValues := make(map[string]cadence.Value, len(bFields))
+		for i, field := range bType.CompositeFields() {
+			bValues[field.Identifier] = element(bFields, i)
+		}
+		for i, field := range aType.CompositeFields() {
+			diff(element(aFields, i), bValues[field.Identifier], fieldPath(path, field.Identifier), differences)
+			delete(bValues, field.Identifier)
+		}
+		for _, field := range bType.CompositeFields() {
+			if value, ok := bValues[field.Identifier]; ok {
+				diff(nil, value, fieldPath(path, field.Identifier), differences)
+			}
+		}
+	}
+}
+
+// typeID returns the ID of the type of the value, without failing on composites without type.
+func typeID(value cadence.Value) string {
+	if compositeType, _, ok := compositeFields(value); ok {
+		return compositeType.ID()
+	}
+	switch value.(type) {
+	case cadence.Struct, cadence.Resource, cadence.Event, cadence.Contract, cadence.Enum:
+		return fmt.Sprintf("%T", value)
+	case cadence.Optional:
+		// nil optionals have the never type, so optionals are compared by their inner values
+		return "Optional"
+	case cadence.Array:
+		// arrays and dictionaries are compared by their elements, which may be created without type
+		return "Array"
+	case cadence.Dictionary:
+		return "Dictionary"
+	}
+	if value.Type() == nil {
+		return fmt.Sprintf("%T", value)
+	}
+	return value.Type().ID()
+}
+
+func element(values []cadence.Value, i int) cadence.Value {
+	if i < len(values) {
+		return values[i]
+	}
+	return nil
+}
+
+func fieldPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// formatInline formats the value on a single line.
+func formatInline(value cadence.Value) string {
+	if value == nil {
+		return "<missing>"
+	}
+	return value.String()
+}
@@ -0,0 +1,311 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+var bigIntPointerType = reflect.TypeOf(&big.Int{})
+
+// A TypeIDer is a Go struct type mapped to a Cadence composite type.
+//
+// Marshal uses the type ID, such as "A.0ae53cb6e3f42a79.FlowToken.Vault", as the type of the Cadence structs
+// encoded from values of the Go type. The type ID is the name of the Go type if it doesn't implement the interface.
+type TypeIDer interface {
+	CadenceTypeID() string
+}
+
+// Marshal encodes a Go value as a Cadence value, to be used as a script or transaction argument.
+//
+// Structs are encoded as Cadence structs with a field for each exported struct field, named by its `cadence` tag
+// or by the field name. Fields tagged with "-" are skipped. Pointers, slices, arrays and maps are encoded as
+// optionals, arrays and dictionaries, and other values are converted with flow.ToCadenceValue.
+//
+// The Cadence type of a field can be overridden with a tag option, such as `cadence:"amount,ufix64"`:
+//   - ufix64 and fix64 encode strings and floats as fixed-point numbers, floats being rounded to 8 decimals.
+//   - int, uint, int8 to int256, uint8 to uint256 and word8 to word64 encode Go integers and decimal strings
+//     as the Cadence integer type, failing if the value is out of range.
+//   - address encodes hex strings as addresses.
+func Marshal(v interface{}) (cadence.Value, error) {
+	if v == nil {
+		return cadence.NewOptional(nil), nil
+	}
+
+	value, err := encode(reflect.ValueOf(v))
+	if err != nil {
+		return nil, fmt.Errorf("cadence: %w", err)
+	}
+	return value, nil
+}
+
+func encode(v reflect.Value) (cadence.Value, error) {
+	if isLeaf(v.Type()) {
+		return flow.ToCadenceValue(v.Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return cadence.NewOptional(nil), nil
+		}
+		return encode(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return cadence.NewOptional(nil), nil
+		}
+		value, err := encode(v.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptional(value), nil
+	case reflect.Slice, reflect.Array:
+		values := make([]cadence.Value, v.Len())
+		for i := range values {
+			value, err := encode(v.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			values[i] = value
+		}
+		return cadence.NewArray(values), nil
+	case reflect.Map:
+		pairs := make([]cadence.KeyValuePair, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := encode(iter.Key())
+			if err != nil {
+				return nil, fmt.Errorf("key %v: %w", iter.Key(), err)
+			}
+			value, err := encode(iter.Value())
+			if err != nil {
+				return nil, fmt.Errorf("value of key %v: %w", iter.Key(), err)
+			}
+			pairs = append(pairs, cadence.KeyValuePair{Key: key, Value: value})
+		}
+		// sort the pairs so the encoded dictionary doesn't depend on the map iteration order
+		sort.Slice(pairs, func(i, j int) bool {
+			return pairs[i].Key.String() < pairs[j].Key.String()
+		})
+		return cadence.NewDictionary(pairs), nil
+	case reflect.Struct:
+		return encodeStruct(v)
+	}
+
+	return flow.ToCadenceValue(v.Interface())
+}
+
+// isLeaf returns true for the types converted by flow.ToCadenceValue without recursion.
+func isLeaf(t reflect.Type) bool {
+	if t.Kind() != reflect.Interface && t.Implements(cadenceValues) {
+		return true
+	}
+	return t == addressType || t == bigIntPointerType
+}
+
+func encodeStruct(v reflect.Value) (cadence.Value, error) {
+	if v.Type() == bigIntType {
+		b := v.Interface().(big.Int)
+		return cadence.NewIntFromBig(&b), nil
+	}
+
+	structType := &cadence.StructType{QualifiedIdentifier: v.Type().Name()}
+	if typeIDer, ok := v.Interface().(TypeIDer); ok {
+		location, qualifiedIdentifier, err := common.DecodeTypeID(nil, typeIDer.CadenceTypeID())
+		if err != nil {
+			return nil, fmt.Errorf("invalid type ID %s: %w", typeIDer.CadenceTypeID(), err)
+		}
+		structType.Location = location
+		structType.QualifiedIdentifier = qualifiedIdentifier
+	}
+
+	fields := make([]cadence.Value, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name, ok := fieldName(structField)
+		if !ok {
+			continue
+		}
+
+		var value cadence.Value
+		var err error
+		if override := fieldTypeOverride(structField); override != "" {
+			value, err = encodeOverride(override, v.Field(i))
+		} else {
+			value, err = encode(v.Field(i))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+
+		fields = append(fields, value)
+		structType.Fields = append(structType.Fields, cadence.Field{Identifier: name, Type: value.Type()})
+	}
+
+	return cadence.NewStruct(fields).WithType(structType), nil
+}
+
+// fieldTypeOverride returns the Cadence type option of the struct field tag, if any.
+func fieldTypeOverride(field reflect.StructField) string {
+	options := strings.Split(field.Tag.Get(tagName), ",")
+	if len(options) < 2 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(options[1]))
+}
+
+// encodeOverride encodes the value as the Cadence type of a tag option. Pointers are encoded as optionals.
+func encodeOverride(override string, v reflect.Value) (cadence.Value, error) {
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return cadence.NewOptional(nil), nil
+		}
+		value, err := encodeOverride(override, v.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptional(value), nil
+	}
+
+	switch override {
+	case "ufix64", "fix64":
+		s, err := decimalString(v)
+		if err != nil {
+			return nil, err
+		}
+		if override == "ufix64" {
+			return cadence.NewUFix64(s)
+		}
+		return cadence.NewFix64(s)
+	case "address":
+		if v.Kind() != reflect.String {
+			return nil, fmt.Errorf("can't encode %s as an address", v.Type())
+		}
+		return cadence.NewAddress(flow.HexToAddress(v.String())), nil
+	}
+
+	newInteger, ok := integerConstructors[override]
+	if !ok {
+		return nil, fmt.Errorf("unsupported type override %s", override)
+	}
+
+	integer, err := integerOf(v)
+	if err != nil {
+		return nil, err
+	}
+	return newInteger(integer)
+}
+
+// decimalString returns the decimal representation of strings and floats.
+func decimalString(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', 8, 64), nil
+	}
+	return "", fmt.Errorf("can't encode %s as a fixed-point number", v.Type())
+}
+
+// integerOf returns the value of Go integers, big integers and decimal strings.
+func integerOf(v reflect.Value) (*big.Int, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(v.Uint()), nil
+	case reflect.String:
+		integer, ok := new(big.Int).SetString(v.String(), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", v.String())
+		}
+		return integer, nil
+	}
+
+	if v.Type() == bigIntType {
+		b := v.Interface().(big.Int)
+		return &b, nil
+	}
+	return nil, fmt.Errorf("can't encode %s as an integer", v.Type())
+}
+
+// integerConstructors create the Cadence integers of the type overrides, checking the range of the value.
+var integerConstructors = map[string]func(*big.Int) (cadence.Value, error){
+	"int": func(i *big.Int) (cadence.Value, error) { return cadence.NewIntFromBig(i), nil },
+	"uint": func(i *big.Int) (cadence.Value, error) {
+		return cadence.NewUIntFromBig(i)
+	},
+	"int8":  fixedSizeInteger(8, true, func(i *big.Int) cadence.Value { return cadence.NewInt8(int8(i.Int64())) }),
+	"int16": fixedSizeInteger(16, true, func(i *big.Int) cadence.Value { return cadence.NewInt16(int16(i.Int64())) }),
+	"int32": fixedSizeInteger(32, true, func(i *big.Int) cadence.Value { return cadence.NewInt32(int32(i.Int64())) }),
+	"int64": fixedSizeInteger(64, true, func(i *big.Int) cadence.Value { return cadence.NewInt64(i.Int64()) }),
+	"int128": func(i *big.Int) (cadence.Value, error) {
+		return cadence.NewInt128FromBig(i)
+	},
+	"int256": func(i *big.Int) (cadence.Value, error) {
+		return cadence.NewInt256FromBig(i)
+	},
+	"uint8":  fixedSizeInteger(8, false, func(i *big.Int) cadence.Value { return cadence.NewUInt8(uint8(i.Uint64())) }),
+	"uint16": fixedSizeInteger(16, false, func(i *big.Int) cadence.Value { return cadence.NewUInt16(uint16(i.Uint64())) }),
+	"uint32": fixedSizeInteger(32, false, func(i *big.Int) cadence.Value { return cadence.NewUInt32(uint32(i.Uint64())) }),
+	"uint64": fixedSizeInteger(64, false, func(i *big.Int) cadence.Value { return cadence.NewUInt64(i.Uint64()) }),
+	"uint128": func(i *big.Int) (cadence.Value, error) {
+		return cadence.NewUInt128FromBig(i)
+	},
+	"uint256": func(i *big.Int) (cadence.Value, error) {
+		return cadence.NewUInt256FromBig(i)
+	},
+	"word8":  fixedSizeInteger(8, false, func(i *big.Int) cadence.Value { return cadence.NewWord8(uint8(i.Uint64())) }),
+	"word16": fixedSizeInteger(16, false, func(i *big.Int) cadence.Value { return cadence.NewWord16(uint16(i.Uint64())) }),
+	"word32": fixedSizeInteger(32, false, func(i *big.Int) cadence.Value { return cadence.NewWord32(uint32(i.Uint64())) }),
+	"word64": fixedSizeInteger(64, false, func(i *big.Int) cadence.Value { return cadence.NewWord64(i.Uint64()) }),
+}
+
+// fixedSizeInteger returns a constructor checking the integer fits in the given number of bits.
+func fixedSizeInteger(bits uint, signed bool, newValue func(*big.Int) cadence.Value) func(*big.Int) (cadence.Value, error) {
+	var min, max *big.Int
+	if signed {
+		max = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits-1), big.NewInt(1))
+		min = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), bits-1))
+	} else {
+		max = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+		min = big.NewInt(0)
+	}
+
+	return func(i *big.Int) (cadence.Value, error) {
+		if i.Cmp(min) < 0 || i.Cmp(max) > 0 {
+			return nil, fmt.Errorf("value %s is out of range of %d bits integers", i, bits)
+		}
+		return newValue(i), nil
+	}
+}
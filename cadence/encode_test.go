@@ -0,0 +1,167 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+type testListing struct {
+	ID        uint64           `cadence:"id"`
+	Seller    string           `cadence:"seller,address"`
+	Price     string           `cadence:"price,ufix64"`
+	Cut       float64          `cadence:"cut,ufix64"`
+	Supply    string           `cadence:"supply,uint64"`
+	Buyer     *flow.Address    `cadence:"buyer"`
+	Tags      []string         `cadence:"tags"`
+	Royalties map[string]uint8 `cadence:"royalties"`
+	Ignored   string           `cadence:"-"`
+	Expiry    *uint64          `cadence:"expiry,uint32"`
+	internal  string
+	Extra     map[string]string `cadence:"extra"`
+}
+
+func (testListing) CadenceTypeID() string {
+	return "A.0000000000000001.Marketplace.Listing"
+}
+
+func TestMarshal(t *testing.T) {
+	t.Run("Primitives", func(t *testing.T) {
+		value, err := sdkcadence.Marshal(uint64(42))
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewUInt64(42), value)
+
+		value, err = sdkcadence.Marshal("hello")
+		require.NoError(t, err)
+		assert.Equal(t, cadence.String("hello"), value)
+
+		value, err = sdkcadence.Marshal(nil)
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewOptional(nil), value)
+	})
+
+	t.Run("Collections", func(t *testing.T) {
+		value, err := sdkcadence.Marshal([]int8{1, 2})
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewArray([]cadence.Value{cadence.NewInt8(1), cadence.NewInt8(2)}), value)
+
+		value, err = sdkcadence.Marshal(map[string]bool{"b": false, "a": true})
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewDictionary([]cadence.KeyValuePair{
+			{Key: cadence.String("a"), Value: cadence.NewBool(true)},
+			{Key: cadence.String("b"), Value: cadence.NewBool(false)},
+		}), value)
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		buyer := flow.HexToAddress("02")
+		listing := testListing{
+			ID:        7,
+			Seller:    "0x01",
+			Price:     "10.5",
+			Cut:       0.025,
+			Supply:    "18446744073709551615",
+			Buyer:     &buyer,
+			Tags:      []string{"rare"},
+			Royalties: map[string]uint8{"artist": 5},
+			Ignored:   "ignored",
+		}
+
+		value, err := sdkcadence.Marshal(listing)
+		require.NoError(t, err)
+
+		s, ok := value.(cadence.Struct)
+		require.True(t, ok)
+		assert.Equal(t, "A.0000000000000001.Marketplace.Listing", s.StructType.ID())
+
+		identifiers := make([]string, len(s.StructType.Fields))
+		for i, field := range s.StructType.Fields {
+			identifiers[i] = field.Identifier
+		}
+		assert.Equal(t, []string{
+			"id", "seller", "price", "cut", "supply", "buyer", "tags", "royalties", "expiry", "extra",
+		}, identifiers)
+
+		assert.Equal(t, cadence.NewAddress(flow.HexToAddress("01")), s.Fields[1])
+		assert.Equal(t, "10.50000000", s.Fields[2].String())
+		assert.Equal(t, "0.02500000", s.Fields[3].String())
+		assert.Equal(t, cadence.NewUInt64(18446744073709551615), s.Fields[4])
+		assert.Equal(t, cadence.NewOptional(nil), s.Fields[8])
+
+		var decoded testListing
+		require.NoError(t, sdkcadence.Unmarshal(value, &decoded))
+		assert.Equal(t, listing.ID, decoded.ID)
+		assert.Equal(t, "10.50000000", decoded.Price)
+		assert.Equal(t, listing.Supply, decoded.Supply)
+		assert.Equal(t, listing.Buyer, decoded.Buyer)
+		assert.Equal(t, listing.Tags, decoded.Tags)
+		assert.Equal(t, listing.Royalties, decoded.Royalties)
+		assert.Empty(t, decoded.Ignored)
+	})
+
+	t.Run("Optional override", func(t *testing.T) {
+		expiry := uint64(100)
+		value, err := sdkcadence.Marshal(testListing{Price: "1.0", Supply: "1", Expiry: &expiry})
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewOptional(cadence.NewUInt32(100)), value.(cadence.Struct).Fields[8])
+	})
+
+	t.Run("Big integers", func(t *testing.T) {
+		value, err := sdkcadence.Marshal(big.NewInt(-5))
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewInt(-5), value)
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		_, err := sdkcadence.Marshal(struct {
+			Value string `cadence:"value,uint64"`
+		}{Value: "18446744073709551616"})
+		assert.Error(t, err)
+
+		_, err = sdkcadence.Marshal(struct {
+			Value int `cadence:"value,uint8"`
+		}{Value: -1})
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid override", func(t *testing.T) {
+		_, err := sdkcadence.Marshal(struct {
+			Value string `cadence:"value,ufix64"`
+		}{Value: "not a number"})
+		assert.Error(t, err)
+
+		_, err = sdkcadence.Marshal(struct {
+			Value bool `cadence:"value,uint64"`
+		}{})
+		assert.Error(t, err)
+
+		_, err = sdkcadence.Marshal(struct {
+			Value string `cadence:"value,unknown"`
+		}{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,121 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// ErrUnregisteredEvent is returned when decoding an event of a type that is not registered.
+var ErrUnregisteredEvent = errors.New("cadence: unregistered event type")
+
+// DecodeEvent decodes the fields of the event into a value of type T, as Unmarshal.
+//
+// If T implements TypeIDer, an error is returned if the event is not of the type T is mapped to.
+//
+//	type Deposit struct {
+//		ID uint64        `cadence:"id"`
+//		To *flow.Address `cadence:"to"`
+//	}
+//
+//	deposit, err := cadence.DecodeEvent[Deposit](event)
+func DecodeEvent[T any](event flow.Event) (T, error) {
+	var value T
+	if typeIDer, ok := interface{}(value).(TypeIDer); ok && typeIDer.CadenceTypeID() != event.Type {
+		return value, fmt.Errorf("cadence: can't decode event %s into %T mapped to %s", event.Type, value, typeIDer.CadenceTypeID())
+	}
+
+	if err := decode(event.Value, reflect.ValueOf(&value).Elem()); err != nil {
+		return value, fmt.Errorf("cadence: failed to decode event %s: %w", event.Type, err)
+	}
+	return value, nil
+}
+
+// An EventRegistry maps event types to the Go types their events are decoded into.
+//
+// Registries allow consumers of event streams to decode events of different types without
+// switching over the event types. The zero value is an empty registry ready to use, and
+// registries are safe for concurrent use.
+type EventRegistry struct {
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+}
+
+// Register maps the event type, such as "A.0b2a3299cc857e29.TopShot.Deposit", to the type of
+// the prototype value. An empty event type registers the type ID of prototypes implementing TypeIDer.
+//
+// Registering an event type again replaces its Go type.
+func (r *EventRegistry) Register(eventType string, prototype interface{}) error {
+	if prototype == nil {
+		return fmt.Errorf("cadence: can't register event %s with a nil prototype", eventType)
+	}
+
+	if eventType == "" {
+		typeIDer, ok := prototype.(TypeIDer)
+		if !ok {
+			return fmt.Errorf("cadence: event type is required to register %T", prototype)
+		}
+		eventType = typeIDer.CadenceTypeID()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.types == nil {
+		r.types = make(map[string]reflect.Type)
+	}
+	r.types[eventType] = reflect.TypeOf(prototype)
+	return nil
+}
+
+// Registered returns true if the event type is registered.
+func (r *EventRegistry) Registered(eventType string) bool {
+	_, ok := r.lookup(eventType)
+	return ok
+}
+
+// Decode decodes the event into a new value of the Go type registered for its event type.
+//
+// The returned value has the type of the registered prototype, so it can be used in type switches.
+// ErrUnregisteredEvent is returned if the event type is not registered.
+func (r *EventRegistry) Decode(event flow.Event) (interface{}, error) {
+	t, ok := r.lookup(event.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnregisteredEvent, event.Type)
+	}
+
+	value := reflect.New(t)
+	if err := decode(event.Value, value.Elem()); err != nil {
+		return nil, fmt.Errorf("cadence: failed to decode event %s: %w", event.Type, err)
+	}
+	return value.Elem().Interface(), nil
+}
+
+func (r *EventRegistry) lookup(eventType string) (reflect.Type, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.types[eventType]
+	return t, ok
+}
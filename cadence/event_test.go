@@ -0,0 +1,84 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+type fooEvent struct {
+	A int    `cadence:"a"`
+	B string `cadence:"b"`
+}
+
+type typedFooEvent struct {
+	A int `cadence:"a"`
+}
+
+func (typedFooEvent) CadenceTypeID() string {
+	return "S.test.FooEvent1"
+}
+
+func TestDecodeEvent(t *testing.T) {
+	events := test.EventGenerator()
+	event := events.New()
+
+	decoded, err := sdkcadence.DecodeEvent[fooEvent](event)
+	require.NoError(t, err)
+	assert.Equal(t, fooEvent{A: 1, B: "foo"}, decoded)
+
+	typed, err := sdkcadence.DecodeEvent[typedFooEvent](event)
+	require.NoError(t, err)
+	assert.Equal(t, typedFooEvent{A: 1}, typed)
+
+	_, err = sdkcadence.DecodeEvent[typedFooEvent](events.New())
+	assert.Error(t, err)
+}
+
+func TestEventRegistry(t *testing.T) {
+	events := test.EventGenerator()
+	first := events.New()
+	second := events.New()
+
+	var registry sdkcadence.EventRegistry
+	require.NoError(t, registry.Register("", typedFooEvent{}))
+	require.NoError(t, registry.Register(second.Type, &fooEvent{}))
+	assert.True(t, registry.Registered(first.Type))
+
+	value, err := registry.Decode(first)
+	require.NoError(t, err)
+	assert.Equal(t, typedFooEvent{A: 1}, value)
+
+	value, err = registry.Decode(second)
+	require.NoError(t, err)
+	assert.Equal(t, &fooEvent{A: 2, B: "foo"}, value)
+
+	_, err = registry.Decode(events.New())
+	assert.True(t, errors.Is(err, sdkcadence.ErrUnregisteredEvent))
+
+	assert.Error(t, registry.Register("", fooEvent{}))
+	assert.Error(t, registry.Register("A.0000000000000001.Foo.Bar", nil))
+}
@@ -0,0 +1,204 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// indent is the indentation of nested values in formatted values.
+const indent = "    "
+
+// Format renders the value as indented, human-readable text close to the Cadence syntax:
+//
+//	A.0b2a3299cc857e29.TopShot.Moment(
+//	    id: 42,
+//	    tags: [
+//	        "rare"
+//	    ]
+//	)
+//
+// Dictionary entries are sorted by key so the output of equal dictionaries is the same.
+func Format(value cadence.Value) string {
+	var b strings.Builder
+	format(&b, value, "")
+	return b.String()
+}
+
+// FormatEvent renders the event as Format, preceded by its type and the transaction it was emitted from.
+func FormatEvent(event flow.Event) string {
+	return fmt.Sprintf(
+		"%s (transaction %s, event %d)\n%s",
+		event.Type,
+		event.TransactionID,
+		event.EventIndex,
+		Format(event.Value),
+	)
+}
+
+func format(b *strings.Builder, value cadence.Value, prefix string) {
+	if value == nil {
+		b.WriteString("nil")
+		return
+	}
+
+	switch v := value.(type) {
+	case cadence.Optional:
+		format(b, v.Value, prefix)
+	case cadence.Array:
+		formatList(b, "[", "]", len(v.Values), prefix, func(i int, prefix string) {
+			format(b, v.Values[i], prefix)
+		})
+	case cadence.Dictionary:
+		pairs := sortedPairs(v)
+		formatList(b, "{", "}", len(pairs), prefix, func(i int, prefix string) {
+			format(b, pairs[i].Key, prefix)
+			b.WriteString(": ")
+			format(b, pairs[i].Value, prefix)
+		})
+	default:
+		compositeType, fields, ok := compositeFields(value)
+		if !ok {
+			b.WriteString(value.String())
+			return
+		}
+
+		b.WriteString(compositeType.ID())
+		formatList(b, "(", ")", len(fields), prefix, func(i int, prefix string) {
+			if i < len(compositeType.CompositeFields()) {
+				b.WriteString(compositeType.CompositeFields()[i].Identifier)
+				b.WriteString(": ")
+			}
+			format(b, fields[i], prefix)
+		})
+	}
+}
+
+// formatList writes the elements between the delimiters, one element per line.
+func formatList(b *strings.Builder, open string, close string, n int, prefix string, element func(i int, prefix string)) {
+	b.WriteString(open)
+	if n == 0 {
+		b.WriteString(close)
+		return
+	}
+
+	for i := 0; i < n; i++ {
+		b.WriteString("\n")
+		b.WriteString(prefix + indent)
+		element(i, prefix+indent)
+		if i < n-1 {
+			b.WriteString(",")
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(prefix)
+	b.WriteString(close)
+}
+
+// sortedPairs returns the pairs of the dictionary sorted by key.
+func sortedPairs(dictionary cadence.Dictionary) []cadence.KeyValuePair {
+	pairs := append([]cadence.KeyValuePair(nil), dictionary.Pairs...)
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Key.String() < pairs[j].Key.String()
+	})
+	return pairs
+}
+
+// CanonicalJSON encodes the value as indented JSON-CDC, with the entries of dictionaries sorted by key,
+// so equal values are encoded the same regardless of the order of their dictionary entries.
+func CanonicalJSON(value cadence.Value) ([]byte, error) {
+	encoded, err := jsoncdc.Encode(value)
+	if err != nil {
+		return nil, fmt.Errorf("cadence: failed to encode value: %w", err)
+	}
+
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("cadence: failed to decode JSON-CDC: %w", err)
+	}
+
+	if err := sortDictionaries(decoded); err != nil {
+		return nil, err
+	}
+
+	// object keys are sorted when marshaling maps
+	return json.MarshalIndent(decoded, "", "  ")
+}
+
+// sortDictionaries sorts the entries of the JSON-CDC dictionaries of the decoded JSON by their encoded key.
+func sortDictionaries(decoded interface{}) error {
+	switch v := decoded.(type) {
+	case []interface{}:
+		for _, element := range v {
+			if err := sortDictionaries(element); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for _, element := range v {
+			if err := sortDictionaries(element); err != nil {
+				return err
+			}
+		}
+
+		entries, ok := v["value"].([]interface{})
+		if v["type"] != "Dictionary" || !ok {
+			return nil
+		}
+
+		keys := make([]string, len(entries))
+		for i, entry := range entries {
+			pair, ok := entry.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("cadence: invalid JSON-CDC dictionary entry")
+			}
+			key, err := json.Marshal(pair["key"])
+			if err != nil {
+				return err
+			}
+			keys[i] = string(key)
+		}
+
+		sort.Sort(entriesByKey{entries, keys})
+	}
+	return nil
+}
+
+type entriesByKey struct {
+	entries []interface{}
+	keys    []string
+}
+
+func (e entriesByKey) Len() int           { return len(e.entries) }
+func (e entriesByKey) Less(i, j int) bool { return e.keys[i] < e.keys[j] }
+func (e entriesByKey) Swap(i, j int) {
+	e.entries[i], e.entries[j] = e.entries[j], e.entries[i]
+	e.keys[i], e.keys[j] = e.keys[j], e.keys[i]
+}
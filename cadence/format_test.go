@@ -0,0 +1,144 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+var momentType = &cadence.StructType{
+	QualifiedIdentifier: "Moment",
+	Fields: []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type{}},
+		{Identifier: "owner", Type: cadence.NewOptionalType(cadence.AddressType{})},
+		{Identifier: "tags", Type: cadence.NewVariableSizedArrayType(cadence.StringType{})},
+		{Identifier: "royalties", Type: cadence.NewDictionaryType(cadence.StringType{}, cadence.UInt8Type{})},
+	},
+}
+
+func newMoment(id uint64, owner cadence.Value, tags []string, royalties map[string]uint8, order []string) cadence.Struct {
+	tagValues := make([]cadence.Value, len(tags))
+	for i, tag := range tags {
+		tagValues[i] = cadence.String(tag)
+	}
+
+	pairs := make([]cadence.KeyValuePair, 0, len(order))
+	for _, key := range order {
+		pairs = append(pairs, cadence.KeyValuePair{Key: cadence.String(key), Value: cadence.NewUInt8(royalties[key])})
+	}
+
+	return cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt64(id),
+		cadence.NewOptional(owner),
+		cadence.NewArray(tagValues),
+		cadence.NewDictionary(pairs),
+	}).WithType(momentType)
+}
+
+func TestFormat(t *testing.T) {
+	moment := newMoment(
+		42,
+		cadence.NewAddress(flow.HexToAddress("01")),
+		[]string{"rare"},
+		map[string]uint8{"b": 2, "a": 1},
+		[]string{"b", "a"},
+	)
+
+	assert.Equal(t, `Moment(
+    id: 42,
+    owner: 0x0000000000000001,
+    tags: [
+        "rare"
+    ],
+    royalties: {
+        "a": 1,
+        "b": 2
+    }
+)`, sdkcadence.Format(moment))
+
+	assert.Equal(t, "nil", sdkcadence.Format(cadence.NewOptional(nil)))
+	assert.Equal(t, "[]", sdkcadence.Format(cadence.NewArray(nil)))
+
+	event := test.EventGenerator().New()
+	assert.Contains(t, sdkcadence.FormatEvent(event), "S.test.FooEvent1 (transaction ")
+	assert.Contains(t, sdkcadence.FormatEvent(event), "    a: 1,\n")
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	royalties := map[string]uint8{"b": 2, "a": 1}
+	first := newMoment(1, nil, nil, royalties, []string{"a", "b"})
+	second := newMoment(1, nil, nil, royalties, []string{"b", "a"})
+
+	firstJSON, err := sdkcadence.CanonicalJSON(first)
+	require.NoError(t, err)
+	secondJSON, err := sdkcadence.CanonicalJSON(second)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(firstJSON), string(secondJSON))
+	assert.Equal(t, string(firstJSON), string(secondJSON))
+}
+
+func TestDiff(t *testing.T) {
+	owner := cadence.NewAddress(flow.HexToAddress("01"))
+
+	t.Run("Equal", func(t *testing.T) {
+		a := newMoment(1, owner, []string{"rare"}, map[string]uint8{"a": 1, "b": 2}, []string{"a", "b"})
+		b := newMoment(1, owner, []string{"rare"}, map[string]uint8{"a": 1, "b": 2}, []string{"b", "a"})
+
+		assert.Empty(t, sdkcadence.Diff(a, b))
+		assert.Empty(t, sdkcadence.DiffString(a, b))
+	})
+
+	t.Run("Different", func(t *testing.T) {
+		a := newMoment(1, owner, []string{"rare", "gold"}, map[string]uint8{"a": 1, "b": 2}, []string{"a", "b"})
+		b := newMoment(2, nil, []string{"rare"}, map[string]uint8{"a": 3, "c": 4}, []string{"a", "c"})
+
+		assert.Equal(t, []string{
+			"id: 1 != 2",
+			"owner: 0x0000000000000001 != nil",
+			`tags[1]: "gold" != <missing>`,
+			`royalties["a"]: 1 != 3`,
+			`royalties["b"]: 2 != <missing>`,
+			`royalties["c"]: <missing> != 4`,
+		}, diffLines(sdkcadence.Diff(a, b)))
+	})
+
+	t.Run("Different types", func(t *testing.T) {
+		differences := sdkcadence.Diff(cadence.NewUInt64(1), cadence.NewUInt32(1))
+		require.Len(t, differences, 1)
+		assert.Equal(t, "", differences[0].Path)
+		assert.Equal(t, "value: 1 (UInt64) != 1 (UInt32)", differences[0].String())
+	})
+}
+
+func diffLines(differences []sdkcadence.Difference) []string {
+	lines := make([]string, len(differences))
+	for i, difference := range differences {
+		lines[i] = difference.String()
+	}
+	return lines
+}
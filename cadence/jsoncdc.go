@@ -0,0 +1,203 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+)
+
+// An UnsupportedValueError is returned when converting a value of a kind that can't be represented
+// by the Cadence version used by the SDK, such as the attachments and inclusive ranges of newer versions.
+//
+// Values that can't be converted are rejected with this error rather than being converted partially.
+type UnsupportedValueError struct {
+	// Kind is the JSON-CDC type of the value, such as "Attachment".
+	Kind string
+	// Reason explains why values of a supported kind can't be converted, if the kind itself is supported.
+	Reason string
+}
+
+func (e *UnsupportedValueError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("cadence: unsupported %s value: %s", e.Kind, e.Reason)
+	}
+	return fmt.Sprintf("cadence: unsupported value kind %s", e.Kind)
+}
+
+// jsonValueKinds are the JSON-CDC value types decoded into Cadence values.
+var jsonValueKinds = map[string]bool{
+	"Void":       true,
+	"Optional":   true,
+	"Bool":       true,
+	"Character":  true,
+	"String":     true,
+	"Address":    true,
+	"Int":        true,
+	"Int8":       true,
+	"Int16":      true,
+	"Int32":      true,
+	"Int64":      true,
+	"Int128":     true,
+	"Int256":     true,
+	"UInt":       true,
+	"UInt8":      true,
+	"UInt16":     true,
+	"UInt32":     true,
+	"UInt64":     true,
+	"UInt128":    true,
+	"UInt256":    true,
+	"Word8":      true,
+	"Word16":     true,
+	"Word32":     true,
+	"Word64":     true,
+	"Fix64":      true,
+	"UFix64":     true,
+	"Array":      true,
+	"Dictionary": true,
+	"Struct":     true,
+	"Resource":   true,
+	"Event":      true,
+	"Contract":   true,
+	"Link":       true,
+	"Path":       true,
+	"Type":       true,
+	"Capability": true,
+	"Enum":       true,
+}
+
+// EncodeJSONCDC encodes the value in JSON-CDC.
+//
+// An UnsupportedValueError is returned if the value contains values that have no JSON-CDC encoding.
+func EncodeJSONCDC(value cadence.Value) ([]byte, error) {
+	if err := checkEncodable(value); err != nil {
+		return nil, err
+	}
+
+	b, err := jsoncdc.Encode(value)
+	if err != nil {
+		return nil, fmt.Errorf("cadence: %w", err)
+	}
+	return b, nil
+}
+
+// DecodeJSONCDC decodes a value encoded in JSON-CDC, such as a script result or an event payload.
+//
+// An UnsupportedValueError is returned if the encoding contains values of kinds that can't be
+// represented, instead of the generic error of the JSON-CDC decoder.
+func DecodeJSONCDC(b []byte, options ...jsoncdc.Option) (cadence.Value, error) {
+	value, err := jsoncdc.Decode(nil, b, options...)
+	if err != nil {
+		if unsupported := findUnsupportedValue(b); unsupported != nil {
+			return nil, unsupported
+		}
+		return nil, fmt.Errorf("cadence: failed to decode JSON-CDC value: %w", err)
+	}
+	return value, nil
+}
+
+// checkEncodable returns an UnsupportedValueError if the value contains values without JSON-CDC encoding.
+func checkEncodable(value cadence.Value) error {
+	switch v := value.(type) {
+	case cadence.Bytes:
+		return &UnsupportedValueError{Kind: "Bytes", Reason: "bytes values only exist at runtime"}
+	case cadence.Optional:
+		if v.Value != nil {
+			return checkEncodable(v.Value)
+		}
+	case cadence.Array:
+		for _, element := range v.Values {
+			if err := checkEncodable(element); err != nil {
+				return err
+			}
+		}
+	case cadence.Dictionary:
+		for _, pair := range v.Pairs {
+			if err := checkEncodable(pair.Key); err != nil {
+				return err
+			}
+			if err := checkEncodable(pair.Value); err != nil {
+				return err
+			}
+		}
+	default:
+		_, fields, _ := compositeFields(value)
+		for _, field := range fields {
+			if err := checkEncodable(field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// findUnsupportedValue returns an UnsupportedValueError for the first value of the JSON-CDC
+// encoding that can't be decoded, or nil if all the values are supported.
+func findUnsupportedValue(b []byte) *UnsupportedValueError {
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil
+	}
+	return unsupportedValue(decoded)
+}
+
+func unsupportedValue(decoded interface{}) *UnsupportedValueError {
+	switch v := decoded.(type) {
+	case []interface{}:
+		for _, element := range v {
+			if unsupported := unsupportedValue(element); unsupported != nil {
+				return unsupported
+			}
+		}
+	case map[string]interface{}:
+		// value objects have a type name and a value, type objects have a kind instead
+		kind, isString := v["type"].(string)
+		_, hasValue := v["value"]
+		if isString && (hasValue || kind == "Void") {
+			if !jsonValueKinds[kind] {
+				return &UnsupportedValueError{Kind: kind}
+			}
+			if capability, ok := v["value"].(map[string]interface{}); ok && kind == "Capability" {
+				if _, ok := capability["path"]; !ok {
+					return &UnsupportedValueError{Kind: kind, Reason: "capabilities without path are not supported"}
+				}
+			}
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if unsupported := unsupportedValue(v[key]); unsupported != nil {
+				return unsupported
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+func TestJSONCDC(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		path := cadence.Path{Domain: "storage", Identifier: "flowTokenVault"}
+		address := cadence.BytesToAddress([]byte{1})
+
+		values := []cadence.Value{
+			cadence.NewCapability(path, address, cadence.ReferenceType{Type: cadence.AnyStructType{}}),
+			path,
+			cadence.NewLink(path, "&AnyStruct"),
+			cadence.NewTypeValue(cadence.StringType{}),
+			cadence.NewArray([]cadence.Value{cadence.NewOptional(cadence.NewUInt8(1))}),
+		}
+
+		for _, value := range values {
+			encoded, err := sdkcadence.EncodeJSONCDC(value)
+			require.NoError(t, err)
+
+			decoded, err := sdkcadence.DecodeJSONCDC(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, value, decoded)
+		}
+	})
+
+	t.Run("Unsupported kinds", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			encoded string
+			kind    string
+		}{
+			{
+				name:    "Attachment",
+				encoded: `{"type":"Struct","value":{"id":"S.test.Foo","fields":[{"name":"bar","value":{"type":"Attachment","value":{"id":"S.test.Bar","fields":[]}}}]}}`,
+				kind:    "Attachment",
+			},
+			{
+				name:    "Inclusive range",
+				encoded: `{"type":"Array","value":[{"type":"InclusiveRange","value":{"start":{"type":"Int","value":"1"},"end":{"type":"Int","value":"9"},"step":{"type":"Int","value":"1"}}}]}`,
+				kind:    "InclusiveRange",
+			},
+			{
+				name:    "ID capability",
+				encoded: `{"type":"Capability","value":{"id":"1","address":"0x0000000000000001","borrowType":{"kind":"Int"}}}`,
+				kind:    "Capability",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := sdkcadence.DecodeJSONCDC([]byte(test.encoded))
+
+				var unsupported *sdkcadence.UnsupportedValueError
+				require.True(t, errors.As(err, &unsupported), "unexpected error %v", err)
+				assert.Equal(t, test.kind, unsupported.Kind)
+			})
+		}
+	})
+
+	t.Run("Invalid encoding", func(t *testing.T) {
+		_, err := sdkcadence.DecodeJSONCDC([]byte(`{"type":"Int","value":"not a number"}`))
+		require.Error(t, err)
+
+		var unsupported *sdkcadence.UnsupportedValueError
+		assert.False(t, errors.As(err, &unsupported))
+	})
+
+	t.Run("Unsupported value", func(t *testing.T) {
+		_, err := sdkcadence.EncodeJSONCDC(cadence.NewArray([]cadence.Value{cadence.NewBytes([]byte{1})}))
+
+		var unsupported *sdkcadence.UnsupportedValueError
+		require.True(t, errors.As(err, &unsupported))
+		assert.Equal(t, "Bytes", unsupported.Kind)
+	})
+}
@@ -0,0 +1,192 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+)
+
+// A JSONDecoder decodes JSON-CDC values from a stream.
+//
+// Script results are encoded in JSON-CDC, and can be large enough that holding both their encoding
+// and the decoded value in memory is a concern. The decoder reads the encoding from the stream as
+// it decodes it, and arrays can be decoded one element at a time with DecodeArray.
+//
+// Script results returned by the HTTP API are base64 encoded, so they must be decoded from a
+// base64 decoder reading the response:
+//
+//	decoder := cadence.NewJSONDecoder(base64.NewDecoder(base64.StdEncoding, r))
+type JSONDecoder struct {
+	decoder *json.Decoder
+	options []jsoncdc.Option
+}
+
+// NewJSONDecoder creates a decoder reading from the reader, decoding values with the JSON-CDC options.
+func NewJSONDecoder(r io.Reader, options ...jsoncdc.Option) *JSONDecoder {
+	return &JSONDecoder{
+		decoder: json.NewDecoder(r),
+		options: options,
+	}
+}
+
+// Decode decodes the next value of the stream.
+func (d *JSONDecoder) Decode() (cadence.Value, error) {
+	var raw json.RawMessage
+	if err := d.decoder.Decode(&raw); err != nil {
+		return nil, d.wrapError(err)
+	}
+	return d.decodeRaw(raw)
+}
+
+// DecodeArray starts decoding the next value of the stream, which must be an array, and returns an
+// iterator over its elements. The elements are decoded as they are read by the iterator.
+//
+// The decoder must not be used until the iterator returns io.EOF.
+func (d *JSONDecoder) DecodeArray() (*ArrayIterator, error) {
+	if err := d.expectDelim('{'); err != nil {
+		return nil, err
+	}
+
+	var valueType string
+	for d.decoder.More() {
+		key, err := d.decoder.Token()
+		if err != nil {
+			return nil, d.wrapError(err)
+		}
+
+		switch key {
+		case "type":
+			if err := d.decoder.Decode(&valueType); err != nil {
+				return nil, d.wrapError(err)
+			}
+			if valueType != "Array" {
+				return nil, fmt.Errorf("cadence: can't decode %s value as an array", valueType)
+			}
+		case "value":
+			if valueType == "" {
+				// the type of the value is required to know how to decode it
+				return nil, fmt.Errorf("cadence: JSON-CDC array value must be preceded by its type")
+			}
+			if err := d.expectDelim('['); err != nil {
+				return nil, err
+			}
+			return &ArrayIterator{decoder: d}, nil
+		default:
+			var ignored json.RawMessage
+			if err := d.decoder.Decode(&ignored); err != nil {
+				return nil, d.wrapError(err)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("cadence: JSON-CDC value is missing its array elements")
+}
+
+func (d *JSONDecoder) decodeRaw(raw json.RawMessage) (cadence.Value, error) {
+	return DecodeJSONCDC(raw, d.options...)
+}
+
+func (d *JSONDecoder) expectDelim(delim json.Delim) error {
+	token, err := d.decoder.Token()
+	if err != nil {
+		return d.wrapError(err)
+	}
+	if token != delim {
+		return fmt.Errorf("cadence: invalid JSON-CDC value: expected %s, got %v", delim, token)
+	}
+	return nil
+}
+
+func (d *JSONDecoder) wrapError(err error) error {
+	if errors.Is(err, io.EOF) {
+		return err
+	}
+	return fmt.Errorf("cadence: failed to read JSON-CDC value: %w", err)
+}
+
+// An ArrayIterator iterates over the elements of an array decoded from a stream, see JSONDecoder.DecodeArray.
+type ArrayIterator struct {
+	decoder *JSONDecoder
+	index   int
+	err     error
+}
+
+// Next returns the next element of the array.
+//
+// io.EOF is returned once all the elements are decoded. Any other error ends the iteration and is
+// returned by all the following calls.
+func (it *ArrayIterator) Next() (cadence.Value, error) {
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	if !it.decoder.decoder.More() {
+		it.err = it.end()
+		return nil, it.err
+	}
+
+	var raw json.RawMessage
+	if err := it.decoder.decoder.Decode(&raw); err != nil {
+		it.err = fmt.Errorf("cadence: failed to read array element %d: %w", it.index, err)
+		return nil, it.err
+	}
+
+	value, err := it.decoder.decodeRaw(raw)
+	if err != nil {
+		it.err = fmt.Errorf("array element %d: %w", it.index, err)
+		return nil, it.err
+	}
+
+	it.index++
+	return value, nil
+}
+
+// end consumes the end of the array value, returning io.EOF if the value is valid.
+func (it *ArrayIterator) end() error {
+	if err := it.decoder.expectDelim(']'); err != nil {
+		return err
+	}
+
+	// the array value may be followed by other keys
+	for it.decoder.decoder.More() {
+		if _, err := it.decoder.decoder.Token(); err != nil {
+			return it.decoder.wrapError(err)
+		}
+		var ignored json.RawMessage
+		if err := it.decoder.decoder.Decode(&ignored); err != nil {
+			return it.decoder.wrapError(err)
+		}
+	}
+
+	if err := it.decoder.expectDelim('}'); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+// DecodeJSON decodes a JSON-CDC value from the reader, as JSONDecoder.Decode.
+func DecodeJSON(r io.Reader, options ...jsoncdc.Option) (cadence.Value, error) {
+	return NewJSONDecoder(r, options...).Decode()
+}
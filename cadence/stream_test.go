@@ -0,0 +1,117 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+func TestJSONDecoder(t *testing.T) {
+	values := make([]cadence.Value, 100)
+	for i := range values {
+		values[i] = cadence.NewUInt64(uint64(i))
+	}
+	array := cadence.NewArray(values)
+
+	encoded, err := jsoncdc.Encode(array)
+	require.NoError(t, err)
+
+	t.Run("Decode", func(t *testing.T) {
+		stream := bytes.NewReader(append(append(encoded, '\n'), encoded...))
+		decoder := sdkcadence.NewJSONDecoder(stream)
+
+		for i := 0; i < 2; i++ {
+			value, err := decoder.Decode()
+			require.NoError(t, err)
+			assert.Equal(t, array, value)
+		}
+
+		_, err := decoder.Decode()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Base64", func(t *testing.T) {
+		stream := strings.NewReader(base64.StdEncoding.EncodeToString(encoded))
+
+		value, err := sdkcadence.DecodeJSON(base64.NewDecoder(base64.StdEncoding, stream))
+		require.NoError(t, err)
+		assert.Equal(t, array, value)
+	})
+
+	t.Run("Array", func(t *testing.T) {
+		it, err := sdkcadence.NewJSONDecoder(bytes.NewReader(encoded)).DecodeArray()
+		require.NoError(t, err)
+
+		decoded := make([]cadence.Value, 0)
+		for {
+			value, err := it.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			decoded = append(decoded, value)
+		}
+		assert.Equal(t, values, decoded)
+
+		_, err = it.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Array with value first", func(t *testing.T) {
+		_, err := sdkcadence.NewJSONDecoder(strings.NewReader(`{"value":[],"type":"Array"}`)).DecodeArray()
+		assert.Error(t, err)
+	})
+
+	t.Run("Not an array", func(t *testing.T) {
+		_, err := sdkcadence.NewJSONDecoder(strings.NewReader(`{"type":"UInt64","value":"1"}`)).DecodeArray()
+		assert.Error(t, err)
+
+		_, err = sdkcadence.NewJSONDecoder(strings.NewReader(`[]`)).DecodeArray()
+		assert.Error(t, err)
+	})
+
+	t.Run("Truncated array", func(t *testing.T) {
+		it, err := sdkcadence.NewJSONDecoder(bytes.NewReader(encoded[:len(encoded)/2])).DecodeArray()
+		require.NoError(t, err)
+
+		for err == nil {
+			_, err = it.Next()
+		}
+		assert.NotEqual(t, io.EOF, err)
+	})
+
+	t.Run("Invalid element", func(t *testing.T) {
+		it, err := sdkcadence.NewJSONDecoder(strings.NewReader(`{"type":"Array","value":[{"type":"Foo","value":"1"}]}`)).DecodeArray()
+		require.NoError(t, err)
+
+		_, err = it.Next()
+		assert.Error(t, err)
+	})
+}
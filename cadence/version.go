@@ -0,0 +1,172 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Version is a major version of the Cadence language run by a network.
+type Version int
+
+const (
+	VersionUnknown Version = iota
+	// Version0 is the Cadence language run by the networks before the Crescendo upgrade.
+	Version0
+	// Version1 is Cadence 1.0, run by the networks since the Crescendo upgrade.
+	Version1
+)
+
+func (v Version) String() string {
+	switch v {
+	case Version0:
+		return "Cadence 0.x"
+	case Version1:
+		return "Cadence 1.0"
+	default:
+		return "unknown Cadence version"
+	}
+}
+
+// MigrateArgumentToV1 converts a JSON-CDC argument encoded by the SDK to the encoding of Cadence 1.0,
+// so arguments can be sent to networks running Cadence 1.0.
+//
+// Restricted types are converted to intersection types and unauthorized reference types to references
+// with an unauthorized access. Authorized reference types and path capabilities have no Cadence 1.0
+// equivalent and are rejected with an UnsupportedValueError. Arguments without such values are returned
+// unchanged.
+func MigrateArgumentToV1(argument []byte) ([]byte, error) {
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(argument))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("cadence: failed to decode JSON-CDC argument: %w", err)
+	}
+
+	migrated, changed, err := migrateToV1(decoded)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return argument, nil
+	}
+
+	b, err := json.Marshal(migrated)
+	if err != nil {
+		return nil, fmt.Errorf("cadence: failed to encode migrated argument: %w", err)
+	}
+	return b, nil
+}
+
+// MigrateArgumentsToV1 converts the JSON-CDC arguments to the encoding of Cadence 1.0 with MigrateArgumentToV1.
+func MigrateArgumentsToV1(arguments [][]byte) ([][]byte, error) {
+	migrated := make([][]byte, len(arguments))
+	for i, argument := range arguments {
+		var err error
+		migrated[i], err = MigrateArgumentToV1(argument)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+	}
+	return migrated, nil
+}
+
+// migrateToV1 migrates the decoded JSON value, returning whether it was changed.
+func migrateToV1(decoded interface{}) (interface{}, bool, error) {
+	switch v := decoded.(type) {
+	case []interface{}:
+		changed := false
+		for i, element := range v {
+			migrated, elementChanged, err := migrateToV1(element)
+			if err != nil {
+				return nil, false, err
+			}
+			v[i] = migrated
+			changed = changed || elementChanged
+		}
+		return v, changed, nil
+
+	case map[string]interface{}:
+		changed := false
+
+		if kind, _ := v["type"].(string); kind == "Capability" {
+			if capability, ok := v["value"].(map[string]interface{}); ok {
+				if _, ok := capability["path"]; ok {
+					return nil, false, &UnsupportedValueError{
+						Kind:   kind,
+						Reason: "Cadence 1.0 capabilities are identified by ID instead of path",
+					}
+				}
+			}
+		}
+
+		switch kind, _ := v["kind"].(string); kind {
+		case "Restriction":
+			restrictions, _ := v["restrictions"].([]interface{})
+			ids := make([]string, len(restrictions))
+			for i, r := range restrictions {
+				if t, ok := r.(map[string]interface{}); ok {
+					ids[i], _ = t["typeID"].(string)
+				} else {
+					ids[i], _ = r.(string)
+				}
+			}
+
+			delete(v, "restrictions")
+			delete(v, "type")
+			v["kind"] = "Intersection"
+			v["types"] = restrictions
+			v["typeID"] = "{" + strings.Join(ids, ",") + "}"
+			changed = true
+
+		case "Reference":
+			if authorized, ok := v["authorized"].(bool); ok {
+				if authorized {
+					return nil, false, &UnsupportedValueError{
+						Kind:   kind,
+						Reason: "authorized references require entitlements in Cadence 1.0",
+					}
+				}
+
+				delete(v, "authorized")
+				v["authorization"] = map[string]interface{}{
+					"kind":         "Unauthorized",
+					"entitlements": nil,
+				}
+				changed = true
+			}
+		}
+
+		for key, value := range v {
+			migrated, valueChanged, err := migrateToV1(value)
+			if err != nil {
+				return nil, false, err
+			}
+			v[key] = migrated
+			changed = changed || valueChanged
+		}
+		return v, changed, nil
+
+	default:
+		return decoded, false, nil
+	}
+}
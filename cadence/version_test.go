@@ -0,0 +1,107 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cadence_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+func TestMigrateArgumentToV1(t *testing.T) {
+	location := common.NewAddressLocation(nil, common.Address{1}, "Foo")
+	receiver := &cadence.ResourceInterfaceType{Location: location, QualifiedIdentifier: "Foo.Receiver"}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		arg := jsoncdc.MustEncode(cadence.NewArray([]cadence.Value{cadence.String("a"), cadence.NewInt(1)}))
+
+		migrated, err := sdkcadence.MigrateArgumentToV1(arg)
+		require.NoError(t, err)
+		assert.Equal(t, arg, migrated)
+	})
+
+	t.Run("Restricted type", func(t *testing.T) {
+		restricted := cadence.NewRestrictedType("AnyResource{A.0100000000000000.Foo.Receiver}", cadence.AnyResourceType{}, []cadence.Type{receiver})
+		arg := jsoncdc.MustEncode(cadence.NewTypeValue(restricted))
+
+		migrated, err := sdkcadence.MigrateArgumentToV1(arg)
+		require.NoError(t, err)
+
+		var decoded struct {
+			Value struct {
+				StaticType struct {
+					Kind   string `json:"kind"`
+					TypeID string `json:"typeID"`
+					Types  []struct {
+						TypeID string `json:"typeID"`
+					} `json:"types"`
+				} `json:"staticType"`
+			} `json:"value"`
+		}
+		require.NoError(t, json.Unmarshal(migrated, &decoded))
+		assert.Equal(t, "Intersection", decoded.Value.StaticType.Kind)
+		assert.Equal(t, "{A.0100000000000000.Foo.Receiver}", decoded.Value.StaticType.TypeID)
+		require.Len(t, decoded.Value.StaticType.Types, 1)
+		assert.Equal(t, "A.0100000000000000.Foo.Receiver", decoded.Value.StaticType.Types[0].TypeID)
+	})
+
+	t.Run("Unauthorized reference", func(t *testing.T) {
+		arg := jsoncdc.MustEncode(cadence.NewTypeValue(cadence.NewReferenceType(false, cadence.AnyStructType{})))
+
+		migrated, err := sdkcadence.MigrateArgumentToV1(arg)
+		require.NoError(t, err)
+		assert.JSONEq(t,
+			`{"type":"Type","value":{"staticType":{"kind":"Reference","type":{"kind":"AnyStruct"},"authorization":{"kind":"Unauthorized","entitlements":null}}}}`,
+			string(migrated),
+		)
+	})
+
+	t.Run("Unsupported values", func(t *testing.T) {
+		args := [][]byte{
+			jsoncdc.MustEncode(cadence.NewTypeValue(cadence.NewReferenceType(true, cadence.AnyStructType{}))),
+			jsoncdc.MustEncode(cadence.NewCapability(
+				cadence.Path{Domain: "public", Identifier: "receiver"},
+				cadence.BytesToAddress([]byte{1}),
+				cadence.NewReferenceType(false, cadence.AnyStructType{}),
+			)),
+		}
+
+		for _, arg := range args {
+			_, err := sdkcadence.MigrateArgumentToV1(arg)
+			var unsupported *sdkcadence.UnsupportedValueError
+			assert.True(t, errors.As(err, &unsupported), "argument %s", arg)
+		}
+
+		_, err := sdkcadence.MigrateArgumentsToV1([][]byte{jsoncdc.MustEncode(cadence.NewInt(1)), args[0]})
+		assert.ErrorContains(t, err, "argument 1")
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		_, err := sdkcadence.MigrateArgumentToV1([]byte("{"))
+		assert.Error(t, err)
+	})
+}
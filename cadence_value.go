@@ -0,0 +1,143 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/onflow/cadence"
+)
+
+var (
+	addressType   = reflect.TypeOf(Address{})
+	bigIntType    = reflect.TypeOf(&big.Int{})
+	cadenceValues = reflect.TypeOf((*cadence.Value)(nil)).Elem()
+)
+
+// ToCadenceValue converts a Go value to the Cadence value with the matching type.
+//
+// Go integers are converted to the Cadence integer of the same size (e.g. uint64 to UInt64,
+// int to Int and *big.Int to Int), strings to String, booleans to Bool and addresses to Address.
+// Pointers are converted to optionals, slices and arrays to arrays, and maps to dictionaries.
+// Cadence values are returned unchanged.
+//
+// *big.Int is the exception to the pointer rule, since it is how Go represents big integers:
+// a non-nil *big.Int is converted to Int and not to an optional, while a nil one is converted to
+// a nil optional. Use a **big.Int to get an optional Int.
+//
+// Floating point numbers are not converted, since they can't represent fixed-point values exactly:
+// use cadence.NewUFix64 or cadence.NewFix64 to create fixed-point values from their string representation.
+func ToCadenceValue(v interface{}) (cadence.Value, error) {
+	if v == nil {
+		return cadence.NewOptional(nil), nil
+	}
+
+	return toCadenceValue(reflect.ValueOf(v))
+}
+
+func toCadenceValue(v reflect.Value) (cadence.Value, error) {
+	if v.Kind() != reflect.Interface && v.Type().Implements(cadenceValues) {
+		return v.Interface().(cadence.Value), nil
+	}
+
+	switch v.Type() {
+	case addressType:
+		return cadence.NewAddress(v.Interface().(Address)), nil
+	case bigIntType:
+		if v.IsNil() {
+			return cadence.NewOptional(nil), nil
+		}
+		return cadence.NewIntFromBig(v.Interface().(*big.Int)), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return cadence.NewBool(v.Bool()), nil
+	case reflect.String:
+		return cadence.NewString(v.String())
+	case reflect.Int:
+		return cadence.NewInt(int(v.Int())), nil
+	case reflect.Int8:
+		return cadence.NewInt8(int8(v.Int())), nil
+	case reflect.Int16:
+		return cadence.NewInt16(int16(v.Int())), nil
+	case reflect.Int32:
+		return cadence.NewInt32(int32(v.Int())), nil
+	case reflect.Int64:
+		return cadence.NewInt64(v.Int()), nil
+	case reflect.Uint:
+		return cadence.NewUInt(uint(v.Uint())), nil
+	case reflect.Uint8:
+		return cadence.NewUInt8(uint8(v.Uint())), nil
+	case reflect.Uint16:
+		return cadence.NewUInt16(uint16(v.Uint())), nil
+	case reflect.Uint32:
+		return cadence.NewUInt32(uint32(v.Uint())), nil
+	case reflect.Uint64:
+		return cadence.NewUInt64(v.Uint()), nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return cadence.NewOptional(nil), nil
+		}
+		return toCadenceValue(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return cadence.NewOptional(nil), nil
+		}
+		value, err := toCadenceValue(v.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptional(value), nil
+	case reflect.Slice, reflect.Array:
+		values := make([]cadence.Value, v.Len())
+		for i := range values {
+			value, err := toCadenceValue(v.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert element %d: %w", i, err)
+			}
+			values[i] = value
+		}
+		return cadence.NewArray(values), nil
+	case reflect.Map:
+		pairs := make([]cadence.KeyValuePair, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := toCadenceValue(iter.Key())
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert key %v: %w", iter.Key(), err)
+			}
+			value, err := toCadenceValue(iter.Value())
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert value of key %v: %w", iter.Key(), err)
+			}
+			pairs = append(pairs, cadence.KeyValuePair{Key: key, Value: value})
+		}
+		// sort the pairs so the encoded dictionary doesn't depend on the map iteration order
+		sort.Slice(pairs, func(i, j int) bool {
+			return pairs[i].Key.String() < pairs[j].Key.String()
+		})
+		return cadence.NewDictionary(pairs), nil
+	}
+
+	return nil, fmt.Errorf("can't convert value of type %s to a Cadence value", v.Type())
+}
@@ -0,0 +1,106 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func TestToCadenceValue(t *testing.T) {
+	address := flow.HexToAddress("01")
+	count := uint64(3)
+	bigCount := big.NewInt(3)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected cadence.Value
+	}{
+		{name: "Bool", value: true, expected: cadence.NewBool(true)},
+		{name: "String", value: "foo", expected: cadence.String("foo")},
+		{name: "Int", value: 5, expected: cadence.NewInt(5)},
+		{name: "Int8", value: int8(-5), expected: cadence.NewInt8(-5)},
+		{name: "Int64", value: int64(-5), expected: cadence.NewInt64(-5)},
+		{name: "UInt8", value: uint8(5), expected: cadence.NewUInt8(5)},
+		{name: "UInt64", value: uint64(5), expected: cadence.NewUInt64(5)},
+		{name: "Big integer", value: big.NewInt(5), expected: cadence.NewIntFromBig(big.NewInt(5))},
+		{name: "Nil big integer", value: (*big.Int)(nil), expected: cadence.NewOptional(nil)},
+		{name: "Big integer pointer", value: &bigCount, expected: cadence.NewOptional(cadence.NewIntFromBig(big.NewInt(3)))},
+		{name: "Address", value: address, expected: cadence.NewAddress(address)},
+		{name: "Cadence value", value: cadence.UFix64(100000000), expected: cadence.UFix64(100000000)},
+		{name: "Nil", value: nil, expected: cadence.NewOptional(nil)},
+		{name: "Pointer", value: &count, expected: cadence.NewOptional(cadence.NewUInt64(3))},
+		{
+			name:     "Slice",
+			value:    []uint32{1, 2},
+			expected: cadence.NewArray([]cadence.Value{cadence.NewUInt32(1), cadence.NewUInt32(2)}),
+		},
+		{
+			name:  "Map",
+			value: map[string]interface{}{"b": 2, "a": "x"},
+			expected: cadence.NewDictionary([]cadence.KeyValuePair{
+				{Key: cadence.String("a"), Value: cadence.String("x")},
+				{Key: cadence.String("b"), Value: cadence.NewInt(2)},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := flow.ToCadenceValue(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+
+	t.Run("Float", func(t *testing.T) {
+		_, err := flow.ToCadenceValue(1.5)
+		assert.Error(t, err)
+	})
+
+	t.Run("Nested unsupported value", func(t *testing.T) {
+		_, err := flow.ToCadenceValue([]interface{}{1, struct{}{}})
+		assert.Error(t, err)
+	})
+}
+
+func TestTransaction_AddArgumentValue(t *testing.T) {
+	tx := flow.NewTransaction()
+
+	require.NoError(t, tx.AddArgumentValue(uint64(5)))
+	require.NoError(t, tx.AddArgumentValue(flow.HexToAddress("01")))
+	assert.Error(t, tx.AddArgumentValue(1.5))
+
+	require.Len(t, tx.Arguments, 2)
+
+	arg, err := tx.Argument(0)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewUInt64(5), arg)
+
+	arg, err = tx.Argument(1)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewAddress(flow.HexToAddress("01")), arg)
+}
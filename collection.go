@@ -46,4 +46,8 @@ func (c Collection) Encode() []byte {
 // A CollectionGuarantee is an attestation signed by the nodes that have guaranteed a collection.
 type CollectionGuarantee struct {
 	CollectionID Identifier
+	// SignerIDs are the IDs of the collection nodes that signed the guarantee.
+	SignerIDs []Identifier
+	// Signature is the aggregated signature of the signers.
+	Signature []byte
 }
@@ -0,0 +1,256 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package awskms provides an AWS Key Management Service (KMS)
+// implementation of the crypto.Signer interface.
+//
+// The client calls the AWS KMS JSON API directly, signing the requests with AWS Signature Version 4.
+//
+// The documentation for AWS KMS can be found here: https://docs.aws.amazon.com/kms/latest/developerguide
+package awskms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+const (
+	serviceName = "kms"
+
+	// keySpecP256 and keySpecSECP256K1 are the key specs of the ECDSA keys supported by the SDK.
+	keySpecP256      = "ECC_NIST_P256"
+	keySpecSECP256K1 = "ECC_SECG_P256K1"
+
+	// signingAlgorithm is the only signing algorithm supported by AWS KMS for the supported key specs.
+	signingAlgorithm = "ECDSA_SHA_256"
+)
+
+// Credentials are the AWS credentials used to sign the KMS requests.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is only set for temporary credentials.
+	SessionToken string
+}
+
+// CredentialsProvider returns the credentials used to sign a request.
+//
+// It is called for every request, so temporary credentials can be refreshed by the provider.
+type CredentialsProvider func(ctx context.Context) (Credentials, error)
+
+// StaticCredentials returns a credentials provider always returning the given credentials.
+func StaticCredentials(credentials Credentials) CredentialsProvider {
+	return func(context.Context) (Credentials, error) {
+		return credentials, nil
+	}
+}
+
+// EnvCredentials returns a credentials provider reading the credentials from the
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+func EnvCredentials() CredentialsProvider {
+	return func(context.Context) (Credentials, error) {
+		credentials := Credentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+		if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+			return Credentials{}, fmt.Errorf("awskms: AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+		}
+		return credentials, nil
+	}
+}
+
+// ClientOption configures a KMS client.
+type ClientOption func(c *Client)
+
+// WithEndpoint sets the URL of the KMS API, by default the regional endpoint https://kms.<region>.amazonaws.com.
+func WithEndpoint(endpoint string) ClientOption {
+	return func(c *Client) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// Client is a client for interacting with the AWS KMS API
+// using types native to the Flow Go SDK.
+type Client struct {
+	region      string
+	endpoint    string
+	credentials CredentialsProvider
+	httpClient  *http.Client
+	now         func() time.Time
+}
+
+// NewClient creates a new KMS client for the region.
+func NewClient(region string, credentials CredentialsProvider, opts ...ClientOption) (*Client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("awskms: region is required")
+	}
+
+	c := &Client{
+		region:      region,
+		endpoint:    fmt.Sprintf("https://kms.%s.amazonaws.com", region),
+		credentials: credentials,
+		httpClient:  http.DefaultClient,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// GetPublicKey fetches the public key of a KMS asymmetric signing key and returns it in the Flow format,
+// along with the hash algorithm used by the key.
+//
+// KMS keys with the `ECC_NIST_P256` and `ECC_SECG_P256K1` key specs are the only keys supported by the SDK.
+// The key ID can be a key ID, a key ARN, an alias name or an alias ARN.
+//
+// Ref: https://docs.aws.amazon.com/kms/latest/APIReference/API_GetPublicKey.html
+func (c *Client) GetPublicKey(ctx context.Context, keyID string) (crypto.PublicKey, crypto.HashAlgorithm, error) {
+	var response struct {
+		KeySpec   string
+		KeyUsage  string
+		PublicKey []byte
+	}
+
+	err := c.call(ctx, "GetPublicKey", map[string]string{"KeyId": keyID}, &response)
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("awskms: failed to fetch public key from KMS API: %w", err)
+	}
+
+	if response.KeyUsage != "" && response.KeyUsage != "SIGN_VERIFY" {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("awskms: key %s can't be used for signing", keyID)
+	}
+
+	sigAlgo := ParseSignatureAlgorithm(response.KeySpec)
+	if sigAlgo == crypto.UnknownSignatureAlgorithm {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("awskms: unsupported key spec %s", response.KeySpec)
+	}
+
+	publicKey, err := crypto.DecodePublicKeyDER(sigAlgo, response.PublicKey)
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("awskms: failed to parse public key: %w", err)
+	}
+
+	return publicKey, crypto.SHA2_256, nil
+}
+
+// ParseSignatureAlgorithm returns the signature algorithm of a KMS key spec,
+// or crypto.UnknownSignatureAlgorithm if the key spec is not supported.
+func ParseSignatureAlgorithm(keySpec string) crypto.SignatureAlgorithm {
+	switch keySpec {
+	case keySpecP256:
+		return crypto.ECDSA_P256
+	case keySpecSECP256K1:
+		return crypto.ECDSA_secp256k1
+	default:
+		return crypto.UnknownSignatureAlgorithm
+	}
+}
+
+// sign signs the digest with the KMS key and returns the DER encoded signature.
+//
+// Ref: https://docs.aws.amazon.com/kms/latest/APIReference/API_Sign.html
+func (c *Client) sign(ctx context.Context, keyID string, digest []byte) ([]byte, error) {
+	request := struct {
+		KeyId            string
+		Message          []byte
+		MessageType      string
+		SigningAlgorithm string
+	}{
+		KeyId:            keyID,
+		Message:          digest,
+		MessageType:      "DIGEST",
+		SigningAlgorithm: signingAlgorithm,
+	}
+
+	var response struct {
+		Signature []byte
+	}
+
+	if err := c.call(ctx, "Sign", request, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Signature, nil
+}
+
+// apiError is an error returned by the KMS API.
+type apiError struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+// call sends a request to a KMS API operation and decodes the response.
+func (c *Client) call(ctx context.Context, operation string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := c.credentials(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get credentials: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+operation)
+	signRequest(req, body, credentials, c.region, serviceName, c.now())
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if json.Unmarshal(resBody, &apiErr) == nil && apiErr.Type != "" {
+			return fmt.Errorf("%s failed with %s: %s", operation, apiErr.Type, apiErr.Message)
+		}
+		return fmt.Errorf("%s failed with status %d: %s", operation, res.StatusCode, resBody)
+	}
+
+	return json.Unmarshal(resBody, response)
+}
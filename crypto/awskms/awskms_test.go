@@ -0,0 +1,135 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package awskms_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/crypto/awskms"
+)
+
+// newKMSServer starts a fake KMS API serving a P-256 key.
+func newKMSServer(t *testing.T, keySpec string) *httptest.Server {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"IncompleteSignatureException","message":"missing signature"}`))
+			return
+		}
+
+		var request struct {
+			KeyId            string
+			Message          []byte
+			MessageType      string
+			SigningAlgorithm string
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		if request.KeyId != "alias/flow" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"NotFoundException","message":"key not found"}`))
+			return
+		}
+
+		var response interface{}
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GetPublicKey":
+			response = map[string]interface{}{
+				"KeySpec":   keySpec,
+				"KeyUsage":  "SIGN_VERIFY",
+				"PublicKey": publicKey,
+			}
+		case "TrentService.Sign":
+			assert.Equal(t, "DIGEST", request.MessageType)
+			assert.Equal(t, "ECDSA_SHA_256", request.SigningAlgorithm)
+
+			sig, err := ecdsa.SignASN1(rand.Reader, key, request.Message)
+			require.NoError(t, err)
+			response = map[string]interface{}{"Signature": sig}
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSigner(t *testing.T) {
+	ctx := context.Background()
+	credentials := awskms.StaticCredentials(awskms.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+
+	t.Run("Sign", func(t *testing.T) {
+		server := newKMSServer(t, "ECC_NIST_P256")
+
+		client, err := awskms.NewClient("us-east-1", credentials, awskms.WithEndpoint(server.URL))
+		require.NoError(t, err)
+
+		signer, err := client.SignerForKey(ctx, "alias/flow")
+		require.NoError(t, err)
+		assert.Equal(t, crypto.ECDSA_P256, signer.PublicKey().Algorithm())
+
+		message := []byte("hello")
+		sig, err := signer.Sign(message)
+		require.NoError(t, err)
+
+		valid, err := signer.PublicKey().Verify(sig, message, crypto.NewSHA2_256())
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("Unknown key", func(t *testing.T) {
+		server := newKMSServer(t, "ECC_NIST_P256")
+
+		client, err := awskms.NewClient("us-east-1", credentials, awskms.WithEndpoint(server.URL))
+		require.NoError(t, err)
+
+		_, err = client.SignerForKey(ctx, "alias/other")
+		assert.ErrorContains(t, err, "NotFoundException")
+	})
+
+	t.Run("Unsupported key spec", func(t *testing.T) {
+		server := newKMSServer(t, "RSA_2048")
+
+		client, err := awskms.NewClient("us-east-1", credentials, awskms.WithEndpoint(server.URL))
+		require.NoError(t, err)
+
+		_, _, err = client.GetPublicKey(ctx, "alias/flow")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package awskms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+var _ crypto.Signer = (*Signer)(nil)
+
+// Signer is an AWS KMS implementation of crypto.Signer.
+type Signer struct {
+	ctx    context.Context
+	client *Client
+	keyID  string
+	// ECDSA is the only algorithm supported by this package. The signature algorithm
+	// therefore represents the elliptic curve used. The curve is needed to parse the kms signature.
+	curve crypto.SignatureAlgorithm
+	// public key for easier access
+	publicKey crypto.PublicKey
+	// Hash algorithm associated to the KMS signing key
+	hashAlgo crypto.HashAlgorithm
+}
+
+// SignerForKey returns a new AWS KMS signer for an asymmetric signing key.
+//
+// Only ECDSA keys on P-256 and secp256k1 curves and SHA2-256 are supported.
+func (c *Client) SignerForKey(ctx context.Context, keyID string) (*Signer, error) {
+	pk, hashAlgo, err := c.GetPublicKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		ctx:       ctx,
+		client:    c,
+		keyID:     keyID,
+		curve:     pk.Algorithm(),
+		publicKey: pk,
+		hashAlgo:  hashAlgo,
+	}, nil
+}
+
+// Sign signs the given message using the KMS signing key for this signer.
+//
+// The message is hashed locally and only the digest is sent to KMS, so the message size
+// is not limited by the KMS API. The DER signature returned by KMS is converted to the
+// raw Flow format with a low S value.
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	hasher, err := crypto.NewHasher(s.hashAlgo)
+	if err != nil {
+		return nil, fmt.Errorf("awskms: failed to sign: %w", err)
+	}
+
+	der, err := s.client.sign(s.ctx, s.keyID, hasher.ComputeHash(message))
+	if err != nil {
+		return nil, fmt.Errorf("awskms: failed to sign: %w", err)
+	}
+
+	sig, err := crypto.SignatureFromDER(der, s.curve)
+	if err != nil {
+		return nil, fmt.Errorf("awskms: failed to parse signature: %w", err)
+	}
+	return sig, nil
+}
+
+// PublicKey returns the public key of the KMS signing key.
+func (s *Signer) PublicKey() crypto.PublicKey {
+	return s.publicKey
+}
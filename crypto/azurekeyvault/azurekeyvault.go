@@ -0,0 +1,372 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package azurekeyvault provides an Azure Key Vault and Managed HSM
+// implementation of the crypto.Signer interface.
+//
+// The client calls the Key Vault REST API directly, authenticating the requests
+// with the OAuth access tokens returned by a TokenCredential.
+//
+// The documentation for Azure Key Vault can be found here: https://learn.microsoft.com/azure/key-vault
+package azurekeyvault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+const (
+	apiVersion = "7.4"
+
+	// scopes of the access tokens for Key Vault and Managed HSM.
+	keyVaultScope   = "https://vault.azure.net/.default"
+	managedHSMScope = "https://managedhsm.azure.net/.default"
+
+	// curveP256 and curveSECP256K1 are the JSON Web Key curves supported by the SDK.
+	curveP256      = "P-256"
+	curveSECP256K1 = "P-256K"
+)
+
+// Key is a reference to an Azure Key Vault or Managed HSM elliptic curve key.
+//
+// An empty version references the current version of the key.
+type Key struct {
+	VaultURL string `json:"vaultUrl"`
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+}
+
+// ID returns the key identifier of this key, as shown in the Azure portal.
+func (k Key) ID() string {
+	id := fmt.Sprintf("%s/keys/%s", strings.TrimSuffix(k.VaultURL, "/"), k.Name)
+	if k.Version != "" {
+		id += "/" + k.Version
+	}
+	return id
+}
+
+// KeyFromID returns a `Key` from a key identifier, such as
+// https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef.
+func KeyFromID(id string) (Key, error) {
+	u, err := url.Parse(id)
+	if err != nil {
+		return Key{}, fmt.Errorf("azurekeyvault: failed to parse key ID %s: %w", id, err)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Scheme == "" || u.Host == "" || len(parts) < 2 || len(parts) > 3 || parts[0] != "keys" || parts[1] == "" {
+		return Key{}, fmt.Errorf("azurekeyvault: failed to parse key ID %s, expected <vault URL>/keys/<name>[/<version>]", id)
+	}
+
+	key := Key{
+		VaultURL: fmt.Sprintf("%s://%s", u.Scheme, u.Host),
+		Name:     parts[1],
+	}
+	if len(parts) == 3 {
+		key.Version = parts[2]
+	}
+
+	return key, nil
+}
+
+// TokenCredential provides the OAuth access tokens used to authenticate the Key Vault requests.
+//
+// It is called for every request with the scope of the vault, so implementations should cache
+// the tokens until they expire. The credential types of the Azure Identity library can be adapted
+// to this interface.
+type TokenCredential interface {
+	GetToken(ctx context.Context, scope string) (string, error)
+}
+
+// TokenCredentialFunc is a function implementing the TokenCredential interface.
+type TokenCredentialFunc func(ctx context.Context, scope string) (string, error)
+
+// GetToken calls f(ctx, scope).
+func (f TokenCredentialFunc) GetToken(ctx context.Context, scope string) (string, error) {
+	return f(ctx, scope)
+}
+
+// StaticToken returns a credential always returning the given access token.
+func StaticToken(token string) TokenCredential {
+	return TokenCredentialFunc(func(context.Context, string) (string, error) {
+		return token, nil
+	})
+}
+
+// ClientOption configures a Key Vault client.
+type ClientOption func(c *Client)
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithScope sets the scope of the access tokens requested from the credential.
+//
+// By default the scope is derived from the vault URL: Managed HSM for *.managedhsm.azure.net
+// vaults and Key Vault otherwise. Vaults in sovereign clouds require setting the scope.
+func WithScope(scope string) ClientOption {
+	return func(c *Client) {
+		c.scope = scope
+	}
+}
+
+// Client is a client for interacting with the Azure Key Vault API
+// using types native to the Flow Go SDK.
+type Client struct {
+	credential TokenCredential
+	httpClient *http.Client
+	scope      string
+}
+
+// NewClient creates a new Key Vault client.
+func NewClient(credential TokenCredential, opts ...ClientOption) (*Client, error) {
+	if credential == nil {
+		return nil, fmt.Errorf("azurekeyvault: credential is required")
+	}
+
+	c := &Client{
+		credential: credential,
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// jsonWebKey is the public part of a Key Vault key.
+//
+// Ref: https://learn.microsoft.com/rest/api/keyvault/keys/get-key/get-key#jsonwebkey
+type jsonWebKey struct {
+	KeyID   string   `json:"kid"`
+	KeyType string   `json:"kty"`
+	Curve   string   `json:"crv"`
+	KeyOps  []string `json:"key_ops"`
+	X       string   `json:"x"`
+	Y       string   `json:"y"`
+}
+
+// GetPublicKey fetches the public key of a Key Vault key and returns it in the Flow format,
+// along with the hash algorithm used by the key.
+//
+// Elliptic curve keys on the `P-256` and `P-256K` curves are the only keys supported by the SDK.
+//
+// Ref: https://learn.microsoft.com/rest/api/keyvault/keys/get-key/get-key
+func (c *Client) GetPublicKey(ctx context.Context, key Key) (crypto.PublicKey, crypto.HashAlgorithm, error) {
+	jwk, err := c.getKey(ctx, key)
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, err
+	}
+
+	return parsePublicKey(key, jwk)
+}
+
+// getKey fetches the public part of a Key Vault key.
+func (c *Client) getKey(ctx context.Context, key Key) (jsonWebKey, error) {
+	var response struct {
+		Key jsonWebKey `json:"key"`
+	}
+
+	err := c.call(ctx, key, http.MethodGet, "", nil, &response)
+	if err != nil {
+		return jsonWebKey{}, fmt.Errorf("azurekeyvault: failed to fetch public key from Key Vault API: %w", err)
+	}
+
+	return response.Key, nil
+}
+
+// parsePublicKey returns the Flow public key and hash algorithm of a JSON Web Key.
+func parsePublicKey(key Key, jwk jsonWebKey) (crypto.PublicKey, crypto.HashAlgorithm, error) {
+	if jwk.KeyType != "EC" && jwk.KeyType != "EC-HSM" {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("azurekeyvault: unsupported key type %s", jwk.KeyType)
+	}
+
+	if len(jwk.KeyOps) > 0 && !containsString(jwk.KeyOps, "sign") {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("azurekeyvault: key %s can't be used for signing", key.ID())
+	}
+
+	sigAlgo := ParseSignatureAlgorithm(jwk.Curve)
+	if sigAlgo == crypto.UnknownSignatureAlgorithm {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("azurekeyvault: unsupported curve %s", jwk.Curve)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.X, "="))
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("azurekeyvault: failed to decode public key: %w", err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.Y, "="))
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("azurekeyvault: failed to decode public key: %w", err)
+	}
+	if len(x) > 32 || len(y) > 32 {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("azurekeyvault: invalid public key coordinates")
+	}
+
+	// the coordinates are left padded to form the raw public key
+	raw := make([]byte, 64)
+	copy(raw[32-len(x):32], x)
+	copy(raw[64-len(y):], y)
+
+	publicKey, err := crypto.DecodePublicKey(sigAlgo, raw)
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("azurekeyvault: failed to parse public key: %w", err)
+	}
+
+	return publicKey, crypto.SHA2_256, nil
+}
+
+// ParseSignatureAlgorithm returns the signature algorithm of a JSON Web Key curve,
+// or crypto.UnknownSignatureAlgorithm if the curve is not supported.
+func ParseSignatureAlgorithm(curve string) crypto.SignatureAlgorithm {
+	switch curve {
+	case curveP256:
+		return crypto.ECDSA_P256
+	case curveSECP256K1:
+		return crypto.ECDSA_secp256k1
+	default:
+		return crypto.UnknownSignatureAlgorithm
+	}
+}
+
+// signingAlgorithm returns the JSON Web Signature algorithm for the signature algorithm.
+func signingAlgorithm(sigAlgo crypto.SignatureAlgorithm) string {
+	if sigAlgo == crypto.ECDSA_secp256k1 {
+		return "ES256K"
+	}
+	return "ES256"
+}
+
+// sign signs the digest with the Key Vault key and returns the raw signature.
+//
+// Ref: https://learn.microsoft.com/rest/api/keyvault/keys/sign/sign
+func (c *Client) sign(ctx context.Context, key Key, sigAlgo crypto.SignatureAlgorithm, digest []byte) ([]byte, error) {
+	request := struct {
+		Algorithm string `json:"alg"`
+		Value     string `json:"value"`
+	}{
+		Algorithm: signingAlgorithm(sigAlgo),
+		Value:     base64.RawURLEncoding.EncodeToString(digest),
+	}
+
+	var response struct {
+		Value string `json:"value"`
+	}
+
+	if err := c.call(ctx, key, http.MethodPost, "/sign", request, &response); err != nil {
+		return nil, err
+	}
+
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(response.Value, "="))
+}
+
+// apiError is an error returned by the Key Vault API.
+type apiError struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// call sends a request to a key operation and decodes the response.
+func (c *Client) call(
+	ctx context.Context,
+	key Key,
+	method string,
+	operation string,
+	request interface{},
+	response interface{},
+) error {
+	var body io.Reader
+	if request != nil {
+		b, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	token, err := c.credential.GetToken(ctx, c.scopeFor(key))
+	if err != nil {
+		return fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, key.ID()+operation+"?api-version="+apiVersion, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if json.Unmarshal(resBody, &apiErr) == nil && apiErr.Error.Code != "" {
+			return fmt.Errorf("request failed with %s: %s", apiErr.Error.Code, apiErr.Error.Message)
+		}
+		return fmt.Errorf("request failed with status %d: %s", res.StatusCode, resBody)
+	}
+
+	return json.Unmarshal(resBody, response)
+}
+
+// scopeFor returns the scope of the access token for the key vault.
+func (c *Client) scopeFor(key Key) string {
+	if c.scope != "" {
+		return c.scope
+	}
+
+	u, err := url.Parse(key.VaultURL)
+	if err == nil && strings.HasSuffix(u.Hostname(), ".managedhsm.azure.net") {
+		return managedHSMScope
+	}
+	return keyVaultScope
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
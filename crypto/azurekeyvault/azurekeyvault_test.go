@@ -0,0 +1,201 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package azurekeyvault_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/crypto/azurekeyvault"
+)
+
+func TestKeyFromID(t *testing.T) {
+	key, err := azurekeyvault.KeyFromID("https://my-vault.vault.azure.net/keys/flow/0123456789abcdef")
+	require.NoError(t, err)
+	assert.Equal(t, azurekeyvault.Key{
+		VaultURL: "https://my-vault.vault.azure.net",
+		Name:     "flow",
+		Version:  "0123456789abcdef",
+	}, key)
+	assert.Equal(t, "https://my-vault.vault.azure.net/keys/flow/0123456789abcdef", key.ID())
+
+	key, err = azurekeyvault.KeyFromID("https://my-vault.vault.azure.net/keys/flow")
+	require.NoError(t, err)
+	assert.Equal(t, "", key.Version)
+
+	_, err = azurekeyvault.KeyFromID("https://my-vault.vault.azure.net/secrets/flow")
+	assert.Error(t, err)
+
+	_, err = azurekeyvault.KeyFromID("flow")
+	assert.Error(t, err)
+}
+
+// newKeyVaultServer starts a fake Key Vault API serving a P-256 key named flow, whose current
+// version is v1. Signing is only served for the versioned key.
+func newKeyVaultServer(t *testing.T, curve string) *httptest.Server {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	encode := base64.RawURLEncoding.EncodeToString
+
+	getKey := func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"key": map[string]interface{}{
+				"kid":     "http://" + r.Host + "/keys/flow/v1",
+				"kty":     "EC-HSM",
+				"crv":     curve,
+				"key_ops": []string{"sign", "verify"},
+				"x":       encode(key.X.Bytes()),
+				"y":       encode(key.Y.Bytes()),
+			},
+		}))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/keys/flow", getKey)
+	mux.HandleFunc("/keys/flow/v1", getKey)
+	mux.HandleFunc("/keys/flow/v1/sign", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Alg   string `json:"alg"`
+			Value string `json:"value"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "ES256", request.Alg)
+
+		digest, err := base64.RawURLEncoding.DecodeString(request.Value)
+		require.NoError(t, err)
+
+		sigR, sigS, err := ecdsa.Sign(rand.Reader, key, digest)
+		require.NoError(t, err)
+
+		// Key Vault doesn't normalize S, return the high S form to check it is normalized
+		if sigS.Cmp(new(big.Int).Rsh(key.Params().N, 1)) <= 0 {
+			sigS = new(big.Int).Sub(key.Params().N, sigS)
+		}
+
+		sig := make([]byte, 64)
+		sigR.FillBytes(sig[:32])
+		sigS.FillBytes(sig[32:])
+
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"value": encode(sig)}))
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" || r.URL.Query().Get("api-version") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":"Unauthorized","message":"invalid token"}}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSigner(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Sign", func(t *testing.T) {
+		server := newKeyVaultServer(t, "P-256")
+
+		var scope string
+		credential := azurekeyvault.TokenCredentialFunc(func(_ context.Context, s string) (string, error) {
+			scope = s
+			return "token", nil
+		})
+
+		client, err := azurekeyvault.NewClient(credential)
+		require.NoError(t, err)
+
+		signer, err := client.SignerForKey(ctx, azurekeyvault.Key{VaultURL: server.URL, Name: "flow"})
+		require.NoError(t, err)
+		assert.Equal(t, crypto.ECDSA_P256, signer.PublicKey().Algorithm())
+		assert.Equal(t, "https://vault.azure.net/.default", scope)
+
+		message := []byte("hello")
+		sig, err := signer.Sign(message)
+		require.NoError(t, err)
+
+		valid, err := signer.PublicKey().Verify(sig, message, crypto.NewSHA2_256())
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		s := new(big.Int).SetBytes(sig[32:])
+		assert.True(t, s.Cmp(new(big.Int).Rsh(elliptic.P256().Params().N, 1)) <= 0)
+	})
+
+	t.Run("Pinned version", func(t *testing.T) {
+		server := newKeyVaultServer(t, "P-256")
+
+		var paths []string
+		httpClient := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			paths = append(paths, r.URL.Path)
+			return http.DefaultTransport.RoundTrip(r)
+		})}
+
+		client, err := azurekeyvault.NewClient(azurekeyvault.StaticToken("token"), azurekeyvault.WithHTTPClient(httpClient))
+		require.NoError(t, err)
+
+		signer, err := client.SignerForKey(ctx, azurekeyvault.Key{VaultURL: server.URL, Name: "flow"})
+		require.NoError(t, err)
+
+		_, err = signer.Sign([]byte("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/keys/flow", "/keys/flow/v1/sign"}, paths)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		server := newKeyVaultServer(t, "P-256")
+
+		client, err := azurekeyvault.NewClient(azurekeyvault.StaticToken("other"))
+		require.NoError(t, err)
+
+		_, err = client.SignerForKey(ctx, azurekeyvault.Key{VaultURL: server.URL, Name: "flow"})
+		assert.ErrorContains(t, err, "Unauthorized")
+	})
+
+	t.Run("Unsupported curve", func(t *testing.T) {
+		server := newKeyVaultServer(t, "P-384")
+
+		client, err := azurekeyvault.NewClient(azurekeyvault.StaticToken("token"))
+		require.NoError(t, err)
+
+		_, _, err = client.GetPublicKey(ctx, azurekeyvault.Key{VaultURL: server.URL, Name: "flow"})
+		assert.Error(t, err)
+	})
+}
+
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
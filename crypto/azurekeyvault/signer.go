@@ -0,0 +1,103 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package azurekeyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+var _ crypto.Signer = (*Signer)(nil)
+
+// Signer is an Azure Key Vault implementation of crypto.Signer.
+type Signer struct {
+	ctx    context.Context
+	client *Client
+	key    Key
+	// ECDSA is the only algorithm supported by this package. The signature algorithm
+	// therefore represents the elliptic curve used. The curve is needed to select the signing algorithm.
+	curve crypto.SignatureAlgorithm
+	// public key for easier access
+	publicKey crypto.PublicKey
+	// Hash algorithm associated to the Key Vault key
+	hashAlgo crypto.HashAlgorithm
+}
+
+// SignerForKey returns a new Azure Key Vault signer for an elliptic curve key.
+//
+// Only ECDSA keys on P-256 and secp256k1 curves and SHA2-256 are supported. If the key version is
+// not set, the signer uses the current version at the time it is created, so rotating the Key Vault
+// key doesn't change the public key of existing signers.
+func (c *Client) SignerForKey(ctx context.Context, key Key) (*Signer, error) {
+	jwk, err := c.getKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Version == "" {
+		current, err := KeyFromID(jwk.KeyID)
+		if err != nil || current.Name != key.Name || current.Version == "" {
+			return nil, fmt.Errorf("azurekeyvault: failed to parse key version from key ID %q", jwk.KeyID)
+		}
+		key.Version = current.Version
+	}
+
+	pk, hashAlgo, err := parsePublicKey(key, jwk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		ctx:       ctx,
+		client:    c,
+		key:       key,
+		curve:     pk.Algorithm(),
+		publicKey: pk,
+		hashAlgo:  hashAlgo,
+	}, nil
+}
+
+// Sign signs the given message using the Key Vault key for this signer.
+//
+// The message is hashed locally and only the digest is sent to Key Vault. Key Vault returns
+// signatures in the raw Flow format, which are normalized to a low S value.
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	hasher, err := crypto.NewHasher(s.hashAlgo)
+	if err != nil {
+		return nil, fmt.Errorf("azurekeyvault: failed to sign: %w", err)
+	}
+
+	raw, err := s.client.sign(s.ctx, s.key, s.curve, hasher.ComputeHash(message))
+	if err != nil {
+		return nil, fmt.Errorf("azurekeyvault: failed to sign: %w", err)
+	}
+
+	sig, err := crypto.NormalizeSignature(raw, s.curve)
+	if err != nil {
+		return nil, fmt.Errorf("azurekeyvault: failed to parse signature: %w", err)
+	}
+	return sig, nil
+}
+
+// PublicKey returns the public key of the Key Vault key.
+func (s *Signer) PublicKey() crypto.PublicKey {
+	return s.publicKey
+}
@@ -20,10 +20,8 @@ package cloudkms
 
 import (
 	"context"
-	"encoding/asn1"
 	"fmt"
 	"hash/crc32"
-	"math/big"
 
 	kms "cloud.google.com/go/kms/apiv1"
 	"github.com/onflow/flow-go-sdk/crypto"
@@ -122,35 +120,7 @@ func checksum(data []byte) *wrapperspb.Int64Value {
 
 // parseSignature parses an asn1 stucture (R,S) into a slice of bytes as required by the `Siger.Sign` method.
 func parseSignature(kmsSignature []byte, curve crypto.SignatureAlgorithm) ([]byte, error) {
-	var parsedSig struct{ R, S *big.Int }
-	if _, err := asn1.Unmarshal(kmsSignature, &parsedSig); err != nil {
-		return nil, fmt.Errorf("asn1.Unmarshal: %w", err)
-	}
-
-	curveOrderLen := curveOrder(curve)
-	signature := make([]byte, 2*curveOrderLen)
-
-	// left pad R and S with zeroes
-	rBytes := parsedSig.R.Bytes()
-	sBytes := parsedSig.S.Bytes()
-	copy(signature[curveOrderLen-len(rBytes):], rBytes)
-	copy(signature[len(signature)-len(sBytes):], sBytes)
-
-	return signature, nil
-}
-
-// returns the curve order size in bytes (used to padd R and S of the ECDSA signature)
-// Only P-256 and secp256k1 are supported. The calling function should make sure
-// the function is only called with one of the 2 curves.
-func curveOrder(curve crypto.SignatureAlgorithm) int {
-	switch curve {
-	case crypto.ECDSA_P256:
-		return 32
-	case crypto.ECDSA_secp256k1:
-		return 32
-	default:
-		panic("the curve is not supported")
-	}
+	return crypto.SignatureFromDER(kmsSignature, curve)
 }
 
 func (s *Signer) PublicKey() crypto.PublicKey {
@@ -140,6 +140,21 @@ func keyGenerationKMACTag(sigAlgo SignatureAlgorithm) []byte {
 	return []byte(fmt.Sprintf("%s Key Generation", sigAlgo))
 }
 
+// keyGenerationSeedLength returns the length of the seeds passed to the key generation of the signature algorithm.
+func keyGenerationSeedLength(sigAlgo SignatureAlgorithm) (int, error) {
+	switch sigAlgo {
+	case ECDSA_P256:
+		return crypto.KeyGenSeedMinLenECDSAP256, nil
+	case ECDSA_secp256k1:
+		return crypto.KeyGenSeedMinLenECDSASecp256k1, nil
+	default:
+		return 0, fmt.Errorf(
+			"crypto: Go SDK does not support key generation for %s algorithm",
+			sigAlgo,
+		)
+	}
+}
+
 // GeneratePrivateKey generates a private key with the specified signature algorithm from the given seed.
 func GeneratePrivateKey(sigAlgo SignatureAlgorithm, seed []byte) (PrivateKey, error) {
 	// check the seed has minimum entropy
@@ -153,17 +168,9 @@ func GeneratePrivateKey(sigAlgo SignatureAlgorithm, seed []byte) (PrivateKey, er
 	}
 
 	// expand the seed and uniformize its entropy
-	var seedLen int
-	switch sigAlgo {
-	case ECDSA_P256:
-		seedLen = crypto.KeyGenSeedMinLenECDSAP256
-	case ECDSA_secp256k1:
-		seedLen = crypto.KeyGenSeedMinLenECDSASecp256k1
-	default:
-		return nil, fmt.Errorf(
-			"crypto: Go SDK does not support key generation for %s algorithm",
-			sigAlgo,
-		)
+	seedLen, err := keyGenerationSeedLength(sigAlgo)
+	if err != nil {
+		return nil, err
 	}
 
 	generationTag := keyGenerationKMACTag(sigAlgo)
@@ -220,14 +227,30 @@ func DecodePublicKeyPEM(sigAlgo SignatureAlgorithm, s string) (PublicKey, error)
 	}
 
 	block, rest := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, fmt.Errorf("crypto: failed to parse PEM string, no PEM data found")
+	}
 	if len(rest) > 0 {
 		return nil, fmt.Errorf("crypto: failed to parse PEM string, not all bytes in PEM key were decoded: %x", rest)
 	}
 
+	return DecodePublicKeyDER(sigAlgo, block.Bytes)
+}
+
+// DecodePublicKeyDER decodes an ECDSA public key in PKIX, ASN.1 DER form with the given curve, encoded in `sigAlgo`.
+//
+// This is the form public keys are exported in by most key management services.
+// The function only supports ECDSA with P256 and secp256k1 curves.
+func DecodePublicKeyDER(sigAlgo SignatureAlgorithm, der []byte) (PublicKey, error) {
+
+	if sigAlgo != ECDSA_P256 && sigAlgo != ECDSA_secp256k1 {
+		return nil, fmt.Errorf("crypto: only ECDSA algorithms are supported")
+	}
+
 	// parse the public key data and extract the raw public key
-	pkBytes, err := x509ParseECDSAPublicKey(block.Bytes)
+	pkBytes, err := x509ParseECDSAPublicKey(der)
 	if err != nil {
-		return nil, fmt.Errorf("crypto: failed to parse PEM string: %w", err)
+		return nil, fmt.Errorf("crypto: failed to parse DER public key: %w", err)
 	}
 
 	// decode the point and check the resulting key is a valid point on the curve
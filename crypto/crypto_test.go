@@ -23,7 +23,10 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
 	"encoding/pem"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -191,3 +194,163 @@ hbOhaN5CWXjw0HQAZeXqjoswiWlVH0baBuwAPwFcdk5fG/KW60QvOYPExA==
 		assert.Equal(t, expected[key], pk.String())
 	})
 }
+
+func TestSignUserMessage(t *testing.T) {
+	seed := make([]byte, crypto.MinSeedLength)
+	_, err := rand.Read(seed)
+	require.NoError(t, err)
+
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, seed)
+	require.NoError(t, err)
+	publicKey := privateKey.PublicKey()
+
+	signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+	require.NoError(t, err)
+
+	message := []byte("hello")
+	sig, err := crypto.SignUserMessage(signer, message)
+	require.NoError(t, err)
+
+	tag := make([]byte, 32)
+	copy(tag, "FLOW-V0.0-user")
+	assert.Equal(t, tag, crypto.UserDomainTag[:])
+
+	hasher, err := crypto.NewHasher(crypto.SHA3_256)
+	require.NoError(t, err)
+
+	valid, err := publicKey.Verify(sig, append(tag, message...), hasher)
+	require.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestSignatureFromDER(t *testing.T) {
+	goKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	rawPublicKey := make([]byte, 64)
+	goKey.X.FillBytes(rawPublicKey[:32])
+	goKey.Y.FillBytes(rawPublicKey[32:])
+	publicKey, err := crypto.DecodePublicKey(crypto.ECDSA_P256, rawPublicKey)
+	require.NoError(t, err)
+
+	message := []byte("hello")
+	hasher := crypto.NewSHA2_256()
+	digest := hasher.ComputeHash(message)
+
+	der, err := ecdsa.SignASN1(rand.Reader, goKey, digest)
+	require.NoError(t, err)
+
+	var parsed struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(der, &parsed)
+	require.NoError(t, err)
+
+	n := elliptic.P256().Params().N
+	lowS := parsed.S
+	if lowS.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		lowS = new(big.Int).Sub(n, lowS)
+	}
+	highS := new(big.Int).Sub(n, lowS)
+
+	encode := func(s *big.Int) []byte {
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{parsed.R, s})
+		require.NoError(t, err)
+		return der
+	}
+
+	lowSig, err := crypto.SignatureFromDER(encode(lowS), crypto.ECDSA_P256)
+	require.NoError(t, err)
+	require.Len(t, lowSig, 64)
+
+	valid, err := publicKey.Verify(lowSig, message, hasher)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	highSig, err := crypto.SignatureFromDER(encode(highS), crypto.ECDSA_P256)
+	require.NoError(t, err)
+	assert.Equal(t, lowSig, highSig)
+
+	_, err = crypto.SignatureFromDER([]byte{1, 2, 3}, crypto.ECDSA_P256)
+	assert.Error(t, err)
+
+	_, err = crypto.SignatureFromDER(encode(n), crypto.ECDSA_P256)
+	assert.Error(t, err)
+
+	_, err = crypto.SignatureFromDER(der, crypto.UnknownSignatureAlgorithm)
+	assert.Error(t, err)
+}
+
+func TestNormalizeSignature(t *testing.T) {
+	n := elliptic.P256().Params().N
+	halfN := new(big.Int).Rsh(n, 1)
+
+	raw := func(r, s *big.Int) []byte {
+		sig := make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+		return sig
+	}
+
+	r := big.NewInt(42)
+	lowS := new(big.Int).Sub(halfN, big.NewInt(1))
+	highS := new(big.Int).Sub(n, lowS)
+
+	lowSig, err := crypto.NormalizeSignature(raw(r, lowS), crypto.ECDSA_P256)
+	require.NoError(t, err)
+	assert.Equal(t, raw(r, lowS), lowSig)
+
+	highSig, err := crypto.NormalizeSignature(raw(r, highS), crypto.ECDSA_P256)
+	require.NoError(t, err)
+	assert.Equal(t, lowSig, highSig)
+
+	_, err = crypto.NormalizeSignature([]byte{1, 2, 3}, crypto.ECDSA_P256)
+	assert.Error(t, err)
+
+	_, err = crypto.NormalizeSignature(raw(big.NewInt(0), lowS), crypto.ECDSA_P256)
+	assert.Error(t, err)
+
+	_, err = crypto.NormalizeSignature(raw(r, lowS), crypto.UnknownSignatureAlgorithm)
+	assert.Error(t, err)
+}
+
+func TestDerivePrivateKey(t *testing.T) {
+	secret := make([]byte, crypto.MinSeedLength)
+	for i := range secret {
+		secret[i] = byte(i)
+	}
+
+	kdfs := map[string]crypto.KeyDerivationFunction{
+		"HKDF SHA2-256": crypto.HKDF_SHA2_256(nil),
+		"HKDF SHA2-512": crypto.HKDF_SHA2_512([]byte("salt")),
+		"HKDF SHA3-256": crypto.HKDF_SHA3_256(nil),
+		"KMAC128":       crypto.KMAC128([]byte("Flow user keys")),
+	}
+
+	derived := make(map[string]bool)
+	for name, kdf := range kdfs {
+		t.Run(name, func(t *testing.T) {
+			for _, sigAlgo := range []crypto.SignatureAlgorithm{crypto.ECDSA_P256, crypto.ECDSA_secp256k1} {
+				privateKey, err := crypto.DerivePrivateKey(sigAlgo, kdf, secret, []byte("user-1"))
+				require.NoError(t, err)
+				assert.Equal(t, sigAlgo, privateKey.Algorithm())
+
+				same, err := crypto.DerivePrivateKey(sigAlgo, kdf, secret, []byte("user-1"))
+				require.NoError(t, err)
+				assert.True(t, privateKey.Equals(same))
+
+				other, err := crypto.DerivePrivateKey(sigAlgo, kdf, secret, []byte("user-2"))
+				require.NoError(t, err)
+				assert.False(t, privateKey.Equals(other))
+
+				encoded := hex.EncodeToString(privateKey.Encode())
+				assert.False(t, derived[encoded], "keys derived with different functions must differ")
+				derived[encoded] = true
+			}
+		})
+	}
+
+	_, err := crypto.DerivePrivateKey(crypto.ECDSA_P256, crypto.HKDF_SHA2_256(nil), secret[:16], nil)
+	assert.Error(t, err)
+
+	_, err = crypto.DerivePrivateKey(crypto.UnknownSignatureAlgorithm, crypto.HKDF_SHA2_256(nil), secret, nil)
+	assert.Error(t, err)
+}
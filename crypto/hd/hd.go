@@ -0,0 +1,232 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package hd derives Flow account keys from BIP-39 mnemonics.
+//
+// Keys are derived with SLIP-10, the generalization of BIP-32 to the NIST P-256 curve, along BIP-44
+// paths using Flow's registered coin type 539. This is the derivation used by the Flow CLI and the
+// Flow Ledger application, so keys recovered from the same mnemonic and path match theirs.
+//
+// References:
+//   - BIP-39: https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki
+//   - BIP-44: https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki
+//   - SLIP-10: https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+const (
+	// FlowCoinType is the BIP-44 coin type registered for Flow in SLIP-44.
+	FlowCoinType = 539
+
+	// DefaultPath is the derivation path of the first key of the first account,
+	// used by default by the Flow CLI.
+	DefaultPath = "m/44'/539'/0'/0/0"
+
+	// HardenedOffset is added to the indexes of hardened path components.
+	HardenedOffset uint32 = 0x80000000
+)
+
+var (
+	// orders of the 2 supported curves (https://www.secg.org/sec2-v2.pdf)
+	curveOrderP256, _      = new(big.Int).SetString("FFFFFFFF00000000FFFFFFFFFFFFFFFFBCE6FAADA7179E84F3B9CAC2FC632551", 16)
+	curveOrderSECP256K1, _ = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", 16)
+)
+
+// Path returns the BIP-44 derivation path of a Flow key: m/44'/539'/account'/0/index.
+func Path(account uint32, index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/%d'/0/%d", FlowCoinType, account, index)
+}
+
+// ParsePath parses a derivation path such as m/44'/539'/0'/0/0 into its component indexes.
+//
+// Hardened components are marked with ' or h, and their indexes include HardenedOffset.
+func ParsePath(path string) ([]uint32, error) {
+	components := strings.Split(strings.TrimSpace(path), "/")
+	if components[0] != "m" {
+		return nil, fmt.Errorf("hd: invalid derivation path %s, expected a path starting with m", path)
+	}
+
+	indexes := make([]uint32, 0, len(components)-1)
+	for _, component := range components[1:] {
+		hardened := strings.HasSuffix(component, "'") || strings.HasSuffix(component, "h")
+		if hardened {
+			component = component[:len(component)-1]
+		}
+
+		index, err := strconv.ParseUint(component, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("hd: invalid derivation path %s, invalid component %q", path, component)
+		}
+
+		if hardened {
+			index += uint64(HardenedOffset)
+		}
+		indexes = append(indexes, uint32(index))
+	}
+
+	return indexes, nil
+}
+
+// key is an extended private key: a private key scalar and a chain code.
+type key struct {
+	scalar    []byte
+	chainCode []byte
+}
+
+// curveParams returns the SLIP-10 master key HMAC key and the order of the curve of the signature algorithm.
+func curveParams(sigAlgo crypto.SignatureAlgorithm) ([]byte, *big.Int, error) {
+	switch sigAlgo {
+	case crypto.ECDSA_P256:
+		return []byte("Nist256p1 seed"), curveOrderP256, nil
+	case crypto.ECDSA_secp256k1:
+		return []byte("Bitcoin seed"), curveOrderSECP256K1, nil
+	default:
+		return nil, nil, fmt.Errorf("hd: only ECDSA algorithms are supported")
+	}
+}
+
+// DerivePrivateKey derives the private key at the derivation path from a seed, such as the seed
+// returned by MnemonicToSeed.
+//
+// The function only supports ECDSA with P256 and secp256k1 curves.
+func DerivePrivateKey(seed []byte, sigAlgo crypto.SignatureAlgorithm, path string) (crypto.PrivateKey, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	hmacKey, order, err := curveParams(sigAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	k := masterKey(seed, hmacKey, order)
+	for _, index := range indexes {
+		k, err = childKey(k, index, sigAlgo, order)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	privateKey, err := crypto.DecodePrivateKey(sigAlgo, k.scalar)
+	if err != nil {
+		return nil, fmt.Errorf("hd: failed to decode derived private key: %w", err)
+	}
+
+	return privateKey, nil
+}
+
+// PrivateKeyFromMnemonic derives the private key at the derivation path from a BIP-39 mnemonic
+// and its optional passphrase.
+func PrivateKeyFromMnemonic(
+	mnemonic string,
+	passphrase string,
+	sigAlgo crypto.SignatureAlgorithm,
+	path string,
+) (crypto.PrivateKey, error) {
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return DerivePrivateKey(seed, sigAlgo, path)
+}
+
+// masterKey derives the master key from the seed.
+func masterKey(seed []byte, hmacKey []byte, order *big.Int) key {
+	data := seed
+	for {
+		mac := hmac.New(sha512.New, hmacKey)
+		mac.Write(data)
+		i := mac.Sum(nil)
+
+		// invalid master keys are retried with the HMAC output as data
+		scalar := new(big.Int).SetBytes(i[:32])
+		if scalar.Sign() != 0 && scalar.Cmp(order) < 0 {
+			return key{scalar: i[:32], chainCode: i[32:]}
+		}
+		data = i
+	}
+}
+
+// childKey derives the child private key at the index.
+func childKey(parent key, index uint32, sigAlgo crypto.SignatureAlgorithm, order *big.Int) (key, error) {
+	var data []byte
+	if index >= HardenedOffset {
+		data = append([]byte{0}, parent.scalar...)
+	} else {
+		publicKey, err := compressedPublicKey(sigAlgo, parent.scalar)
+		if err != nil {
+			return key{}, err
+		}
+		data = publicKey
+	}
+	data = append(data, ser32(index)...)
+
+	parentScalar := new(big.Int).SetBytes(parent.scalar)
+	for {
+		mac := hmac.New(sha512.New, parent.chainCode)
+		mac.Write(data)
+		i := mac.Sum(nil)
+
+		tweak := new(big.Int).SetBytes(i[:32])
+		scalar := new(big.Int).Add(tweak, parentScalar)
+		scalar.Mod(scalar, order)
+
+		// invalid child keys are retried with the right part of the HMAC output
+		if tweak.Cmp(order) < 0 && scalar.Sign() != 0 {
+			return key{scalar: scalar.FillBytes(make([]byte, 32)), chainCode: i[32:]}, nil
+		}
+		data = append([]byte{1}, i[32:]...)
+		data = append(data, ser32(index)...)
+	}
+}
+
+// compressedPublicKey returns the SEC 1 compressed encoding of the public key of a private key scalar.
+func compressedPublicKey(sigAlgo crypto.SignatureAlgorithm, scalar []byte) ([]byte, error) {
+	privateKey, err := crypto.DecodePrivateKey(sigAlgo, scalar)
+	if err != nil {
+		return nil, fmt.Errorf("hd: failed to decode private key: %w", err)
+	}
+
+	// the Flow encoding of public keys is the X and Y coordinates concatenated
+	point := privateKey.PublicKey().Encode()
+
+	compressed := make([]byte, 33)
+	compressed[0] = 0x02 | point[63]&1
+	copy(compressed[1:], point[:32])
+	return compressed, nil
+}
+
+// ser32 serializes the index as a 4-byte big endian integer.
+func ser32(index uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, index)
+	return b
+}
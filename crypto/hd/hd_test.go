@@ -0,0 +1,148 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hd_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/crypto/hd"
+)
+
+// BIP-39 test vectors: https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+var mnemonicVectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth title",
+		seed:     "bc09fca1804f7e69da93c2f2028eb238c227f2e9dda30cd63699232578480a4021b146ad717fbb7e451ce9eb835f43620bf5c514db0f8add49f5d121449d3e87",
+	},
+}
+
+func TestMnemonic(t *testing.T) {
+	for _, vector := range mnemonicVectors {
+		entropy, err := hex.DecodeString(vector.entropy)
+		require.NoError(t, err)
+
+		mnemonic, err := hd.NewMnemonicFromEntropy(entropy)
+		require.NoError(t, err)
+		assert.Equal(t, vector.mnemonic, mnemonic)
+
+		decoded, err := hd.MnemonicToEntropy(mnemonic)
+		require.NoError(t, err)
+		assert.Equal(t, entropy, decoded)
+
+		seed, err := hd.MnemonicToSeed(mnemonic, "TREZOR")
+		require.NoError(t, err)
+		assert.Equal(t, vector.seed, hex.EncodeToString(seed))
+	}
+
+	t.Run("Random", func(t *testing.T) {
+		mnemonic, err := hd.NewMnemonic(256)
+		require.NoError(t, err)
+		assert.Len(t, strings.Fields(mnemonic), 24)
+		assert.NoError(t, hd.ValidateMnemonic(mnemonic))
+
+		_, err = hd.NewMnemonic(100)
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		// invalid checksum
+		err := hd.ValidateMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+		assert.Error(t, err)
+
+		// unknown word
+		err = hd.ValidateMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon flow")
+		assert.Error(t, err)
+
+		// invalid length
+		err = hd.ValidateMnemonic("abandon about")
+		assert.Error(t, err)
+	})
+}
+
+func TestParsePath(t *testing.T) {
+	indexes, err := hd.ParsePath(hd.DefaultPath)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{44 + hd.HardenedOffset, 539 + hd.HardenedOffset, hd.HardenedOffset, 0, 0}, indexes)
+
+	assert.Equal(t, "m/44'/539'/1'/0/2", hd.Path(1, 2))
+
+	_, err = hd.ParsePath("44'/539'")
+	assert.Error(t, err)
+
+	_, err = hd.ParsePath("m/2147483648")
+	assert.Error(t, err)
+}
+
+func TestDerivePrivateKey(t *testing.T) {
+	// SLIP-10 test vector 1: https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	vectors := []struct {
+		sigAlgo    crypto.SignatureAlgorithm
+		path       string
+		privateKey string
+	}{
+		{crypto.ECDSA_secp256k1, "m", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{crypto.ECDSA_secp256k1, "m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{crypto.ECDSA_secp256k1, "m/0'/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{crypto.ECDSA_P256, "m", "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"},
+		{crypto.ECDSA_P256, "m/0'", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		{crypto.ECDSA_P256, "m/0'/1", "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"},
+	}
+
+	for _, vector := range vectors {
+		privateKey, err := hd.DerivePrivateKey(seed, vector.sigAlgo, vector.path)
+		require.NoError(t, err)
+		assert.Equal(t, vector.privateKey, hex.EncodeToString(privateKey.Encode()), "%s %s", vector.sigAlgo, vector.path)
+	}
+
+	_, err = hd.DerivePrivateKey(seed, crypto.UnknownSignatureAlgorithm, hd.DefaultPath)
+	assert.Error(t, err)
+}
+
+func TestPrivateKeyFromMnemonic(t *testing.T) {
+	mnemonic := mnemonicVectors[0].mnemonic
+
+	privateKey, err := hd.PrivateKeyFromMnemonic(mnemonic, "", crypto.ECDSA_P256, hd.DefaultPath)
+	require.NoError(t, err)
+
+	other, err := hd.PrivateKeyFromMnemonic(mnemonic, "", crypto.ECDSA_P256, hd.Path(0, 1))
+	require.NoError(t, err)
+	assert.NotEqual(t, privateKey.Encode(), other.Encode())
+
+	_, err = hd.PrivateKeyFromMnemonic("abandon about", "", crypto.ECDSA_P256, hd.DefaultPath)
+	assert.Error(t, err)
+}
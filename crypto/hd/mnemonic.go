@@ -0,0 +1,162 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hd
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// english.txt is the BIP-39 English wordlist: https://github.com/bitcoin/bips/blob/master/bip-0039/english.txt
+//
+//go:embed english.txt
+var englishWordList string
+
+var (
+	wordList    = strings.Split(strings.TrimSpace(englishWordList), "\n")
+	wordIndexes = make(map[string]int, len(wordList))
+)
+
+func init() {
+	for i, word := range wordList {
+		wordIndexes[word] = i
+	}
+}
+
+const (
+	// seedIterations is the number of PBKDF2 iterations used to derive the seed from a mnemonic.
+	seedIterations = 2048
+	// seedLength is the length in bytes of the seed derived from a mnemonic.
+	seedLength = 64
+)
+
+// NewMnemonic generates a random BIP-39 mnemonic with the given entropy size in bits.
+//
+// The entropy size must be a multiple of 32 between 128 and 256, resulting in mnemonics of 12 to 24 words.
+// Flow wallets use mnemonics of 12 or 24 words.
+func NewMnemonic(bits int) (string, error) {
+	if err := checkEntropySize(bits); err != nil {
+		return "", err
+	}
+
+	entropy := make([]byte, bits/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", fmt.Errorf("hd: failed to generate entropy: %w", err)
+	}
+
+	return NewMnemonicFromEntropy(entropy)
+}
+
+// NewMnemonicFromEntropy encodes the entropy as a BIP-39 mnemonic.
+func NewMnemonicFromEntropy(entropy []byte) (string, error) {
+	bits := len(entropy) * 8
+	if err := checkEntropySize(bits); err != nil {
+		return "", err
+	}
+
+	// the checksum is the first bits/32 bits of the entropy hash, appended to the entropy
+	checksumBits := bits / 32
+	hash := sha256.Sum256(entropy)
+
+	data := new(big.Int).SetBytes(entropy)
+	data.Lsh(data, uint(checksumBits))
+	data.Or(data, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+
+	// each word encodes 11 bits, starting from the most significant bits
+	wordCount := (bits + checksumBits) / 11
+	words := make([]string, wordCount)
+	mask := big.NewInt(2047)
+	for i := wordCount - 1; i >= 0; i-- {
+		index := new(big.Int).And(data, mask).Int64()
+		words[i] = wordList[index]
+		data.Rsh(data, 11)
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// MnemonicToEntropy decodes a BIP-39 mnemonic and returns its entropy.
+//
+// An error is returned if the mnemonic contains an unknown word or if its checksum is invalid.
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+
+	wordCount := len(words)
+	if wordCount < 12 || wordCount > 24 || wordCount%3 != 0 {
+		return nil, fmt.Errorf("hd: invalid mnemonic length %d, expected 12, 15, 18, 21 or 24 words", wordCount)
+	}
+
+	data := new(big.Int)
+	for _, word := range words {
+		index, ok := wordIndexes[word]
+		if !ok {
+			return nil, fmt.Errorf("hd: invalid mnemonic word %q", word)
+		}
+		data.Lsh(data, 11)
+		data.Or(data, big.NewInt(int64(index)))
+	}
+
+	checksumBits := wordCount * 11 / 33
+	checksum := new(big.Int).And(data, big.NewInt(int64(1<<checksumBits-1)))
+	data.Rsh(data, uint(checksumBits))
+
+	entropy := make([]byte, checksumBits*4)
+	data.FillBytes(entropy)
+
+	hash := sha256.Sum256(entropy)
+	if checksum.Int64() != int64(hash[0]>>(8-checksumBits)) {
+		return nil, fmt.Errorf("hd: invalid mnemonic checksum")
+	}
+
+	return entropy, nil
+}
+
+// ValidateMnemonic checks the words and the checksum of a BIP-39 mnemonic.
+func ValidateMnemonic(mnemonic string) error {
+	_, err := MnemonicToEntropy(mnemonic)
+	return err
+}
+
+// MnemonicToSeed validates a BIP-39 mnemonic and derives the seed of the key tree from it,
+// using the optional passphrase.
+func MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+
+	password := norm.NFKD.String(strings.Join(strings.Fields(mnemonic), " "))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+
+	return pbkdf2.Key([]byte(password), []byte(salt), seedIterations, seedLength, sha512.New), nil
+}
+
+func checkEntropySize(bits int) error {
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return fmt.Errorf("hd: invalid entropy size %d, expected a multiple of 32 between 128 and 256", bits)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	gohash "hash"
+	"io"
+
+	"github.com/onflow/flow-go/crypto"
+	"github.com/onflow/flow-go/crypto/hash"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/sha3"
+)
+
+// A KeyDerivationFunction derives key generation seeds from a master secret and a context.
+//
+// Different contexts must result in independent seeds, so a single master secret can be
+// used to derive many keys.
+type KeyDerivationFunction interface {
+	// DeriveSeed derives a seed of the given length from the secret and the context.
+	DeriveSeed(secret []byte, context []byte, length int) ([]byte, error)
+}
+
+type hkdfFunction struct {
+	hash func() gohash.Hash
+	salt []byte
+}
+
+// HKDF returns the HMAC-based key derivation function defined in RFC 5869, with the given hash
+// function and optional salt. The context is used as the HKDF info parameter.
+func HKDF(hash func() gohash.Hash, salt []byte) KeyDerivationFunction {
+	return hkdfFunction{hash: hash, salt: salt}
+}
+
+// HKDF_SHA2_256 returns HKDF with SHA2-256 and the given optional salt.
+func HKDF_SHA2_256(salt []byte) KeyDerivationFunction {
+	return HKDF(sha256.New, salt)
+}
+
+// HKDF_SHA2_512 returns HKDF with SHA2-512 and the given optional salt.
+func HKDF_SHA2_512(salt []byte) KeyDerivationFunction {
+	return HKDF(sha512.New, salt)
+}
+
+// HKDF_SHA3_256 returns HKDF with SHA3-256 and the given optional salt.
+func HKDF_SHA3_256(salt []byte) KeyDerivationFunction {
+	return HKDF(sha3.New256, salt)
+}
+
+func (f hkdfFunction) DeriveSeed(secret []byte, context []byte, length int) ([]byte, error) {
+	seed := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(f.hash, secret, f.salt, context), seed); err != nil {
+		return nil, fmt.Errorf("crypto: failed to derive seed with HKDF: %w", err)
+	}
+	return seed, nil
+}
+
+type kmacFunction struct {
+	tag []byte
+}
+
+// KMAC128 returns a key derivation function based on KMAC128, the function used by GeneratePrivateKey to
+// expand seeds. The secret is used as the KMAC key, the tag as the KMAC customization string
+// and the context as the input.
+func KMAC128(tag []byte) KeyDerivationFunction {
+	return kmacFunction{tag: tag}
+}
+
+func (f kmacFunction) DeriveSeed(secret []byte, context []byte, length int) ([]byte, error) {
+	kmac, err := hash.NewKMAC_128(secret, f.tag, length)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: failed to derive seed with KMAC128: %w", err)
+	}
+	return kmac.ComputeHash(context), nil
+}
+
+// DerivePrivateKey deterministically derives a private key with the specified signature algorithm from a
+// master secret and a context, such as a user ID.
+//
+// The same secret, context and key derivation function always derive the same key, and different contexts
+// derive independent keys. The secret must be at least MinSeedLength bytes long and must be kept secret,
+// since all the keys can be derived from it.
+func DerivePrivateKey(
+	sigAlgo SignatureAlgorithm,
+	kdf KeyDerivationFunction,
+	secret []byte,
+	context []byte,
+) (PrivateKey, error) {
+	if len(secret) < MinSeedLength {
+		return nil, fmt.Errorf(
+			"crypto: insufficient secret length %d, must be at least %d bytes",
+			len(secret),
+			MinSeedLength,
+		)
+	}
+
+	seedLen, err := keyGenerationSeedLength(sigAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := kdf.DeriveSeed(secret, context, seedLen)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.GeneratePrivateKey(sigAlgo, seed)
+}
@@ -0,0 +1,366 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package keystore stores private keys in password encrypted key files.
+//
+// Key files are JSON documents holding the private key encrypted with AES-256-GCM, under a key
+// derived from the password with scrypt or Argon2id. The public key and the signature algorithm
+// are stored in clear, so key files can be identified without the password.
+//
+// LoadKey also reads the plain hex key files written by the Flow CLI, so applications can accept
+// both formats and migrate keys with SaveKey.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// Version is the version of the key file format.
+const Version = 1
+
+const (
+	kdfScrypt   = "scrypt"
+	kdfArgon2id = "argon2id"
+
+	cipherAES256GCM = "aes-256-gcm"
+
+	// derivedKeyLength is the length of the AES-256 key derived from the password.
+	derivedKeyLength = 32
+	// saltLength is the length of the random salts.
+	saltLength = 32
+
+	// maxScryptN is the largest scrypt cost accepted, key files are read from untrusted sources
+	// and the cost is paid before the password is checked.
+	maxScryptN = 1 << 20
+	// maxScryptMemory is the largest scrypt memory accepted, computed as 128 * N * r * p bytes (1 GiB).
+	maxScryptMemory = 1 << 30
+	// maxArgon2Time is the largest number of Argon2id passes accepted.
+	maxArgon2Time = 100
+	// maxArgon2Memory is the largest Argon2id memory accepted, in KiB (1 GiB).
+	maxArgon2Memory = 1024 * 1024
+)
+
+// ErrDecrypt is returned when a key file can't be decrypted, because the password is wrong
+// or the key file has been modified.
+var ErrDecrypt = errors.New("keystore: could not decrypt key with given password")
+
+// keyFile is the JSON encoding of a key file.
+type keyFile struct {
+	Version            int          `json:"version"`
+	SignatureAlgorithm string       `json:"signatureAlgorithm"`
+	PublicKey          string       `json:"publicKey"`
+	Crypto             cryptoParams `json:"crypto"`
+}
+
+type cryptoParams struct {
+	KDF        string    `json:"kdf"`
+	KDFParams  kdfParams `json:"kdfparams"`
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`
+	Ciphertext string    `json:"ciphertext"`
+}
+
+// kdfParams are the parameters of the key derivation function, the fields depend on the function.
+type kdfParams struct {
+	Salt string `json:"salt"`
+	// scrypt parameters
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+	// Argon2id parameters, the memory is in KiB
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+type options struct {
+	kdf    string
+	params kdfParams
+}
+
+// An Option configures the encryption of a key file.
+type Option func(o *options)
+
+// WithScrypt derives the encryption key with scrypt and the given cost parameters.
+//
+// This is the default, with N = 2^18, r = 8 and p = 1. N must be a power of two up to 2^20,
+// r * p less than 2^30 and the memory, 128 * N * r * p bytes, at most 1 GiB.
+func WithScrypt(n, r, p int) Option {
+	return func(o *options) {
+		o.kdf = kdfScrypt
+		o.params = kdfParams{N: n, R: r, P: p}
+	}
+}
+
+// WithArgon2id derives the encryption key with Argon2id and the given cost parameters,
+// the memory being in KiB. The time is limited to 100 passes and the memory to 1 GiB.
+func WithArgon2id(time, memory uint32, threads uint8) Option {
+	return func(o *options) {
+		o.kdf = kdfArgon2id
+		o.params = kdfParams{Time: time, Memory: memory, Threads: threads}
+	}
+}
+
+// Encrypt encrypts the private key with the password and returns the JSON encoded key file.
+func Encrypt(privateKey crypto.PrivateKey, password []byte, opts ...Option) ([]byte, error) {
+	o := options{
+		kdf:    kdfScrypt,
+		params: kdfParams{N: 1 << 18, R: 8, P: 1},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	kdf, params := o.kdf, o.params
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("keystore: failed to generate salt: %w", err)
+	}
+	params.Salt = hex.EncodeToString(salt)
+
+	derivedKey, err := deriveKey(kdf, params, password)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("keystore: failed to generate nonce: %w", err)
+	}
+
+	sigAlgo := privateKey.Algorithm().String()
+	ciphertext := aead.Seal(nil, nonce, privateKey.Encode(), []byte(sigAlgo))
+
+	return json.MarshalIndent(keyFile{
+		Version:            Version,
+		SignatureAlgorithm: sigAlgo,
+		PublicKey:          hex.EncodeToString(privateKey.PublicKey().Encode()),
+		Crypto: cryptoParams{
+			KDF:        kdf,
+			KDFParams:  params,
+			Cipher:     cipherAES256GCM,
+			Nonce:      hex.EncodeToString(nonce),
+			Ciphertext: hex.EncodeToString(ciphertext),
+		},
+	}, "", "  ")
+}
+
+// Decrypt decrypts a JSON encoded key file with the password.
+//
+// ErrDecrypt is returned if the password is wrong.
+func Decrypt(data []byte, password []byte) (crypto.PrivateKey, error) {
+	var file keyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("keystore: failed to decode key file: %w", err)
+	}
+
+	if file.Version != Version {
+		return nil, fmt.Errorf("keystore: unsupported key file version %d", file.Version)
+	}
+	if file.Crypto.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("keystore: unsupported cipher %s", file.Crypto.Cipher)
+	}
+
+	sigAlgo := crypto.StringToSignatureAlgorithm(file.SignatureAlgorithm)
+	if sigAlgo == crypto.UnknownSignatureAlgorithm {
+		return nil, fmt.Errorf("keystore: unsupported signature algorithm %s", file.SignatureAlgorithm)
+	}
+
+	nonce, err := hex.DecodeString(file.Crypto.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: failed to decode nonce: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(file.Crypto.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: failed to decode ciphertext: %w", err)
+	}
+
+	derivedKey, err := deriveKey(file.Crypto.KDF, file.Crypto.KDFParams, password)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("keystore: invalid nonce length %d", len(nonce))
+	}
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(file.SignatureAlgorithm))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	defer zero(plaintext)
+
+	privateKey, err := crypto.DecodePrivateKey(sigAlgo, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: failed to decode private key: %w", err)
+	}
+
+	if file.PublicKey != hex.EncodeToString(privateKey.PublicKey().Encode()) {
+		return nil, fmt.Errorf("keystore: private key doesn't match the public key of the key file")
+	}
+
+	return privateKey, nil
+}
+
+// SaveKey encrypts the private key with the password and writes the key file at the path.
+//
+// The file is only readable and writable by the current user.
+func SaveKey(path string, privateKey crypto.PrivateKey, password []byte, opts ...Option) error {
+	data, err := Encrypt(privateKey, password, opts...)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("keystore: failed to write key file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadKey reads the key file at the path and decrypts it with the password.
+//
+// Plain hex key files, as written by the Flow CLI, are also supported: they are decoded with
+// the given signature algorithm and the password is ignored. For encrypted key files, the signature
+// algorithm is read from the file and must match the given one, unless it is unknown.
+func LoadKey(path string, password []byte, sigAlgo crypto.SignatureAlgorithm) (crypto.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: failed to read key file: %w", err)
+	}
+
+	content := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(content, "{") {
+		privateKey, err := crypto.DecodePrivateKeyHex(sigAlgo, strings.TrimPrefix(content, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("keystore: failed to decode hex key file: %w", err)
+		}
+		return privateKey, nil
+	}
+
+	privateKey, err := Decrypt(data, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if sigAlgo != crypto.UnknownSignatureAlgorithm && privateKey.Algorithm() != sigAlgo {
+		return nil, fmt.Errorf("keystore: key file algorithm %s doesn't match %s", privateKey.Algorithm(), sigAlgo)
+	}
+
+	return privateKey, nil
+}
+
+// deriveKey derives the encryption key from the password.
+func deriveKey(kdf string, params kdfParams, password []byte) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: failed to decode salt: %w", err)
+	}
+
+	switch kdf {
+	case kdfScrypt:
+		if err := validateScrypt(params); err != nil {
+			return nil, err
+		}
+		key, err := scrypt.Key(password, salt, params.N, params.R, params.P, derivedKeyLength)
+		if err != nil {
+			return nil, fmt.Errorf("keystore: invalid scrypt parameters: %w", err)
+		}
+		return key, nil
+	case kdfArgon2id:
+		if err := validateArgon2id(params); err != nil {
+			return nil, err
+		}
+		return argon2.IDKey(password, salt, params.Time, params.Memory, params.Threads, derivedKeyLength), nil
+	default:
+		return nil, fmt.Errorf("keystore: unsupported key derivation function %s", kdf)
+	}
+}
+
+// validateScrypt checks the scrypt parameters before deriving, so a key file can't make the
+// derivation panic or use unbounded memory.
+func validateScrypt(params kdfParams) error {
+	if params.N <= 1 || params.N&(params.N-1) != 0 {
+		return fmt.Errorf("keystore: invalid scrypt parameters: N must be a power of two greater than 1, got %d", params.N)
+	}
+	if params.N > maxScryptN {
+		return fmt.Errorf("keystore: invalid scrypt parameters: N must be at most %d, got %d", maxScryptN, params.N)
+	}
+	if params.R <= 0 || params.P <= 0 {
+		return fmt.Errorf("keystore: invalid scrypt parameters: r and p must be positive, got r = %d and p = %d", params.R, params.P)
+	}
+	if uint64(params.R)*uint64(params.P) >= 1<<30 {
+		return fmt.Errorf("keystore: invalid scrypt parameters: r * p must be less than 2^30, got r = %d and p = %d", params.R, params.P)
+	}
+	if memory := 128 * uint64(params.N) * uint64(params.R) * uint64(params.P); memory > maxScryptMemory {
+		return fmt.Errorf("keystore: invalid scrypt parameters: memory must be at most %d bytes, got %d", maxScryptMemory, memory)
+	}
+	return nil
+}
+
+// validateArgon2id checks the Argon2id parameters before deriving, so a key file can't make the
+// derivation run for too long or use unbounded memory.
+func validateArgon2id(params kdfParams) error {
+	if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+		return fmt.Errorf("keystore: invalid argon2id parameters: time, memory and threads must be positive")
+	}
+	if params.Time > maxArgon2Time {
+		return fmt.Errorf("keystore: invalid argon2id parameters: time must be at most %d, got %d", maxArgon2Time, params.Time)
+	}
+	if params.Memory > maxArgon2Memory {
+		return fmt.Errorf("keystore: invalid argon2id parameters: memory must be at most %d KiB, got %d", maxArgon2Memory, params.Memory)
+	}
+	return nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// zero overwrites the key material in b.
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
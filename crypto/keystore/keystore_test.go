@@ -0,0 +1,148 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package keystore_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/crypto/keystore"
+)
+
+// lightScrypt keeps the tests fast, real key files should use the default parameters.
+var lightScrypt = keystore.WithScrypt(1<<10, 8, 1)
+
+func generateKey(t *testing.T, sigAlgo crypto.SignatureAlgorithm) crypto.PrivateKey {
+	seed := make([]byte, crypto.MinSeedLength)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	privateKey, err := crypto.GeneratePrivateKey(sigAlgo, seed)
+	require.NoError(t, err)
+	return privateKey
+}
+
+func TestEncrypt(t *testing.T) {
+	password := []byte("password")
+
+	for _, opt := range []keystore.Option{lightScrypt, keystore.WithArgon2id(1, 1024, 1)} {
+		privateKey := generateKey(t, crypto.ECDSA_secp256k1)
+
+		data, err := keystore.Encrypt(privateKey, password, opt)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), hex.EncodeToString(privateKey.Encode()))
+
+		decrypted, err := keystore.Decrypt(data, password)
+		require.NoError(t, err)
+		assert.True(t, privateKey.Equals(decrypted))
+
+		_, err = keystore.Decrypt(data, []byte("wrong"))
+		assert.ErrorIs(t, err, keystore.ErrDecrypt)
+	}
+}
+
+func TestInvalidKDFParameters(t *testing.T) {
+	password := []byte("password")
+	privateKey := generateKey(t, crypto.ECDSA_P256)
+
+	for name, opt := range map[string]keystore.Option{
+		"scrypt N not a power of two": keystore.WithScrypt(1000, 8, 1),
+		"scrypt N too large":          keystore.WithScrypt(1<<21, 8, 1),
+		"scrypt r * p too large":      keystore.WithScrypt(1<<10, 1<<15, 1<<15),
+		"scrypt r not positive":       keystore.WithScrypt(1<<10, 0, 1),
+		"scrypt memory too large":     keystore.WithScrypt(1<<20, 16, 1),
+		"scrypt hostile r":            keystore.WithScrypt(2, 1<<29, 1),
+		"scrypt hostile p":            keystore.WithScrypt(1<<10, 8, 1<<20),
+		"argon2id time too large":     keystore.WithArgon2id(101, 1024, 1),
+		"argon2id memory too large":   keystore.WithArgon2id(1, 1024*1024+1, 1),
+		"argon2id zero threads":       keystore.WithArgon2id(1, 1024, 0),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := keystore.Encrypt(privateKey, password, opt)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("Key file", func(t *testing.T) {
+		data, err := keystore.Encrypt(privateKey, password, lightScrypt)
+		require.NoError(t, err)
+
+		var file map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &file))
+		file["crypto"].(map[string]interface{})["kdfparams"].(map[string]interface{})["n"] = 1 << 30
+		data, err = json.Marshal(file)
+		require.NoError(t, err)
+
+		_, err = keystore.Decrypt(data, password)
+		assert.ErrorContains(t, err, "N must be at most")
+	})
+
+	t.Run("Key file with hostile r", func(t *testing.T) {
+		data, err := keystore.Encrypt(privateKey, password, lightScrypt)
+		require.NoError(t, err)
+
+		var file map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &file))
+		file["crypto"].(map[string]interface{})["kdfparams"].(map[string]interface{})["r"] = 1 << 28
+		data, err = json.Marshal(file)
+		require.NoError(t, err)
+
+		_, err = keystore.Decrypt(data, password)
+		assert.ErrorContains(t, err, "memory must be at most")
+	})
+}
+
+func TestSaveKey(t *testing.T) {
+	dir := t.TempDir()
+	password := []byte("password")
+	privateKey := generateKey(t, crypto.ECDSA_P256)
+
+	t.Run("Encrypted", func(t *testing.T) {
+		path := filepath.Join(dir, "account.key")
+		require.NoError(t, keystore.SaveKey(path, privateKey, password, lightScrypt))
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		loaded, err := keystore.LoadKey(path, password, crypto.UnknownSignatureAlgorithm)
+		require.NoError(t, err)
+		assert.True(t, privateKey.Equals(loaded))
+
+		_, err = keystore.LoadKey(path, password, crypto.ECDSA_secp256k1)
+		assert.Error(t, err)
+	})
+
+	t.Run("Flow CLI hex key", func(t *testing.T) {
+		path := filepath.Join(dir, "emulator-account.pkey")
+		require.NoError(t, os.WriteFile(path, []byte("0x"+hex.EncodeToString(privateKey.Encode())+"\n"), 0600))
+
+		loaded, err := keystore.LoadKey(path, nil, crypto.ECDSA_P256)
+		require.NoError(t, err)
+		assert.True(t, privateKey.Equals(loaded))
+	})
+}
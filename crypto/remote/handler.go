@@ -0,0 +1,141 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// A Policy decides whether a message can be signed, for example by checking it is a transaction
+// for an allowed contract. An error rejects the request.
+type Policy func(r *http.Request, message []byte) error
+
+// HandlerOption configures a signer handler.
+type HandlerOption func(h *Handler)
+
+// WithHandlerToken requires the requests to be authenticated with the bearer token.
+func WithHandlerToken(token string) HandlerOption {
+	return func(h *Handler) {
+		h.token = token
+	}
+}
+
+// WithPolicy sets the policy deciding which messages are signed, by default all messages are signed.
+func WithPolicy(policy Policy) HandlerOption {
+	return func(h *Handler) {
+		h.policy = policy
+	}
+}
+
+// Handler serves the remote signing protocol for a signer.
+type Handler struct {
+	signer crypto.Signer
+	token  string
+	policy Policy
+	mux    *http.ServeMux
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// NewHandler returns an HTTP handler serving the remote signing protocol for the signer.
+//
+// The handler serves the protocol paths from its root, use http.StripPrefix to mount it under a prefix.
+func NewHandler(signer crypto.Signer, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		signer: signer,
+		mux:    http.NewServeMux(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	h.mux.HandleFunc(publicKeyPath, h.handlePublicKey)
+	h.mux.HandleFunc(signPath, h.handleSign)
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.token != "" {
+		expected := []byte("Bearer " + h.token)
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			writeError(w, http.StatusUnauthorized, fmt.Errorf("invalid token"))
+			return
+		}
+	}
+
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) handlePublicKey(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	publicKey := h.signer.PublicKey()
+	writeJSON(w, http.StatusOK, publicKeyResponse{
+		PublicKey:          hex.EncodeToString(publicKey.Encode()),
+		SignatureAlgorithm: publicKey.Algorithm().String(),
+	})
+}
+
+func (h *Handler) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	var request signRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxMessageSize)).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid sign request: %w", err))
+		return
+	}
+
+	if h.policy != nil {
+		if err := h.policy(r, request.Message); err != nil {
+			writeError(w, http.StatusForbidden, err)
+			return
+		}
+	}
+
+	sig, err := h.signer.Sign(request.Message)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("failed to sign: %w", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, signResponse{Signature: sig})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
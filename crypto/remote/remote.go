@@ -0,0 +1,56 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package remote delegates signing to a separate signer service over HTTP.
+//
+// The protocol has two JSON endpoints, relative to the base URL of the service:
+//
+//	GET  /public-key  returns {"publicKey": "<hex>", "signatureAlgorithm": "ECDSA_P256"}
+//	POST /sign        takes {"message": "<base64>"} and returns {"signature": "<base64>"}
+//
+// Errors are returned with a non-2xx status and an {"error": "<message>"} body. Requests are
+// authenticated with an optional bearer token.
+//
+// NewHandler serves the protocol for any crypto.Signer, so a signer service holding the keys
+// can be stood up with the SDK, and NewSigner returns a crypto.Signer calling the service.
+package remote
+
+const (
+	publicKeyPath = "/public-key"
+	signPath      = "/sign"
+
+	// maxMessageSize is the maximum size of the sign requests accepted by the handler.
+	maxMessageSize = 1 << 20
+)
+
+type publicKeyResponse struct {
+	PublicKey          string `json:"publicKey"`
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+}
+
+type signRequest struct {
+	Message []byte `json:"message"`
+}
+
+type signResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
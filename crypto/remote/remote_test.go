@@ -0,0 +1,92 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/crypto/remote"
+)
+
+func newInMemorySigner(t *testing.T) crypto.Signer {
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, bytes.Repeat([]byte{1}, crypto.MinSeedLength))
+	require.NoError(t, err)
+	privateKey.PublicKey()
+
+	signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+	require.NoError(t, err)
+	return signer
+}
+
+func TestSigner(t *testing.T) {
+	ctx := context.Background()
+	inMemorySigner := newInMemorySigner(t)
+
+	handler := remote.NewHandler(
+		inMemorySigner,
+		remote.WithHandlerToken("token"),
+		remote.WithPolicy(func(_ *http.Request, message []byte) error {
+			if bytes.HasPrefix(message, []byte("forbidden")) {
+				return fmt.Errorf("message is not allowed")
+			}
+			return nil
+		}),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/signer/", http.StripPrefix("/signer", handler))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("Sign", func(t *testing.T) {
+		signer, err := remote.NewSigner(ctx, server.URL+"/signer", remote.WithToken("token"))
+		require.NoError(t, err)
+		assert.True(t, inMemorySigner.PublicKey().Equals(signer.PublicKey()))
+
+		message := []byte("hello")
+		sig, err := signer.Sign(message)
+		require.NoError(t, err)
+
+		valid, err := signer.PublicKey().Verify(sig, message, crypto.NewSHA3_256())
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("Policy", func(t *testing.T) {
+		signer, err := remote.NewSigner(ctx, server.URL+"/signer", remote.WithToken("token"))
+		require.NoError(t, err)
+
+		_, err = signer.Sign([]byte("forbidden message"))
+		assert.ErrorContains(t, err, "message is not allowed")
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		_, err := remote.NewSigner(ctx, server.URL+"/signer", remote.WithToken("other"))
+		assert.ErrorContains(t, err, "invalid token")
+	})
+}
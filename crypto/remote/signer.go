@@ -0,0 +1,142 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// SignerOption configures a remote signer.
+type SignerOption func(s *Signer)
+
+// WithToken authenticates the requests with the bearer token.
+func WithToken(token string) SignerOption {
+	return func(s *Signer) {
+		s.token = token
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+func WithHTTPClient(httpClient *http.Client) SignerOption {
+	return func(s *Signer) {
+		s.httpClient = httpClient
+	}
+}
+
+var _ crypto.Signer = (*Signer)(nil)
+
+// Signer is a crypto.Signer delegating signing to a signer service.
+type Signer struct {
+	ctx        context.Context
+	url        string
+	token      string
+	httpClient *http.Client
+	// public key for easier access
+	publicKey crypto.PublicKey
+}
+
+// NewSigner returns a signer for the signer service at the base URL, fetching its public key.
+func NewSigner(ctx context.Context, url string, opts ...SignerOption) (*Signer, error) {
+	s := &Signer{
+		ctx:        ctx,
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	var response publicKeyResponse
+	if err := s.call(http.MethodGet, publicKeyPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("remote: failed to fetch public key: %w", err)
+	}
+
+	sigAlgo := crypto.StringToSignatureAlgorithm(response.SignatureAlgorithm)
+	if sigAlgo == crypto.UnknownSignatureAlgorithm {
+		return nil, fmt.Errorf("remote: unsupported signature algorithm %s", response.SignatureAlgorithm)
+	}
+
+	publicKey, err := crypto.DecodePublicKeyHex(sigAlgo, response.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("remote: failed to decode public key: %w", err)
+	}
+	s.publicKey = publicKey
+
+	return s, nil
+}
+
+// Sign sends the message to the signer service and returns the signature.
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	var response signResponse
+	if err := s.call(http.MethodPost, signPath, signRequest{Message: message}, &response); err != nil {
+		return nil, fmt.Errorf("remote: failed to sign: %w", err)
+	}
+	return response.Signature, nil
+}
+
+// PublicKey returns the public key of the signer service.
+func (s *Signer) PublicKey() crypto.PublicKey {
+	return s.publicKey
+}
+
+func (s *Signer) call(method string, path string, request interface{}, response interface{}) error {
+	var body io.Reader
+	if request != nil {
+		b, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(s.ctx, method, s.url+path, body)
+	if err != nil {
+		return err
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var errRes errorResponse
+		if json.NewDecoder(res.Body).Decode(&errRes) == nil && errRes.Error != "" {
+			return fmt.Errorf("signer service returned status %d: %s", res.StatusCode, errRes.Error)
+		}
+		return fmt.Errorf("signer service returned status %d", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(response)
+}
@@ -0,0 +1,113 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crypto
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// orders of the 2 supported curves (https://www.secg.org/sec2-v2.pdf)
+	curveOrderP256, _      = new(big.Int).SetString("FFFFFFFF00000000FFFFFFFFFFFFFFFFBCE6FAADA7179E84F3B9CAC2FC632551", 16)
+	curveOrderSECP256K1, _ = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", 16)
+)
+
+// ecdsaSignatureComponentLen is the size in bytes of the R and S components of signatures on the supported curves.
+const ecdsaSignatureComponentLen = 32
+
+// SignatureFromDER converts an ASN.1 DER encoded ECDSA signature, the format returned by most key management
+// services, into the raw format of Flow signatures: R and S left padded to 32 bytes and concatenated.
+//
+// The signature is canonicalized: S is replaced by N-S when it is in the upper half of the curve order N,
+// which produces an equivalent signature, so the same key and message always produce signatures of
+// the same form.
+//
+// The function only supports ECDSA with P256 and secp256k1 curves.
+func SignatureFromDER(der []byte, sigAlgo SignatureAlgorithm) ([]byte, error) {
+	order, err := curveOrder(sigAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig struct{ R, S *big.Int }
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: failed to parse DER signature: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("crypto: trailing data after DER signature")
+	}
+
+	return canonicalSignature(sig.R, sig.S, order)
+}
+
+// NormalizeSignature canonicalizes an ECDSA signature in the raw Flow format, R and S
+// left padded to 32 bytes and concatenated, the format returned by JWS based key management services.
+//
+// As with SignatureFromDER, S is replaced by N-S when it is in the upper half of the curve order N.
+//
+// The function only supports ECDSA with P256 and secp256k1 curves.
+func NormalizeSignature(sig []byte, sigAlgo SignatureAlgorithm) ([]byte, error) {
+	order, err := curveOrder(sigAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sig) != 2*ecdsaSignatureComponentLen {
+		return nil, fmt.Errorf("crypto: invalid signature length %d, expected %d", len(sig), 2*ecdsaSignatureComponentLen)
+	}
+
+	r := new(big.Int).SetBytes(sig[:ecdsaSignatureComponentLen])
+	s := new(big.Int).SetBytes(sig[ecdsaSignatureComponentLen:])
+
+	return canonicalSignature(r, s, order)
+}
+
+// curveOrder returns the order of the curve used by the signature algorithm.
+func curveOrder(sigAlgo SignatureAlgorithm) (*big.Int, error) {
+	switch sigAlgo {
+	case ECDSA_P256:
+		return curveOrderP256, nil
+	case ECDSA_secp256k1:
+		return curveOrderSECP256K1, nil
+	default:
+		return nil, fmt.Errorf("crypto: only ECDSA algorithms are supported")
+	}
+}
+
+// canonicalSignature checks the signature values are in range and encodes
+// the signature in the raw format with a low S value.
+func canonicalSignature(r, s, order *big.Int) ([]byte, error) {
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(order) >= 0 || s.Cmp(order) >= 0 {
+		return nil, fmt.Errorf("crypto: signature values are out of range")
+	}
+
+	halfOrder := new(big.Int).Rsh(order, 1)
+	if s.Cmp(halfOrder) > 0 {
+		s = new(big.Int).Sub(order, s)
+	}
+
+	signature := make([]byte, 2*ecdsaSignatureComponentLen)
+	r.FillBytes(signature[:ecdsaSignatureComponentLen])
+	s.FillBytes(signature[ecdsaSignatureComponentLen:])
+
+	return signature, nil
+}
@@ -0,0 +1,32 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crypto
+
+// UserDomainTag is the prefix of all signed user space payloads.
+//
+// A domain tag is encoded as UTF-8 bytes, right padded to a total length of 32 bytes.
+var UserDomainTag = [32]byte{'F', 'L', 'O', 'W', '-', 'V', '0', '.', '0', '-', 'u', 's', 'e', 'r'}
+
+// SignUserMessage signs a message in the user domain, prefixing it with the user domain tag.
+//
+// User messages are distinct from other signed messages (i.e. transactions), and can be
+// verified directly in on-chain Cadence code with the "FLOW-V0.0-user" domain separation tag.
+func SignUserMessage(signer Signer, message []byte) ([]byte, error) {
+	return signer.Sign(append(UserDomainTag[:], message...))
+}
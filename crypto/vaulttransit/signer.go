@@ -0,0 +1,94 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vaulttransit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+var _ crypto.Signer = (*Signer)(nil)
+
+// Signer is a Vault transit implementation of crypto.Signer.
+type Signer struct {
+	ctx    context.Context
+	client *Client
+	key    Key
+	// public key for easier access
+	publicKey crypto.PublicKey
+	// Hash algorithm associated to the transit key
+	hashAlgo crypto.HashAlgorithm
+}
+
+// SignerForKey returns a new Vault transit signer for a signing key.
+//
+// Only ECDSA keys on the P-256 curve and SHA2-256 are supported. If the key version is not set,
+// the signer uses the latest version at the time it is created, so rotating the transit key
+// doesn't change the public key of existing signers.
+func (c *Client) SignerForKey(ctx context.Context, key Key) (*Signer, error) {
+	if key.Version == 0 {
+		version, err := c.latestVersion(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("vaulttransit: failed to fetch key version: %w", err)
+		}
+		key.Version = version
+	}
+
+	pk, hashAlgo, err := c.GetPublicKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		ctx:       ctx,
+		client:    c,
+		key:       key,
+		publicKey: pk,
+		hashAlgo:  hashAlgo,
+	}, nil
+}
+
+// Sign signs the given message using the transit key for this signer.
+//
+// The message is hashed locally and only the digest is sent to Vault. The DER signature
+// returned by Vault is converted to the raw Flow format with a low S value.
+func (s *Signer) Sign(message []byte) ([]byte, error) {
+	hasher, err := crypto.NewHasher(s.hashAlgo)
+	if err != nil {
+		return nil, fmt.Errorf("vaulttransit: failed to sign: %w", err)
+	}
+
+	der, err := s.client.sign(s.ctx, s.key, hasher.ComputeHash(message))
+	if err != nil {
+		return nil, fmt.Errorf("vaulttransit: failed to sign: %w", err)
+	}
+
+	sig, err := crypto.SignatureFromDER(der, crypto.ECDSA_P256)
+	if err != nil {
+		return nil, fmt.Errorf("vaulttransit: failed to parse signature: %w", err)
+	}
+	return sig, nil
+}
+
+// PublicKey returns the public key of the transit key.
+func (s *Signer) PublicKey() crypto.PublicKey {
+	return s.publicKey
+}
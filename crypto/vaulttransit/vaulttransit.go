@@ -0,0 +1,305 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vaulttransit provides a HashiCorp Vault transit secrets engine
+// implementation of the crypto.Signer interface.
+//
+// The client calls the Vault HTTP API directly, authenticating the requests with a Vault token.
+//
+// The documentation for the transit secrets engine can be found here:
+// https://developer.hashicorp.com/vault/docs/secrets/transit
+package vaulttransit
+
+import (
+	"bytes"
+	"context"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+const (
+	// DefaultMount is the path the transit secrets engine is mounted at by default.
+	DefaultMount = "transit"
+
+	// keyTypeP256 is the only transit key type supported by the SDK,
+	// Vault doesn't support the secp256k1 curve.
+	keyTypeP256 = "ecdsa-p256"
+)
+
+// Key is a reference to a transit signing key.
+//
+// An empty mount references the default mount path, and a zero version
+// references the latest version of the key.
+type Key struct {
+	Mount   string `json:"mount"`
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+}
+
+func (k Key) mount() string {
+	if k.Mount == "" {
+		return DefaultMount
+	}
+	return strings.Trim(k.Mount, "/")
+}
+
+// ClientOption configures a Vault client.
+type ClientOption func(c *Client)
+
+// WithNamespace sets the Vault Enterprise namespace of the requests.
+func WithNamespace(namespace string) ClientOption {
+	return func(c *Client) {
+		c.namespace = namespace
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// Client is a client for interacting with the Vault transit secrets engine
+// using types native to the Flow Go SDK.
+type Client struct {
+	address    string
+	token      string
+	namespace  string
+	httpClient *http.Client
+}
+
+// NewClient creates a new Vault client for the server address, such as https://vault.example.com:8200.
+func NewClient(address string, token string, opts ...ClientOption) (*Client, error) {
+	if address == "" {
+		return nil, fmt.Errorf("vaulttransit: address is required")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("vaulttransit: token is required")
+	}
+
+	c := &Client{
+		address:    strings.TrimSuffix(address, "/"),
+		token:      token,
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// GetPublicKey fetches the public key of a transit key version and returns it in the Flow format,
+// along with the hash algorithm used by the key.
+//
+// Transit keys of the type `ecdsa-p256` are the only keys supported by the SDK.
+//
+// Ref: https://developer.hashicorp.com/vault/api-docs/secret/transit#read-key
+func (c *Client) GetPublicKey(ctx context.Context, key Key) (crypto.PublicKey, crypto.HashAlgorithm, error) {
+	var response struct {
+		Data struct {
+			Type          string `json:"type"`
+			LatestVersion int    `json:"latest_version"`
+			Keys          map[string]struct {
+				PublicKey string `json:"public_key"`
+			} `json:"keys"`
+		} `json:"data"`
+	}
+
+	err := c.call(ctx, http.MethodGet, fmt.Sprintf("%s/keys/%s", key.mount(), key.Name), nil, &response)
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("vaulttransit: failed to fetch public key from Vault API: %w", err)
+	}
+
+	if response.Data.Type != keyTypeP256 {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("vaulttransit: unsupported key type %s", response.Data.Type)
+	}
+
+	version := key.Version
+	if version == 0 {
+		version = response.Data.LatestVersion
+	}
+
+	keyVersion, ok := response.Data.Keys[strconv.Itoa(version)]
+	if !ok {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("vaulttransit: version %d of key %s not found", version, key.Name)
+	}
+
+	publicKey, err := crypto.DecodePublicKeyPEM(crypto.ECDSA_P256, keyVersion.PublicKey)
+	if err != nil {
+		return nil, crypto.UnknownHashAlgorithm, fmt.Errorf("vaulttransit: failed to parse public key: %w", err)
+	}
+
+	return publicKey, crypto.SHA2_256, nil
+}
+
+// Verify checks a Flow signature of the message with the transit key.
+//
+// The signature is verified by Vault, which is useful to check signatures made by keys
+// that have since been rotated.
+//
+// Ref: https://developer.hashicorp.com/vault/api-docs/secret/transit#verify-signed-data
+func (c *Client) Verify(ctx context.Context, key Key, message []byte, sig []byte) (bool, error) {
+	if len(sig) != 64 {
+		return false, fmt.Errorf("vaulttransit: invalid signature length %d", len(sig))
+	}
+
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(sig[:32]),
+		S: new(big.Int).SetBytes(sig[32:]),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	version := key.Version
+	if version == 0 {
+		// the signature must reference the key version, use the latest one
+		latest, err := c.latestVersion(ctx, key)
+		if err != nil {
+			return false, fmt.Errorf("vaulttransit: failed to verify: %w", err)
+		}
+		version = latest
+	}
+
+	request := map[string]interface{}{
+		"input":          base64.StdEncoding.EncodeToString(message),
+		"signature":      fmt.Sprintf("vault:v%d:%s", version, base64.StdEncoding.EncodeToString(der)),
+		"hash_algorithm": "sha2-256",
+	}
+
+	var response struct {
+		Data struct {
+			Valid bool `json:"valid"`
+		} `json:"data"`
+	}
+
+	err = c.call(ctx, http.MethodPost, fmt.Sprintf("%s/verify/%s", key.mount(), key.Name), request, &response)
+	if err != nil {
+		return false, fmt.Errorf("vaulttransit: failed to verify: %w", err)
+	}
+
+	return response.Data.Valid, nil
+}
+
+// latestVersion returns the latest version of the transit key.
+func (c *Client) latestVersion(ctx context.Context, key Key) (int, error) {
+	var response struct {
+		Data struct {
+			LatestVersion int `json:"latest_version"`
+		} `json:"data"`
+	}
+
+	err := c.call(ctx, http.MethodGet, fmt.Sprintf("%s/keys/%s", key.mount(), key.Name), nil, &response)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Data.LatestVersion, nil
+}
+
+// sign signs the digest with the transit key and returns the DER encoded signature.
+//
+// Ref: https://developer.hashicorp.com/vault/api-docs/secret/transit#sign-data
+func (c *Client) sign(ctx context.Context, key Key, digest []byte) ([]byte, error) {
+	request := map[string]interface{}{
+		"input":                base64.StdEncoding.EncodeToString(digest),
+		"prehashed":            true,
+		"hash_algorithm":       "sha2-256",
+		"marshaling_algorithm": "asn1",
+	}
+	if key.Version != 0 {
+		request["key_version"] = key.Version
+	}
+
+	var response struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+
+	err := c.call(ctx, http.MethodPost, fmt.Sprintf("%s/sign/%s", key.mount(), key.Name), request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	// signatures are formatted as vault:v<version>:<base64 signature>
+	parts := strings.SplitN(response.Data.Signature, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, fmt.Errorf("unexpected signature format")
+	}
+
+	return base64.StdEncoding.DecodeString(parts[2])
+}
+
+// call sends a request to a Vault API path and decodes the response.
+func (c *Client) call(ctx context.Context, method string, path string, request interface{}, response interface{}) error {
+	var body io.Reader
+	if request != nil {
+		b, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.address+"/v1/"+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", c.token)
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Errors []string `json:"errors"`
+		}
+		if json.Unmarshal(resBody, &apiErr) == nil && len(apiErr.Errors) > 0 {
+			return fmt.Errorf("request failed with status %d: %s", res.StatusCode, strings.Join(apiErr.Errors, ", "))
+		}
+		return fmt.Errorf("request failed with status %d", res.StatusCode)
+	}
+
+	return json.Unmarshal(resBody, response)
+}
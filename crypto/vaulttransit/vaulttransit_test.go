@@ -0,0 +1,166 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vaulttransit_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/crypto/vaulttransit"
+)
+
+// newVaultServer starts a fake Vault server with a transit key named flow mounted at flow-transit.
+func newVaultServer(t *testing.T, keyType string) *httptest.Server {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/flow-transit/keys/flow", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"type":           keyType,
+				"latest_version": 1,
+				"keys": map[string]interface{}{
+					"1": map[string]string{"public_key": string(publicKey)},
+				},
+			},
+		}))
+	})
+	mux.HandleFunc("/v1/flow-transit/sign/flow", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Input      string `json:"input"`
+			Prehashed  bool   `json:"prehashed"`
+			KeyVersion int    `json:"key_version"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.True(t, request.Prehashed)
+		assert.Equal(t, 1, request.KeyVersion)
+
+		digest, err := base64.StdEncoding.DecodeString(request.Input)
+		require.NoError(t, err)
+
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest)
+		require.NoError(t, err)
+
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]string{"signature": "vault:v1:" + base64.StdEncoding.EncodeToString(sig)},
+		}))
+	})
+	mux.HandleFunc("/v1/flow-transit/verify/flow", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Input     string `json:"input"`
+			Signature string `json:"signature"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.True(t, strings.HasPrefix(request.Signature, "vault:v1:"))
+
+		input, err := base64.StdEncoding.DecodeString(request.Input)
+		require.NoError(t, err)
+		sig, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(request.Signature, "vault:v1:"))
+		require.NoError(t, err)
+
+		digest := sha256.Sum256(input)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]bool{"valid": ecdsa.VerifyASN1(&key.PublicKey, digest[:], sig)},
+		}))
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSigner(t *testing.T) {
+	ctx := context.Background()
+	key := vaulttransit.Key{Mount: "flow-transit", Name: "flow"}
+
+	t.Run("Sign", func(t *testing.T) {
+		server := newVaultServer(t, "ecdsa-p256")
+
+		client, err := vaulttransit.NewClient(server.URL, "token")
+		require.NoError(t, err)
+
+		signer, err := client.SignerForKey(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.ECDSA_P256, signer.PublicKey().Algorithm())
+
+		message := []byte("hello")
+		sig, err := signer.Sign(message)
+		require.NoError(t, err)
+
+		valid, err := signer.PublicKey().Verify(sig, message, crypto.NewSHA2_256())
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = client.Verify(ctx, key, message, sig)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = client.Verify(ctx, key, []byte("other"), sig)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		server := newVaultServer(t, "ecdsa-p256")
+
+		client, err := vaulttransit.NewClient(server.URL, "other")
+		require.NoError(t, err)
+
+		_, err = client.SignerForKey(ctx, key)
+		assert.ErrorContains(t, err, "permission denied")
+	})
+
+	t.Run("Unsupported key type", func(t *testing.T) {
+		server := newVaultServer(t, "ed25519")
+
+		client, err := vaulttransit.NewClient(server.URL, "token")
+		require.NoError(t, err)
+
+		_, _, err = client.GetPublicKey(ctx, key)
+		assert.Error(t, err)
+	})
+}
@@ -37,6 +37,19 @@ const (
 	EventAccountContractRemoved string = "flow.AccountContractRemoved"
 )
 
+// EventFilter selects the events delivered by an event subscription.
+//
+// An event matches the filter if it matches any of the event types, addresses or contracts.
+// An empty filter matches all events.
+type EventFilter struct {
+	// EventTypes are the qualified types of the events, for example "A.0b2a3299cc857e29.TopShot.Deposit".
+	EventTypes []string
+	// Addresses are the addresses of the accounts declaring the events.
+	Addresses []string
+	// Contracts are the qualified names of the contracts declaring the events, for example "A.0b2a3299cc857e29.TopShot".
+	Contracts []string
+}
+
 type Event struct {
 	// Type is the qualified event type.
 	Type string
@@ -127,3 +140,35 @@ type AccountCreatedEvent Event
 func (evt AccountCreatedEvent) Address() Address {
 	return BytesToAddress(evt.Value.Fields[0].(cadence.Address).Bytes())
 }
+
+// An AccountContractEvent is emitted when a transaction adds, updates or removes a contract of an account.
+//
+// The event type is one of EventAccountContractAdded, EventAccountContractUpdated
+// and EventAccountContractRemoved.
+//
+// This event contains the following fields:
+// - Address: Address
+// - CodeHash: [UInt8]
+// - Contract: String
+type AccountContractEvent Event
+
+// Address returns the address of the account the contract is deployed to.
+func (evt AccountContractEvent) Address() Address {
+	return BytesToAddress(evt.Value.Fields[0].(cadence.Address).Bytes())
+}
+
+// CodeHash returns the hash of the contract code.
+func (evt AccountContractEvent) CodeHash() []byte {
+	values := evt.Value.Fields[1].(cadence.Array).Values
+
+	hash := make([]byte, len(values))
+	for i, v := range values {
+		hash[i] = byte(v.(cadence.UInt8))
+	}
+	return hash
+}
+
+// Contract returns the name of the contract.
+func (evt AccountContractEvent) Contract() string {
+	return string(evt.Value.Fields[2].(cadence.String))
+}
@@ -25,7 +25,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"time"
 
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/access"
@@ -275,17 +274,12 @@ func NewFlowGRPCClient() *grpc.Client {
 }
 
 func WaitForSeal(ctx context.Context, c access.Client, id flow.Identifier) *flow.TransactionResult {
-	result, err := c.GetTransactionResult(ctx, id)
-	Handle(err)
-
 	fmt.Printf("Waiting for transaction %s to be sealed...\n", id)
 
-	for result.Status != flow.TransactionStatusSealed {
-		time.Sleep(time.Second)
+	result, err := access.WaitForSeal(ctx, c, id, access.WithStatusCallback(func(*flow.TransactionResult) {
 		fmt.Print(".")
-		result, err = c.GetTransactionResult(ctx, id)
-		Handle(err)
-	}
+	}))
+	Handle(err)
 
 	fmt.Println()
 	fmt.Printf("Transaction %s sealed\n", id)
@@ -107,6 +107,9 @@ const (
 	// Stagingnet is the chain ID for internal stagingnet chain.
 	Stagingnet ChainID = "flow-stagingnet"
 
+	// Canary is the chain ID for the canary chain, which receives protocol upgrades before testnet.
+	Canary ChainID = "flow-canary"
+
 	// Transient test networks
 
 	// Benchnet is the chain ID for the transient benchmarking chain.
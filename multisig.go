@@ -0,0 +1,218 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// An AccountKeySigner is a signer for a key of an account.
+type AccountKeySigner struct {
+	Address  Address
+	KeyIndex int
+	Signer   crypto.Signer
+}
+
+// An InsufficientWeightError is returned when the available signers of an account
+// don't reach the account key weight threshold.
+type InsufficientWeightError struct {
+	Address Address
+	// Weight is the total weight of the available keys of the account.
+	Weight int
+}
+
+func (e InsufficientWeightError) Error() string {
+	return fmt.Sprintf(
+		"available keys of account %s have a total weight of %d, %d more is required",
+		e.Address,
+		e.Weight,
+		e.MissingWeight(),
+	)
+}
+
+// MissingWeight returns the weight missing to reach the account key weight threshold.
+func (e InsufficientWeightError) MissingWeight() int {
+	return AccountKeyWeightThreshold - e.Weight
+}
+
+// SelectAccountSigners selects the signers of the account keys to sign with, among the available signers.
+//
+// The required keys are always selected, then the other keys are selected by decreasing weight until the
+// account key weight threshold is reached, which selects as few keys as possible. Signers of other accounts
+// and of revoked keys are ignored. An InsufficientWeightError is returned if the available keys don't reach
+// the threshold, and an error is returned if a signer doesn't match the public key of its account key.
+//
+// The threshold only applies to the payer and the authorizers of a transaction, a proposer that has no
+// other role only signs with the proposal key.
+func SelectAccountSigners(account *Account, signers []AccountKeySigner, requiredKeys ...int) ([]AccountKeySigner, error) {
+	candidates := make([]AccountKeySigner, 0)
+	seen := make(map[int]bool)
+
+	for _, signer := range signers {
+		if signer.Address != account.Address || seen[signer.KeyIndex] {
+			continue
+		}
+
+		key := accountKey(account, signer.KeyIndex)
+		if key == nil {
+			return nil, fmt.Errorf("account %s has no key with index %d", account.Address, signer.KeyIndex)
+		}
+		if key.Revoked {
+			continue
+		}
+		if !signer.Signer.PublicKey().Equals(key.PublicKey) {
+			return nil, fmt.Errorf("signer doesn't match the public key of key %d of account %s", key.Index, account.Address)
+		}
+
+		seen[signer.KeyIndex] = true
+		candidates = append(candidates, signer)
+	}
+
+	weightOf := func(signer AccountKeySigner) int {
+		return accountKey(account, signer.KeyIndex).Weight
+	}
+
+	// required keys first, then by decreasing weight and increasing key index
+	sort.SliceStable(candidates, func(i, j int) bool {
+		requiredI := containsKeyIndex(requiredKeys, candidates[i].KeyIndex)
+		requiredJ := containsKeyIndex(requiredKeys, candidates[j].KeyIndex)
+		if requiredI != requiredJ {
+			return requiredI
+		}
+		if weightOf(candidates[i]) != weightOf(candidates[j]) {
+			return weightOf(candidates[i]) > weightOf(candidates[j])
+		}
+		return candidates[i].KeyIndex < candidates[j].KeyIndex
+	})
+
+	for _, index := range requiredKeys {
+		if !seen[index] {
+			return nil, fmt.Errorf("no signer available for required key %d of account %s", index, account.Address)
+		}
+	}
+
+	selected := make([]AccountKeySigner, 0)
+	weight := 0
+	for _, signer := range candidates {
+		if weight >= AccountKeyWeightThreshold && !containsKeyIndex(requiredKeys, signer.KeyIndex) {
+			break
+		}
+		selected = append(selected, signer)
+		weight += weightOf(signer)
+	}
+
+	if weight < AccountKeyWeightThreshold {
+		return nil, InsufficientWeightError{Address: account.Address, Weight: weight}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].KeyIndex < selected[j].KeyIndex
+	})
+
+	return selected, nil
+}
+
+// SignWithAccountKeys signs the transaction for all its signers, with keys selected among the available signers.
+//
+// The keys of the payer and the authorizers are selected with SelectAccountSigners, the proposal key being
+// always selected, while a proposer that is neither payer nor authorizer only signs with the proposal key.
+// The proposer and the authorizers sign the payload first, then the payer signs the envelope, unless they
+// are the payer who only signs the envelope. The accounts must include every signer account.
+//
+// The keys of all the accounts are selected before signing, so the transaction is left unchanged if
+// an account doesn't have enough weight.
+func (t *Transaction) SignWithAccountKeys(accounts []*Account, signers []AccountKeySigner) error {
+	accountsByAddress := make(map[Address]*Account, len(accounts))
+	for _, account := range accounts {
+		accountsByAddress[account.Address] = account
+	}
+
+	selected := make(map[Address][]AccountKeySigner)
+	for _, address := range t.signerList() {
+		account, ok := accountsByAddress[address]
+		if !ok {
+			return fmt.Errorf("account %s of transaction signer is missing", address)
+		}
+
+		if !t.weightedSigner(address) {
+			proposalKeySigner, err := selectProposalKeySigner(account, signers, t.ProposalKey.KeyIndex)
+			if err != nil {
+				return err
+			}
+			selected[address] = []AccountKeySigner{proposalKeySigner}
+			continue
+		}
+
+		var requiredKeys []int
+		if address == t.ProposalKey.Address {
+			requiredKeys = []int{t.ProposalKey.KeyIndex}
+		}
+
+		accountSigners, err := SelectAccountSigners(account, signers, requiredKeys...)
+		if err != nil {
+			return err
+		}
+		selected[address] = accountSigners
+	}
+
+	for _, address := range t.signerList() {
+		if address == t.Payer {
+			continue
+		}
+		for _, signer := range selected[address] {
+			if err := t.SignPayload(address, signer.KeyIndex, signer.Signer); err != nil {
+				return fmt.Errorf("failed to sign payload with key %d of account %s: %w", signer.KeyIndex, address, err)
+			}
+		}
+	}
+
+	for _, signer := range selected[t.Payer] {
+		if err := t.SignEnvelope(t.Payer, signer.KeyIndex, signer.Signer); err != nil {
+			return fmt.Errorf("failed to sign envelope with key %d of account %s: %w", signer.KeyIndex, t.Payer, err)
+		}
+	}
+
+	return nil
+}
+
+// selectProposalKeySigner returns the signer of the proposal key of the account among the available signers.
+func selectProposalKeySigner(account *Account, signers []AccountKeySigner, keyIndex int) (AccountKeySigner, error) {
+	key := accountKey(account, keyIndex)
+	if key == nil {
+		return AccountKeySigner{}, fmt.Errorf("account %s has no key with index %d", account.Address, keyIndex)
+	}
+	if key.Revoked {
+		return AccountKeySigner{}, fmt.Errorf("proposal key %d of account %s is revoked", keyIndex, account.Address)
+	}
+
+	for _, signer := range signers {
+		if signer.Address != account.Address || signer.KeyIndex != keyIndex {
+			continue
+		}
+		if !signer.Signer.PublicKey().Equals(key.PublicKey) {
+			return AccountKeySigner{}, fmt.Errorf("signer doesn't match the public key of key %d of account %s", keyIndex, account.Address)
+		}
+		return signer, nil
+	}
+
+	return AccountKeySigner{}, fmt.Errorf("no signer available for required key %d of account %s", keyIndex, account.Address)
+}
@@ -0,0 +1,196 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestTransaction_SignWithAccountKeys(t *testing.T) {
+	addresses := test.AddressGenerator()
+
+	seed := byte(0)
+	newKey := func(account *flow.Account, weight int) flow.AccountKeySigner {
+		seed++
+		privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, bytes.Repeat([]byte{seed}, crypto.MinSeedLength))
+		require.NoError(t, err)
+
+		signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+		require.NoError(t, err)
+
+		key := flow.NewAccountKey().FromPrivateKey(privateKey).SetHashAlgo(crypto.SHA3_256).SetWeight(weight)
+		key.Index = len(account.Keys)
+		account.Keys = append(account.Keys, key)
+
+		return flow.AccountKeySigner{Address: account.Address, KeyIndex: key.Index, Signer: signer}
+	}
+
+	verify := func(t *testing.T, account *flow.Account, message []byte, sigs []flow.TransactionSignature) {
+		composite := make([]flow.CompositeSignature, 0)
+		for _, sig := range sigs {
+			if sig.Address == account.Address {
+				composite = append(composite, flow.CompositeSignature{Address: sig.Address, KeyIndex: sig.KeyIndex, Signature: sig.Signature})
+			}
+		}
+
+		valid, err := flow.VerifyAccountSignatures(account, message, composite)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	t.Run("Multiple signers", func(t *testing.T) {
+		payer := &flow.Account{Address: addresses.New()}
+		authorizer := &flow.Account{Address: addresses.New()}
+
+		signers := []flow.AccountKeySigner{
+			newKey(authorizer, 500),
+			newKey(authorizer, 300),
+			newKey(authorizer, 700),
+			newKey(payer, 1000),
+			newKey(payer, 1000),
+		}
+
+		tx := flow.NewTransaction().
+			SetScript([]byte("transaction { prepare(acct: AuthAccount) {} }")).
+			SetProposalKey(authorizer.Address, 1, 0).
+			SetPayer(payer.Address).
+			AddAuthorizer(authorizer.Address)
+
+		err := tx.SignWithAccountKeys([]*flow.Account{payer, authorizer}, signers)
+		require.NoError(t, err)
+
+		// the proposal key is required, then the heaviest key reaches the threshold
+		require.Len(t, tx.PayloadSignatures, 2)
+		assert.Equal(t, 1, tx.PayloadSignatures[0].KeyIndex)
+		assert.Equal(t, 2, tx.PayloadSignatures[1].KeyIndex)
+
+		// the payer signs with a single key
+		require.Len(t, tx.EnvelopeSignatures, 1)
+		assert.Equal(t, payer.Address, tx.EnvelopeSignatures[0].Address)
+
+		verify(t, authorizer, tx.PayloadSignableMessage(), tx.PayloadSignatures)
+		verify(t, payer, tx.EnvelopeSignableMessage(), tx.EnvelopeSignatures)
+	})
+
+	t.Run("Proposer only", func(t *testing.T) {
+		payer := &flow.Account{Address: addresses.New()}
+		proposer := &flow.Account{Address: addresses.New()}
+
+		signers := []flow.AccountKeySigner{
+			newKey(proposer, 1000),
+			newKey(proposer, 100),
+			newKey(payer, 1000),
+		}
+
+		tx := flow.NewTransaction().
+			SetScript([]byte("transaction { prepare(acct: AuthAccount) {} }")).
+			SetProposalKey(proposer.Address, 1, 0).
+			SetPayer(payer.Address).
+			AddAuthorizer(payer.Address)
+
+		err := tx.SignWithAccountKeys([]*flow.Account{payer, proposer}, signers)
+		require.NoError(t, err)
+
+		// the proposer only signs with the proposal key, below the weight threshold
+		require.Len(t, tx.PayloadSignatures, 1)
+		assert.Equal(t, proposer.Address, tx.PayloadSignatures[0].Address)
+		assert.Equal(t, 1, tx.PayloadSignatures[0].KeyIndex)
+
+		result, err := flow.VerifyTransactionSignatures(tx, flow.AccountsLookup(payer, proposer))
+		require.NoError(t, err)
+		assert.True(t, result.Valid())
+	})
+
+	t.Run("Proposer only without proposal key signer", func(t *testing.T) {
+		payer := &flow.Account{Address: addresses.New()}
+		proposer := &flow.Account{Address: addresses.New()}
+
+		signers := []flow.AccountKeySigner{newKey(proposer, 1000), newKey(payer, 1000)}
+		newKey(proposer, 100) // no signer available
+
+		tx := flow.NewTransaction().
+			SetProposalKey(proposer.Address, 1, 0).
+			SetPayer(payer.Address)
+
+		err := tx.SignWithAccountKeys([]*flow.Account{payer, proposer}, signers)
+		assert.Error(t, err)
+		assert.Empty(t, tx.PayloadSignatures)
+	})
+
+	t.Run("Insufficient weight", func(t *testing.T) {
+		account := &flow.Account{Address: addresses.New()}
+		signers := []flow.AccountKeySigner{newKey(account, 400), newKey(account, 300)}
+		newKey(account, 1000) // no signer available
+
+		tx := flow.NewTransaction().
+			SetProposalKey(account.Address, 0, 0).
+			SetPayer(account.Address)
+
+		err := tx.SignWithAccountKeys([]*flow.Account{account}, signers)
+
+		var weightErr flow.InsufficientWeightError
+		require.True(t, errors.As(err, &weightErr))
+		assert.Equal(t, account.Address, weightErr.Address)
+		assert.Equal(t, 700, weightErr.Weight)
+		assert.Equal(t, 300, weightErr.MissingWeight())
+		assert.Empty(t, tx.EnvelopeSignatures)
+	})
+
+	t.Run("Revoked key", func(t *testing.T) {
+		account := &flow.Account{Address: addresses.New()}
+		signers := []flow.AccountKeySigner{newKey(account, 1000), newKey(account, 1000)}
+		account.Keys[0].Revoked = true
+
+		selected, err := flow.SelectAccountSigners(account, signers)
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		assert.Equal(t, 1, selected[0].KeyIndex)
+	})
+
+	t.Run("Mismatched signer", func(t *testing.T) {
+		account := &flow.Account{Address: addresses.New()}
+		signer := newKey(account, 1000)
+		other := newKey(account, 1000)
+		signer.Signer = other.Signer
+
+		_, err := flow.SelectAccountSigners(account, []flow.AccountKeySigner{signer})
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing account", func(t *testing.T) {
+		account := &flow.Account{Address: addresses.New()}
+		signers := []flow.AccountKeySigner{newKey(account, 1000)}
+
+		tx := flow.NewTransaction().
+			SetProposalKey(account.Address, 0, 0).
+			SetPayer(addresses.New())
+
+		err := tx.SignWithAccountKeys([]*flow.Account{account}, signers)
+		assert.Error(t, err)
+	})
+}
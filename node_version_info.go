@@ -0,0 +1,33 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+// NodeVersionInfo describes the software version of a node and the spork of the network it is part of.
+type NodeVersionInfo struct {
+	// Semver is the version of the node software, such as "v0.37.10".
+	Semver string
+	// Commit is the commit hash the node software was built from.
+	Commit          string
+	SporkID         Identifier
+	ProtocolVersion uint64
+	// SporkRootBlockHeight is the height of the first block of the current spork.
+	SporkRootBlockHeight uint64
+	// NodeRootBlockHeight is the height of the first block the node has data for.
+	NodeRootBlockHeight uint64
+}
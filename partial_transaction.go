@@ -0,0 +1,220 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// A PartialTransaction is a transaction collecting the signatures of its signers.
+//
+// Partial transactions are used to coordinate the signing of a transaction by multiple parties:
+// each party adds its signatures to its copy of the partial transaction, and the copies are merged
+// until no signer is missing. Partial transactions can be encoded and decoded, so the signing state
+// can be passed between services and offline devices.
+//
+// The envelope signature covers the payload signatures, so the payer should only sign once all the
+// payload signatures have been merged.
+type PartialTransaction struct {
+	tx *Transaction
+}
+
+// NewPartialTransaction creates a partial transaction from a copy of the transaction,
+// including the signatures already added to it.
+func NewPartialTransaction(tx *Transaction) *PartialTransaction {
+	return &PartialTransaction{tx: copyTransaction(tx)}
+}
+
+// Transaction returns the transaction being signed.
+//
+// Signatures are added to the partial transaction by signing the returned transaction.
+func (p *PartialTransaction) Transaction() *Transaction {
+	return p.tx
+}
+
+// Merge adds the signatures of the other partial transaction that are missing from this one.
+//
+// An error is returned if the partial transactions have different payloads, if the other partial
+// transaction contains a different signature for the same account key, or if the merge would add
+// payload signatures not covered by the envelope signatures of either partial transaction.
+func (p *PartialTransaction) Merge(other *PartialTransaction) error {
+	if !bytes.Equal(p.tx.PayloadMessage(), other.tx.PayloadMessage()) {
+		return fmt.Errorf("can't merge partial transactions with different payloads")
+	}
+
+	payloadSignatures, err := missingSignatures(p.tx.PayloadSignatures, other.tx.PayloadSignatures)
+	if err != nil {
+		return fmt.Errorf("failed to merge payload signatures: %w", err)
+	}
+
+	// the envelope signatures cover the payload signatures, so they are invalidated by new payload signatures
+	otherMissing, err := missingSignatures(other.tx.PayloadSignatures, p.tx.PayloadSignatures)
+	if err != nil {
+		return fmt.Errorf("failed to merge payload signatures: %w", err)
+	}
+	if (len(payloadSignatures) > 0 && len(p.tx.EnvelopeSignatures) > 0) ||
+		(len(otherMissing) > 0 && len(other.tx.EnvelopeSignatures) > 0) {
+		return fmt.Errorf("can't merge payload signatures into an envelope signed transaction")
+	}
+
+	envelopeSignatures, err := missingSignatures(p.tx.EnvelopeSignatures, other.tx.EnvelopeSignatures)
+	if err != nil {
+		return fmt.Errorf("failed to merge envelope signatures: %w", err)
+	}
+
+	for _, sig := range payloadSignatures {
+		p.tx.AddPayloadSignature(sig.Address, sig.KeyIndex, sig.Signature)
+	}
+	for _, sig := range envelopeSignatures {
+		p.tx.AddEnvelopeSignature(sig.Address, sig.KeyIndex, sig.Signature)
+	}
+
+	return nil
+}
+
+// MissingSigners returns the accounts that haven't signed the transaction yet.
+//
+// The payer must sign the envelope and the proposer and authorizers must sign the payload, unless
+// they are also the payer. An account is considered to have signed once it has a signature for any
+// of its keys, since the key weights can't be checked without fetching the account.
+func (p *PartialTransaction) MissingSigners() []Address {
+	signed := make(map[Address]bool)
+	for _, sig := range p.tx.PayloadSignatures {
+		signed[sig.Address] = true
+	}
+
+	missing := make([]Address, 0)
+	for _, signer := range p.tx.signerList() {
+		if signer == p.tx.Payer {
+			continue
+		}
+		if !signed[signer] {
+			missing = append(missing, signer)
+		}
+	}
+
+	payerSigned := false
+	for _, sig := range p.tx.EnvelopeSignatures {
+		if sig.Address == p.tx.Payer {
+			payerSigned = true
+		}
+	}
+	if !payerSigned && p.tx.Payer != EmptyAddress {
+		missing = append(missing, p.tx.Payer)
+	}
+
+	return missing
+}
+
+// Finalize returns a copy of the fully signed transaction.
+//
+// An error is returned if any signer is missing.
+func (p *PartialTransaction) Finalize() (*Transaction, error) {
+	if p.tx.Payer == EmptyAddress {
+		return nil, fmt.Errorf("transaction payer is not set")
+	}
+
+	if missing := p.MissingSigners(); len(missing) > 0 {
+		return nil, fmt.Errorf("transaction is missing signatures from accounts %v", missing)
+	}
+
+	return copyTransaction(p.tx), nil
+}
+
+// Encode serializes the partial transaction, using the same encoding as Transaction.Encode.
+func (p *PartialTransaction) Encode() []byte {
+	return p.tx.Encode()
+}
+
+// DecodePartialTransaction decodes a partial transaction serialized with PartialTransaction.Encode.
+func DecodePartialTransaction(data []byte) (*PartialTransaction, error) {
+	tx, err := DecodeTransaction(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode partial transaction: %w", err)
+	}
+
+	return &PartialTransaction{tx: tx}, nil
+}
+
+// MarshalJSON encodes the partial transaction as a hex string.
+func (p *PartialTransaction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(p.Encode()))
+}
+
+// UnmarshalJSON decodes a partial transaction encoded as a hex string.
+func (p *PartialTransaction) UnmarshalJSON(data []byte) error {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+
+	b, err := hex.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to decode partial transaction: %w", err)
+	}
+
+	decoded, err := DecodePartialTransaction(b)
+	if err != nil {
+		return err
+	}
+
+	*p = *decoded
+	return nil
+}
+
+// missingSignatures returns the signatures in other that are not in signatures.
+func missingSignatures(signatures, other []TransactionSignature) ([]TransactionSignature, error) {
+	type signatureKey struct {
+		address  Address
+		keyIndex int
+	}
+
+	existing := make(map[signatureKey][]byte)
+	for _, sig := range signatures {
+		existing[signatureKey{sig.Address, sig.KeyIndex}] = sig.Signature
+	}
+
+	missing := make([]TransactionSignature, 0)
+	for _, sig := range other {
+		existingSig, ok := existing[signatureKey{sig.Address, sig.KeyIndex}]
+		if !ok {
+			missing = append(missing, sig)
+			continue
+		}
+		if !bytes.Equal(existingSig, sig.Signature) {
+			return nil, fmt.Errorf("conflicting signatures for key %d of account %s", sig.KeyIndex, sig.Address)
+		}
+	}
+
+	return missing, nil
+}
+
+func copyTransaction(tx *Transaction) *Transaction {
+	txCopy := *tx
+
+	txCopy.Arguments = append([][]byte(nil), tx.Arguments...)
+	txCopy.Authorizers = append([]Address(nil), tx.Authorizers...)
+	txCopy.PayloadSignatures = append([]TransactionSignature(nil), tx.PayloadSignatures...)
+	txCopy.EnvelopeSignatures = append([]TransactionSignature(nil), tx.EnvelopeSignatures...)
+
+	return &txCopy
+}
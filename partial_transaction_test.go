@@ -0,0 +1,143 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestPartialTransaction(t *testing.T) {
+	addresses := test.AddressGenerator()
+	proposer := addresses.New()
+	authorizer := addresses.New()
+	payer := addresses.New()
+
+	newTransaction := func() *flow.Transaction {
+		return flow.NewTransaction().
+			SetScript([]byte(`transaction { prepare(signer: AuthAccount) {} }`)).
+			SetReferenceBlockID(test.IdentifierGenerator().New()).
+			SetProposalKey(proposer, 1, 42).
+			SetPayer(payer).
+			AddAuthorizer(authorizer)
+	}
+
+	t.Run("Merge and finalize", func(t *testing.T) {
+		tx := newTransaction()
+
+		proposerCopy := flow.NewPartialTransaction(tx)
+		authorizerCopy := flow.NewPartialTransaction(tx)
+
+		assert.Equal(t, []flow.Address{proposer, authorizer, payer}, proposerCopy.MissingSigners())
+
+		require.NoError(t, proposerCopy.Transaction().SignPayload(proposer, 1, test.MockSigner([]byte{1})))
+		require.NoError(t, authorizerCopy.Transaction().SignPayload(authorizer, 0, test.MockSigner([]byte{2})))
+
+		_, err := proposerCopy.Finalize()
+		assert.Error(t, err)
+
+		require.NoError(t, proposerCopy.Merge(authorizerCopy))
+		assert.Equal(t, []flow.Address{payer}, proposerCopy.MissingSigners())
+
+		require.NoError(t, proposerCopy.Transaction().SignEnvelope(payer, 0, test.MockSigner([]byte{3})))
+		assert.Empty(t, proposerCopy.MissingSigners())
+
+		signed, err := proposerCopy.Finalize()
+		require.NoError(t, err)
+		assert.Len(t, signed.PayloadSignatures, 2)
+		assert.Len(t, signed.EnvelopeSignatures, 1)
+
+		// the original transaction is not modified
+		assert.Empty(t, tx.PayloadSignatures)
+	})
+
+	t.Run("Merge is idempotent", func(t *testing.T) {
+		partial := flow.NewPartialTransaction(newTransaction())
+		require.NoError(t, partial.Transaction().SignPayload(proposer, 1, test.MockSigner([]byte{1})))
+
+		require.NoError(t, partial.Merge(partial))
+		assert.Len(t, partial.Transaction().PayloadSignatures, 1)
+	})
+
+	t.Run("Different payloads", func(t *testing.T) {
+		partial := flow.NewPartialTransaction(newTransaction())
+		other := flow.NewPartialTransaction(newTransaction().SetGasLimit(10))
+
+		assert.Error(t, partial.Merge(other))
+	})
+
+	t.Run("Conflicting signatures", func(t *testing.T) {
+		tx := newTransaction()
+
+		partial := flow.NewPartialTransaction(tx)
+		require.NoError(t, partial.Transaction().SignPayload(proposer, 1, test.MockSigner([]byte{1})))
+
+		other := flow.NewPartialTransaction(tx)
+		require.NoError(t, other.Transaction().SignPayload(proposer, 1, test.MockSigner([]byte{2})))
+
+		assert.Error(t, partial.Merge(other))
+	})
+
+	t.Run("Envelope already signed", func(t *testing.T) {
+		tx := newTransaction()
+
+		signed := flow.NewPartialTransaction(tx)
+		require.NoError(t, signed.Transaction().SignPayload(proposer, 1, test.MockSigner([]byte{1})))
+		require.NoError(t, signed.Transaction().SignEnvelope(payer, 0, test.MockSigner([]byte{3})))
+
+		authorizerCopy := flow.NewPartialTransaction(tx)
+		require.NoError(t, authorizerCopy.Transaction().SignPayload(authorizer, 0, test.MockSigner([]byte{2})))
+
+		assert.Error(t, signed.Merge(authorizerCopy))
+		assert.Len(t, signed.Transaction().PayloadSignatures, 1)
+
+		assert.Error(t, authorizerCopy.Merge(signed))
+		assert.Len(t, authorizerCopy.Transaction().EnvelopeSignatures, 0)
+
+		// merging the signatures already covered by the envelope is allowed
+		proposerCopy := flow.NewPartialTransaction(tx)
+		require.NoError(t, proposerCopy.Transaction().SignPayload(proposer, 1, test.MockSigner([]byte{1})))
+		require.NoError(t, proposerCopy.Merge(signed))
+		assert.Equal(t, []flow.Address{authorizer}, proposerCopy.MissingSigners())
+		assert.Len(t, proposerCopy.Transaction().EnvelopeSignatures, 1)
+	})
+
+	t.Run("Encoding", func(t *testing.T) {
+		partial := flow.NewPartialTransaction(newTransaction())
+		require.NoError(t, partial.Transaction().SignPayload(authorizer, 0, test.MockSigner([]byte{2})))
+
+		decoded, err := flow.DecodePartialTransaction(partial.Encode())
+		require.NoError(t, err)
+		assert.Equal(t, partial.Transaction().ID(), decoded.Transaction().ID())
+		assert.Equal(t, partial.MissingSigners(), decoded.MissingSigners())
+
+		data, err := json.Marshal(partial)
+		require.NoError(t, err)
+
+		var unmarshaled flow.PartialTransaction
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.Equal(t, partial.Transaction().ID(), unmarshaled.Transaction().ID())
+	})
+}
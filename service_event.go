@@ -0,0 +1,210 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The types of the service events of execution results.
+const (
+	ServiceEventEpochSetup    = "flow.EpochSetup"
+	ServiceEventEpochCommit   = "flow.EpochCommit"
+	ServiceEventVersionBeacon = "flow.VersionBeacon"
+)
+
+// ErrUnknownServiceEvent is returned when decoding a service event of a type with no SDK representation.
+var ErrUnknownServiceEvent = errors.New("unknown service event type")
+
+// EpochSetup is the service event emitted when the participants and the view boundaries of the next
+// epoch are determined, at the start of the setup phase of the current epoch.
+type EpochSetup struct {
+	Counter   uint64
+	FirstView uint64
+	// DKGPhase1FinalView, DKGPhase2FinalView and DKGPhase3FinalView are the final views of the DKG phases.
+	DKGPhase1FinalView uint64
+	DKGPhase2FinalView uint64
+	DKGPhase3FinalView uint64
+	FinalView          uint64
+	Participants       []EpochParticipant
+	RandomSource       []byte
+	// TargetDuration is the target duration of the epoch in seconds.
+	TargetDuration uint64
+	// TargetEndTime is the target end time of the epoch as a Unix timestamp.
+	TargetEndTime uint64
+}
+
+// An EpochParticipant is a node participating in an epoch.
+type EpochParticipant struct {
+	NodeID Identifier
+	// Address is the networking address of the node, such as "access-001.mainnet.nodes.onflow.org:3569".
+	Address string
+	// Role is the role of the node, such as "collection" or "access".
+	Role          string
+	InitialWeight uint64
+	StakingKey    []byte
+	NetworkingKey []byte
+}
+
+// EpochCommit is the service event emitted when the results of the DKG and the cluster QC generation of
+// the next epoch are committed, at the start of the committed phase of the current epoch.
+type EpochCommit struct {
+	Counter    uint64
+	ClusterQCs []ClusterQC
+	// DKGGroupKey is the hex-encoded group public key of the random beacon.
+	DKGGroupKey string
+	// DKGParticipantKeys are the hex-encoded public key shares of the random beacon participants.
+	DKGParticipantKeys []string
+}
+
+// A ClusterQC is the quorum certificate of the root block of a collection cluster.
+type ClusterQC struct {
+	// Signature is the aggregated signature of the voters.
+	Signature []byte
+	VoterIDs  []Identifier
+}
+
+// VersionBeacon is the service event emitted when the node software versions required by the network
+// at upcoming block heights change.
+type VersionBeacon struct {
+	VersionBoundaries []VersionBoundary
+	// Sequence is the sequence number of the beacon, increasing with each beacon.
+	Sequence uint64
+}
+
+// A VersionBoundary is the minimum node software version, such as "0.37.0", required from a block height.
+type VersionBoundary struct {
+	BlockHeight uint64
+	Version     string
+}
+
+// Decode decodes the payload of the service event into an *EpochSetup, *EpochCommit or *VersionBeacon,
+// depending on its type.
+//
+// ErrUnknownServiceEvent is returned for other service event types.
+func (s ServiceEvent) Decode() (interface{}, error) {
+	switch s.Type {
+	case ServiceEventEpochSetup:
+		return s.DecodeEpochSetup()
+	case ServiceEventEpochCommit:
+		return s.DecodeEpochCommit()
+	case ServiceEventVersionBeacon:
+		return s.DecodeVersionBeacon()
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownServiceEvent, s.Type)
+	}
+}
+
+// DecodeEpochSetup decodes the payload of an EpochSetup service event.
+func (s ServiceEvent) DecodeEpochSetup() (*EpochSetup, error) {
+	var encoded struct {
+		EpochSetup
+		Participants []struct {
+			NodeID        string
+			Address       string
+			Role          string
+			InitialWeight uint64
+			// Weight is the weight of participants encoded by node software before v0.33.
+			Weight        uint64
+			StakingPubKey []byte
+			NetworkPubKey []byte
+		}
+	}
+	if err := s.unmarshal(ServiceEventEpochSetup, &encoded); err != nil {
+		return nil, err
+	}
+
+	setup := encoded.EpochSetup
+	setup.Participants = make([]EpochParticipant, len(encoded.Participants))
+	for i, p := range encoded.Participants {
+		weight := p.InitialWeight
+		if weight == 0 {
+			weight = p.Weight
+		}
+
+		setup.Participants[i] = EpochParticipant{
+			NodeID:        HexToID(p.NodeID),
+			Address:       p.Address,
+			Role:          p.Role,
+			InitialWeight: weight,
+			StakingKey:    p.StakingPubKey,
+			NetworkingKey: p.NetworkPubKey,
+		}
+	}
+
+	return &setup, nil
+}
+
+// DecodeEpochCommit decodes the payload of an EpochCommit service event.
+func (s ServiceEvent) DecodeEpochCommit() (*EpochCommit, error) {
+	var encoded struct {
+		Counter    uint64
+		ClusterQCs []struct {
+			SigData  []byte
+			VoterIDs []string
+		}
+		DKGGroupKey        string
+		DKGParticipantKeys []string
+	}
+	if err := s.unmarshal(ServiceEventEpochCommit, &encoded); err != nil {
+		return nil, err
+	}
+
+	commit := &EpochCommit{
+		Counter:            encoded.Counter,
+		ClusterQCs:         make([]ClusterQC, len(encoded.ClusterQCs)),
+		DKGGroupKey:        strings.TrimPrefix(encoded.DKGGroupKey, "0x"),
+		DKGParticipantKeys: make([]string, len(encoded.DKGParticipantKeys)),
+	}
+	for i, qc := range encoded.ClusterQCs {
+		voterIDs := make([]Identifier, len(qc.VoterIDs))
+		for j, id := range qc.VoterIDs {
+			voterIDs[j] = HexToID(id)
+		}
+		commit.ClusterQCs[i] = ClusterQC{Signature: qc.SigData, VoterIDs: voterIDs}
+	}
+	for i, key := range encoded.DKGParticipantKeys {
+		commit.DKGParticipantKeys[i] = strings.TrimPrefix(key, "0x")
+	}
+
+	return commit, nil
+}
+
+// DecodeVersionBeacon decodes the payload of a VersionBeacon service event.
+func (s ServiceEvent) DecodeVersionBeacon() (*VersionBeacon, error) {
+	var beacon VersionBeacon
+	if err := s.unmarshal(ServiceEventVersionBeacon, &beacon); err != nil {
+		return nil, err
+	}
+	return &beacon, nil
+}
+
+// unmarshal decodes the JSON payload of the service event, encoded by the node software.
+func (s ServiceEvent) unmarshal(eventType string, v interface{}) error {
+	if s.Type != eventType {
+		return fmt.Errorf("can't decode service event %s as %s", s.Type, eventType)
+	}
+	if err := json.Unmarshal(s.Payload, v); err != nil {
+		return fmt.Errorf("failed to decode service event %s: %w", s.Type, err)
+	}
+	return nil
+}
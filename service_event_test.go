@@ -0,0 +1,153 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+const nodeID = "0b2a3299cc857e290b2a3299cc857e290b2a3299cc857e290b2a3299cc857e29"
+
+func TestServiceEvent_DecodeEpochSetup(t *testing.T) {
+	event := flow.ServiceEvent{
+		Type: flow.ServiceEventEpochSetup,
+		Payload: []byte(`{
+			"Counter": 2,
+			"FirstView": 100,
+			"DKGPhase1FinalView": 150,
+			"DKGPhase2FinalView": 160,
+			"DKGPhase3FinalView": 170,
+			"FinalView": 200,
+			"Participants": [{
+				"NodeID": "` + nodeID + `",
+				"Address": "access-001.mainnet.nodes.onflow.org:3569",
+				"Role": "access",
+				"InitialWeight": 100,
+				"StakingPubKey": "AQI=",
+				"NetworkPubKey": "AwQ="
+			}, {
+				"NodeID": "` + nodeID + `",
+				"Role": "collection",
+				"Weight": 50
+			}],
+			"Assignments": [],
+			"RandomSource": "BQY=",
+			"TargetDuration": 604800,
+			"TargetEndTime": 1700000000
+		}`),
+	}
+
+	setup, err := event.DecodeEpochSetup()
+	require.NoError(t, err)
+	assert.Equal(t, &flow.EpochSetup{
+		Counter:            2,
+		FirstView:          100,
+		DKGPhase1FinalView: 150,
+		DKGPhase2FinalView: 160,
+		DKGPhase3FinalView: 170,
+		FinalView:          200,
+		Participants: []flow.EpochParticipant{{
+			NodeID:        flow.HexToID(nodeID),
+			Address:       "access-001.mainnet.nodes.onflow.org:3569",
+			Role:          "access",
+			InitialWeight: 100,
+			StakingKey:    []byte{1, 2},
+			NetworkingKey: []byte{3, 4},
+		}, {
+			NodeID:        flow.HexToID(nodeID),
+			Role:          "collection",
+			InitialWeight: 50,
+		}},
+		RandomSource:   []byte{5, 6},
+		TargetDuration: 604800,
+		TargetEndTime:  1700000000,
+	}, setup)
+
+	decoded, err := event.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, setup, decoded)
+
+	_, err = event.DecodeEpochCommit()
+	assert.Error(t, err)
+}
+
+func TestServiceEvent_DecodeEpochCommit(t *testing.T) {
+	event := flow.ServiceEvent{
+		Type: flow.ServiceEventEpochCommit,
+		Payload: []byte(`{
+			"Counter": 2,
+			"ClusterQCs": [{"SigData": "AQI=", "VoterIDs": ["` + nodeID + `"]}],
+			"DKGGroupKey": "0xabcd",
+			"DKGParticipantKeys": ["ef01"]
+		}`),
+	}
+
+	commit, err := event.DecodeEpochCommit()
+	require.NoError(t, err)
+	assert.Equal(t, &flow.EpochCommit{
+		Counter: 2,
+		ClusterQCs: []flow.ClusterQC{{
+			Signature: []byte{1, 2},
+			VoterIDs:  []flow.Identifier{flow.HexToID(nodeID)},
+		}},
+		DKGGroupKey:        "abcd",
+		DKGParticipantKeys: []string{"ef01"},
+	}, commit)
+}
+
+func TestServiceEvent_DecodeVersionBeacon(t *testing.T) {
+	event := flow.ServiceEvent{
+		Type:    flow.ServiceEventVersionBeacon,
+		Payload: []byte(`{"VersionBoundaries": [{"BlockHeight": 1000, "Version": "0.37.0"}], "Sequence": 3}`),
+	}
+
+	decoded, err := event.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, &flow.VersionBeacon{
+		VersionBoundaries: []flow.VersionBoundary{{BlockHeight: 1000, Version: "0.37.0"}},
+		Sequence:          3,
+	}, decoded)
+}
+
+func TestServiceEvent_Decode(t *testing.T) {
+	t.Run("Generated execution result", func(t *testing.T) {
+		result := test.ExecutionResultGenerator().New()
+
+		decoded, err := result.ServiceEvents[0].Decode()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), decoded.(*flow.EpochSetup).Counter)
+	})
+
+	t.Run("Unknown type", func(t *testing.T) {
+		_, err := flow.ServiceEvent{Type: "flow.Unknown"}.Decode()
+		assert.True(t, errors.Is(err, flow.ErrUnknownServiceEvent))
+	})
+
+	t.Run("Invalid payload", func(t *testing.T) {
+		_, err := flow.ServiceEvent{Type: flow.ServiceEventVersionBeacon, Payload: []byte("{")}.Decode()
+		assert.Error(t, err)
+	})
+}
@@ -24,7 +24,12 @@ import (
 	"github.com/onflow/flow-go-sdk/crypto"
 )
 
-const domainTagLength = 32
+// DomainTagLength is the length of domain tags in bytes.
+const DomainTagLength = 32
+
+// A DomainTag is a UTF-8 encoded string right padded with zero bytes to a total length of 32 bytes,
+// prefixing signed messages to separate the signatures of different domains.
+type DomainTag = [DomainTagLength]byte
 
 // TransactionDomainTag is the prefix of all signed transaction payloads.
 //
@@ -34,10 +39,15 @@ var TransactionDomainTag = mustPadDomainTag("FLOW-V0.0-transaction")
 // UserDomainTag is the prefix of all signed user space payloads.
 //
 // A domain tag is encoded as UTF-8 bytes, right padded to a total length of 32 bytes.
-var UserDomainTag = mustPadDomainTag("FLOW-V0.0-user")
+var UserDomainTag = crypto.UserDomainTag
+
+// AccountProofDomainTag is the prefix of all signed account proof messages.
+//
+// A domain tag is encoded as UTF-8 bytes, right padded to a total length of 32 bytes.
+var AccountProofDomainTag = mustPadDomainTag("FCL-ACCOUNT-PROOF-V0.0")
 
-func mustPadDomainTag(s string) [domainTagLength]byte {
-	paddedTag, err := padDomainTag(s)
+func mustPadDomainTag(s string) DomainTag {
+	paddedTag, err := NewDomainTag(s)
 	if err != nil {
 		panic(err)
 	}
@@ -45,11 +55,14 @@ func mustPadDomainTag(s string) [domainTagLength]byte {
 	return paddedTag
 }
 
-// padDomainTag returns a new padded domain tag from the given string. This function returns an error if the domain
-// tag is too long.
-func padDomainTag(tag string) (paddedTag [domainTagLength]byte, err error) {
-	if len(tag) > domainTagLength {
-		return paddedTag, fmt.Errorf("domain tag %s cannot be longer than %d characters", tag, domainTagLength)
+// NewDomainTag returns a new domain tag from the given string, right padded with zero bytes.
+//
+// This function returns an error if the string is longer than DomainTagLength bytes.
+func NewDomainTag(tag string) (DomainTag, error) {
+	var paddedTag DomainTag
+
+	if len(tag) > DomainTagLength {
+		return paddedTag, fmt.Errorf("domain tag %s cannot be longer than %d characters", tag, DomainTagLength)
 	}
 
 	copy(paddedTag[:], tag)
@@ -57,11 +70,87 @@ func padDomainTag(tag string) (paddedTag [domainTagLength]byte, err error) {
 	return paddedTag, nil
 }
 
+// DomainTaggedMessage returns the message prefixed with the domain tag,
+// which is the message signed by the SDK signing functions for the domain.
+func DomainTaggedMessage(tag DomainTag, message []byte) []byte {
+	tagged := make([]byte, 0, DomainTagLength+len(message))
+	tagged = append(tagged, tag[:]...)
+	return append(tagged, message...)
+}
+
 // SignUserMessage signs a message in the user domain.
 //
 // User messages are distinct from other signed messages (i.e. transactions), and can be
 // verified directly in on-chain Cadence code.
 func SignUserMessage(signer crypto.Signer, message []byte) ([]byte, error) {
-	message = append(UserDomainTag[:], message...)
-	return signer.Sign(message)
+	return crypto.SignUserMessage(signer, message)
+}
+
+// VerifyUserSignatures checks that the signatures are valid signatures of the user message produced with
+// keys of the account, and that the total weight of the signing keys reaches the account key weight threshold.
+//
+// The message must not include the user domain tag. See VerifyAccountSignatures for how the signatures are verified.
+func VerifyUserSignatures(account *Account, message []byte, signatures []CompositeSignature) (bool, error) {
+	return VerifyAccountSignatures(account, DomainTaggedMessage(UserDomainTag, message), signatures)
+}
+
+// A CompositeSignature is a signature produced with a key of an account.
+type CompositeSignature struct {
+	Address   Address
+	KeyIndex  int
+	Signature []byte
+}
+
+// VerifyAccountSignatures checks that the signatures are valid signatures of the message produced with
+// keys of the account, and that the total weight of the signing keys reaches the account key weight threshold.
+//
+// The message must include the domain tag it was signed with. Each key is counted once, even if it produced
+// several signatures. The function returns false if any signature is invalid, and an error if a signature
+// references another account, or a key that doesn't exist or is revoked.
+func VerifyAccountSignatures(account *Account, message []byte, signatures []CompositeSignature) (bool, error) {
+	if len(signatures) == 0 {
+		return false, fmt.Errorf("no signatures to verify")
+	}
+
+	keys := make(map[int]*AccountKey, len(account.Keys))
+	for _, key := range account.Keys {
+		keys[key.Index] = key
+	}
+
+	weight := 0
+	counted := make(map[int]bool)
+
+	for _, sig := range signatures {
+		if sig.Address != account.Address {
+			return false, fmt.Errorf("signature of account %s can't be verified with account %s", sig.Address, account.Address)
+		}
+
+		key, ok := keys[sig.KeyIndex]
+		if !ok {
+			return false, fmt.Errorf("account %s has no key with index %d", account.Address, sig.KeyIndex)
+		}
+		if key.Revoked {
+			return false, fmt.Errorf("key %d of account %s is revoked", sig.KeyIndex, account.Address)
+		}
+
+		hasher, err := crypto.NewHasher(key.HashAlgo)
+		if err != nil {
+			return false, fmt.Errorf("failed to create hasher for key %d: %w", sig.KeyIndex, err)
+		}
+
+		valid, err := key.PublicKey.Verify(sig.Signature, message, hasher)
+		if err != nil {
+			return false, fmt.Errorf("failed to verify signature of key %d: %w", sig.KeyIndex, err)
+		}
+		if !valid {
+			return false, nil
+		}
+
+		if !counted[sig.KeyIndex] {
+			counted[sig.KeyIndex] = true
+			weight += key.Weight
+		}
+	}
+
+	return weight >= AccountKeyWeightThreshold, nil
 }
@@ -0,0 +1,62 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestNewDomainTag(t *testing.T) {
+	tag, err := flow.NewDomainTag("FLOW-V0.0-transaction")
+	require.NoError(t, err)
+	assert.Equal(t, flow.TransactionDomainTag, tag)
+
+	tag, err = flow.NewDomainTag("FLOW-V0.0-user")
+	require.NoError(t, err)
+	assert.Equal(t, flow.UserDomainTag, tag)
+
+	tag, err = flow.NewDomainTag("FCL-ACCOUNT-PROOF-V0.0")
+	require.NoError(t, err)
+	assert.Equal(t, flow.AccountProofDomainTag, tag)
+
+	_, err = flow.NewDomainTag(strings.Repeat("a", flow.DomainTagLength+1))
+	assert.Error(t, err)
+}
+
+func TestDomainTaggedMessage(t *testing.T) {
+	tx := test.TransactionGenerator().New()
+
+	assert.Equal(t, tx.PayloadSignableMessage(), flow.DomainTaggedMessage(flow.TransactionDomainTag, tx.PayloadMessage()))
+	assert.Equal(t, tx.EnvelopeSignableMessage(), flow.DomainTaggedMessage(flow.TransactionDomainTag, tx.EnvelopeMessage()))
+
+	tag, err := flow.NewDomainTag("custom")
+	require.NoError(t, err)
+
+	message := flow.DomainTaggedMessage(tag, []byte{1, 2})
+	assert.Len(t, message, flow.DomainTagLength+2)
+	assert.Equal(t, []byte("custom"), message[:6])
+	assert.Equal(t, []byte{1, 2}, message[flow.DomainTagLength:])
+}
@@ -141,7 +141,7 @@ func AccountKeyToCadenceCryptoKey(key *flow.AccountKey) (cadence.Value, error) {
 	}
 
 	return cadence.NewStruct([]cadence.Value{
-		cadence.NewInt(key.Weight),
+		cadence.NewInt(key.Index),
 		publicKey,
 		hash,
 		weight,
@@ -207,6 +207,41 @@ func CreateAccount(accountKeys []*flow.AccountKey, contracts []Contract, payer f
 		AddRawArgument(jsoncdc.MustEncode(cadenceContracts)), nil
 }
 
+// ParseAccountCreated returns the address of the account created by a transaction
+// generated with CreateAccount.
+//
+// An error is returned if the transaction failed or didn't create an account.
+func ParseAccountCreated(result *flow.TransactionResult) (flow.Address, error) {
+	addresses, err := ParseAccountsCreated(result)
+	if err != nil {
+		return flow.EmptyAddress, err
+	}
+
+	if len(addresses) == 0 {
+		return flow.EmptyAddress, fmt.Errorf("transaction didn't create an account")
+	}
+
+	return addresses[0], nil
+}
+
+// ParseAccountsCreated returns the addresses of the accounts created by a transaction, in creation order.
+//
+// An error is returned if the transaction failed.
+func ParseAccountsCreated(result *flow.TransactionResult) ([]flow.Address, error) {
+	if result.Error != nil {
+		return nil, fmt.Errorf("account creation failed: %w", result.Error)
+	}
+
+	addresses := make([]flow.Address, 0)
+	for _, event := range result.Events {
+		if event.Type == flow.EventAccountCreated {
+			addresses = append(addresses, flow.AccountCreatedEvent(event).Address())
+		}
+	}
+
+	return addresses, nil
+}
+
 // UpdateAccountContract generates a transaction that updates a contract deployed at an account.
 func UpdateAccountContract(address flow.Address, contract Contract) *flow.Transaction {
 	cadenceName := cadence.String(contract.Name)
@@ -244,7 +279,9 @@ func AddAccountKey(address flow.Address, accountKey *flow.AccountKey) (*flow.Tra
 		AddAuthorizer(address), nil
 }
 
-// RemoveAccountKey generates a transaction that removes a key from an account.
+// RemoveAccountKey generates a transaction that revokes the key with the given index of an account.
+//
+// Revoked keys remain on the account, but can no longer sign transactions.
 func RemoveAccountKey(address flow.Address, keyIndex int) *flow.Transaction {
 	cadenceKeyIndex := cadence.NewInt(keyIndex)
 
@@ -19,11 +19,18 @@
 package templates_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/templates"
-	"github.com/stretchr/testify/require"
 )
 
 func TestCreateAccount(t *testing.T) {
@@ -54,3 +61,124 @@ func TestCreateAccount(t *testing.T) {
 				"2 times the contract code (converted to hex) + 500 bytes of extra data.")
 	})
 }
+
+func TestAccountContracts(t *testing.T) {
+	address := flow.HexToAddress("01")
+	contracts := []templates.Contract{
+		{Name: "A", Source: "pub contract A {}"},
+		{Name: "B", Source: "import A from 0x01\npub contract B {}"},
+	}
+
+	transactions := map[string]*flow.Transaction{
+		"Add":    templates.AddAccountContracts(address, contracts),
+		"Update": templates.UpdateAccountContracts(address, contracts),
+		"Remove": templates.RemoveAccountContracts(address, []string{"B", "A"}),
+	}
+
+	for name, tx := range transactions {
+		t.Run(name, func(t *testing.T) {
+			checkTransaction(t, tx.Script)
+			assert.Equal(t, []flow.Address{address}, tx.Authorizers)
+		})
+	}
+
+	t.Run("Arguments", func(t *testing.T) {
+		tx := transactions["Add"]
+		require.Len(t, tx.Arguments, 2)
+
+		names, err := tx.Argument(0)
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewArray([]cadence.Value{cadence.String("A"), cadence.String("B")}), names)
+
+		codes, err := tx.Argument(1)
+		require.NoError(t, err)
+		assert.Equal(t, cadence.String(contracts[1].SourceHex()), codes.(cadence.Array).Values[1])
+	})
+}
+
+func TestContractEvents(t *testing.T) {
+	address := flow.HexToAddress("01")
+
+	contractEvent := func(eventType string, address flow.Address, name string) flow.Event {
+		return flow.Event{
+			Type: eventType,
+			Value: cadence.NewEvent([]cadence.Value{
+				cadence.NewAddress(address),
+				cadence.NewArray([]cadence.Value{cadence.NewUInt8(1), cadence.NewUInt8(2)}),
+				cadence.String(name),
+			}),
+		}
+	}
+
+	result := &flow.TransactionResult{
+		Events: []flow.Event{
+			contractEvent(flow.EventAccountContractAdded, address, "A"),
+			{Type: flow.EventAccountCreated},
+			contractEvent(flow.EventAccountContractUpdated, flow.HexToAddress("02"), "B"),
+			contractEvent(flow.EventAccountContractUpdated, address, "C"),
+		},
+	}
+
+	events := templates.ContractEvents(address, result)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, flow.EventAccountContractAdded, events[0].Type)
+	assert.Equal(t, "A", events[0].Contract())
+	assert.Equal(t, address, events[0].Address())
+	assert.Equal(t, []byte{1, 2}, events[0].CodeHash())
+
+	assert.Equal(t, flow.EventAccountContractUpdated, events[1].Type)
+	assert.Equal(t, "C", events[1].Contract())
+}
+
+// checkTransaction parses and type checks the transaction script.
+func checkTransaction(t *testing.T, script []byte) {
+	program, err := parser.ParseProgram(script, nil)
+	require.NoError(t, err)
+
+	checker, err := sema.NewChecker(
+		program,
+		common.StringLocation("transaction"),
+		nil,
+		&sema.Config{AccessCheckMode: sema.AccessCheckModeNotSpecifiedUnrestricted},
+	)
+	require.NoError(t, err)
+	require.NoError(t, checker.Check())
+}
+
+func TestParseAccountCreated(t *testing.T) {
+	accountCreated := func(address flow.Address) flow.Event {
+		return flow.Event{
+			Type:  flow.EventAccountCreated,
+			Value: cadence.NewEvent([]cadence.Value{cadence.NewAddress(address)}),
+		}
+	}
+
+	t.Run("Created", func(t *testing.T) {
+		result := &flow.TransactionResult{
+			Events: []flow.Event{
+				{Type: "A.0000000000000001.FlowFees.FeesDeducted"},
+				accountCreated(flow.HexToAddress("02")),
+				accountCreated(flow.HexToAddress("03")),
+			},
+		}
+
+		address, err := templates.ParseAccountCreated(result)
+		require.NoError(t, err)
+		assert.Equal(t, flow.HexToAddress("02"), address)
+
+		addresses, err := templates.ParseAccountsCreated(result)
+		require.NoError(t, err)
+		assert.Equal(t, []flow.Address{flow.HexToAddress("02"), flow.HexToAddress("03")}, addresses)
+	})
+
+	t.Run("No account created", func(t *testing.T) {
+		_, err := templates.ParseAccountCreated(&flow.TransactionResult{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Failed transaction", func(t *testing.T) {
+		_, err := templates.ParseAccountCreated(&flow.TransactionResult{Error: errors.New("failed")})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,179 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	templates "github.com/onflow/sdks"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+// The Cadence 1.0 variants of the transaction templates, using account references with entitlements
+// instead of AuthAccount.
+const (
+	createAccountTemplateV1 = `
+import Crypto
+
+transaction(publicKeys: [Crypto.KeyListEntry], contracts: {String: String}) {
+	prepare(signer: auth(BorrowValue) &Account) {
+		let account = Account(payer: signer)
+
+		// add all the keys to the account
+		for key in publicKeys {
+			account.keys.add(publicKey: key.publicKey, hashAlgorithm: key.hashAlgorithm, weight: key.weight)
+		}
+
+		// add contracts if provided
+		for contract in contracts.keys {
+			account.contracts.add(name: contract, code: contracts[contract]!.decodeHex())
+		}
+	}
+}
+`
+
+	addAccountKeyTemplateV1 = `
+import Crypto
+
+transaction(key: Crypto.KeyListEntry) {
+	prepare(signer: auth(AddKey) &Account) {
+		signer.keys.add(publicKey: key.publicKey, hashAlgorithm: key.hashAlgorithm, weight: key.weight)
+	}
+}
+`
+
+	removeAccountKeyTemplateV1 = `
+transaction(keyIndex: Int) {
+	prepare(signer: auth(RevokeKey) &Account) {
+		signer.keys.revoke(keyIndex: keyIndex)
+	}
+}
+`
+
+	addContractTemplateV1 = `
+transaction(name: String, code: String) {
+	prepare(signer: auth(AddContract) &Account) {
+		signer.contracts.add(name: name, code: code.decodeHex())
+	}
+}
+`
+
+	updateContractTemplateV1 = `
+transaction(name: String, code: String) {
+	prepare(signer: auth(UpdateContract) &Account) {
+		signer.contracts.update(name: name, code: code.decodeHex())
+	}
+}
+`
+
+	removeContractTemplateV1 = `
+transaction(name: String) {
+	prepare(signer: auth(RemoveContract) &Account) {
+		signer.contracts.remove(name: name)
+	}
+}
+`
+
+	addContractsTemplateV1 = `
+transaction(names: [String], codes: [String]) {
+	prepare(signer: auth(AddContract) &Account) {
+		var i = 0
+		while i < names.length {
+			signer.contracts.add(name: names[i], code: codes[i].decodeHex())
+			i = i + 1
+		}
+	}
+}
+`
+
+	updateContractsTemplateV1 = `
+transaction(names: [String], codes: [String]) {
+	prepare(signer: auth(UpdateContract) &Account) {
+		var i = 0
+		while i < names.length {
+			signer.contracts.update(name: names[i], code: codes[i].decodeHex())
+			i = i + 1
+		}
+	}
+}
+`
+
+	removeContractsTemplateV1 = `
+transaction(names: [String]) {
+	prepare(signer: auth(RemoveContract) &Account) {
+		for name in names {
+			signer.contracts.remove(name: name)
+		}
+	}
+}
+`
+
+	rotateAccountKeyTemplateV1 = `
+import Crypto
+
+transaction(key: Crypto.KeyListEntry, oldKeyIndex: Int) {
+	prepare(signer: auth(AddKey, RevokeKey) &Account) {
+		signer.keys.add(publicKey: key.publicKey, hashAlgorithm: key.hashAlgorithm, weight: key.weight)
+
+		if signer.keys.revoke(keyIndex: oldKeyIndex) == nil {
+			panic("account has no key with index ".concat(oldKeyIndex.toString()))
+		}
+	}
+}
+`
+)
+
+// templatesV1 maps the scripts of the templates to their Cadence 1.0 variants.
+var templatesV1 = map[string]string{
+	templates.CreateAccount:    createAccountTemplateV1,
+	templates.AddAccountKey:    addAccountKeyTemplateV1,
+	templates.RemoveAccountKey: removeAccountKeyTemplateV1,
+	templates.AddContract:      addContractTemplateV1,
+	templates.UpdateContract:   updateContractTemplateV1,
+	templates.RemoveContract:   removeContractTemplateV1,
+	addContractsTemplate:       addContractsTemplateV1,
+	updateContractsTemplate:    updateContractsTemplateV1,
+	removeContractsTemplate:    removeContractsTemplateV1,
+	rotateAccountKeyTemplate:   rotateAccountKeyTemplateV1,
+}
+
+// ForCadenceVersion adapts a transaction generated by this package to the Cadence version of the network
+// it is sent to, so applications can target networks before and after the Crescendo upgrade.
+//
+// For Cadence 1.0, the template script is replaced by its Cadence 1.0 variant and the arguments are
+// migrated with cadence.MigrateArgumentsToV1. Scripts not generated by this package are left unchanged,
+// so they must already be written for the network Cadence version. The transaction is modified in place
+// and must be adapted before it is signed.
+func ForCadenceVersion(tx *flow.Transaction, version sdkcadence.Version) (*flow.Transaction, error) {
+	if version != sdkcadence.Version1 {
+		return tx, nil
+	}
+
+	if script, ok := templatesV1[string(tx.Script)]; ok {
+		tx.Script = []byte(script)
+	}
+
+	arguments, err := sdkcadence.MigrateArgumentsToV1(tx.Arguments)
+	if err != nil {
+		return nil, err
+	}
+	tx.Arguments = arguments
+
+	return tx, nil
+}
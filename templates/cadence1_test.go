@@ -0,0 +1,89 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/templates"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestForCadenceVersion(t *testing.T) {
+	address := test.AddressGenerator().New()
+	key := test.AccountKeyGenerator().New()
+	contract := templates.Contract{Name: "Foo", Source: "access(all) contract Foo {}"}
+
+	createAccount, err := templates.CreateAccount([]*flow.AccountKey{key}, nil, address)
+	require.NoError(t, err)
+	addKey, err := templates.AddAccountKey(address, key)
+	require.NoError(t, err)
+	rotateKey, err := templates.RotateAccountKey(address, key, 0)
+	require.NoError(t, err)
+
+	txs := map[string]*flow.Transaction{
+		"CreateAccount":          createAccount,
+		"AddAccountKey":          addKey,
+		"RemoveAccountKey":       templates.RemoveAccountKey(address, 0),
+		"AddAccountContract":     templates.AddAccountContract(address, contract),
+		"UpdateAccountContract":  templates.UpdateAccountContract(address, contract),
+		"RemoveAccountContract":  templates.RemoveAccountContract(address, contract.Name),
+		"AddAccountContracts":    templates.AddAccountContracts(address, []templates.Contract{contract}),
+		"UpdateAccountContracts": templates.UpdateAccountContracts(address, []templates.Contract{contract}),
+		"RemoveAccountContracts": templates.RemoveAccountContracts(address, []string{contract.Name}),
+		"RotateAccountKey":       rotateKey,
+	}
+
+	for name, tx := range txs {
+		t.Run(name, func(t *testing.T) {
+			assert.Contains(t, string(tx.Script), "AuthAccount")
+			arguments := tx.Arguments
+
+			unchanged, err := templates.ForCadenceVersion(tx, sdkcadence.Version0)
+			require.NoError(t, err)
+			assert.Contains(t, string(unchanged.Script), "AuthAccount")
+
+			migrated, err := templates.ForCadenceVersion(tx, sdkcadence.Version1)
+			require.NoError(t, err)
+			assert.NotContains(t, string(migrated.Script), "AuthAccount")
+			assert.Contains(t, string(migrated.Script), "&Account")
+			assert.NotContains(t, string(migrated.Script), "update__experimental")
+			assert.Equal(t, arguments, migrated.Arguments)
+		})
+	}
+
+	t.Run("Custom script", func(t *testing.T) {
+		script := []byte("transaction(t: Type) { prepare(signer: &Account) {} }")
+		tx := flow.NewTransaction().
+			SetScript(script).
+			AddRawArgument(jsoncdc.MustEncode(cadence.NewTypeValue(cadence.NewReferenceType(false, cadence.AnyStructType{}))))
+
+		migrated, err := templates.ForCadenceVersion(tx, sdkcadence.Version1)
+		require.NoError(t, err)
+		assert.Equal(t, script, migrated.Script)
+		assert.Contains(t, string(migrated.Arguments[0]), "Unauthorized")
+	})
+}
@@ -0,0 +1,130 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+const addContractsTemplate = `
+transaction(names: [String], codes: [String]) {
+	prepare(signer: AuthAccount) {
+		var i = 0
+		while i < names.length {
+			signer.contracts.add(name: names[i], code: codes[i].decodeHex())
+			i = i + 1
+		}
+	}
+}
+`
+
+const updateContractsTemplate = `
+transaction(names: [String], codes: [String]) {
+	prepare(signer: AuthAccount) {
+		var i = 0
+		while i < names.length {
+			signer.contracts.update__experimental(name: names[i], code: codes[i].decodeHex())
+			i = i + 1
+		}
+	}
+}
+`
+
+const removeContractsTemplate = `
+transaction(names: [String]) {
+	prepare(signer: AuthAccount) {
+		for name in names {
+			signer.contracts.remove(name: name)
+		}
+	}
+}
+`
+
+// AddAccountContracts generates a transaction that deploys multiple contracts to an account.
+//
+// The contracts are deployed in the given order, so a contract can import the contracts deployed before it.
+func AddAccountContracts(address flow.Address, contracts []Contract) *flow.Transaction {
+	names, codes := contractArguments(contracts)
+
+	return flow.NewTransaction().
+		SetScript([]byte(addContractsTemplate)).
+		AddRawArgument(jsoncdc.MustEncode(names)).
+		AddRawArgument(jsoncdc.MustEncode(codes)).
+		AddAuthorizer(address)
+}
+
+// UpdateAccountContracts generates a transaction that updates multiple contracts deployed at an account.
+//
+// The contracts are updated in the given order.
+func UpdateAccountContracts(address flow.Address, contracts []Contract) *flow.Transaction {
+	names, codes := contractArguments(contracts)
+
+	return flow.NewTransaction().
+		SetScript([]byte(updateContractsTemplate)).
+		AddRawArgument(jsoncdc.MustEncode(names)).
+		AddRawArgument(jsoncdc.MustEncode(codes)).
+		AddAuthorizer(address)
+}
+
+// RemoveAccountContracts generates a transaction that removes the contracts with the given names from an account.
+func RemoveAccountContracts(address flow.Address, contractNames []string) *flow.Transaction {
+	names := make([]cadence.Value, len(contractNames))
+	for i, name := range contractNames {
+		names[i] = cadence.String(name)
+	}
+
+	return flow.NewTransaction().
+		SetScript([]byte(removeContractsTemplate)).
+		AddRawArgument(jsoncdc.MustEncode(cadence.NewArray(names))).
+		AddAuthorizer(address)
+}
+
+// ContractEvents returns the contract events of the account emitted by a transaction,
+// in the order they were emitted.
+func ContractEvents(address flow.Address, result *flow.TransactionResult) []flow.AccountContractEvent {
+	events := make([]flow.AccountContractEvent, 0)
+
+	for _, event := range result.Events {
+		switch event.Type {
+		case flow.EventAccountContractAdded, flow.EventAccountContractUpdated, flow.EventAccountContractRemoved:
+			contractEvent := flow.AccountContractEvent(event)
+			if contractEvent.Address() == address {
+				events = append(events, contractEvent)
+			}
+		}
+	}
+
+	return events
+}
+
+// contractArguments returns the names and the hex encoded source code of the contracts.
+func contractArguments(contracts []Contract) (cadence.Array, cadence.Array) {
+	names := make([]cadence.Value, len(contracts))
+	codes := make([]cadence.Value, len(contracts))
+
+	for i, contract := range contracts {
+		names[i] = cadence.String(contract.Name)
+		codes[i] = cadence.String(contract.SourceHex())
+	}
+
+	return cadence.NewArray(names), cadence.NewArray(codes)
+}
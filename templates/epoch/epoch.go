@@ -0,0 +1,146 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package epoch provides scripts reading the state of the epochs of a network from the FlowEpoch contract.
+//
+// Epochs are measured in views, the consensus rounds of the network, which roughly advance with each
+// block. Operators can schedule around epoch transitions by comparing the view boundaries of the
+// current epoch and its phases.
+//
+// The scripts use Cadence 1.0.
+package epoch
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// Phase is the phase of an epoch.
+type Phase uint8
+
+const (
+	// PhaseStakingAuction is the phase nodes can stake and unstake tokens in.
+	PhaseStakingAuction Phase = iota
+	// PhaseSetup is the phase the participants of the next epoch run the DKG and the cluster QC generation in.
+	PhaseSetup
+	// PhaseCommitted is the phase the next epoch is fully configured in, until the epoch ends.
+	PhaseCommitted
+)
+
+// String returns the name of the phase.
+func (p Phase) String() string {
+	switch p {
+	case PhaseStakingAuction:
+		return "staking auction"
+	case PhaseSetup:
+		return "setup"
+	case PhaseCommitted:
+		return "committed"
+	default:
+		return "unknown"
+	}
+}
+
+const getCurrentEpochScript = `
+import FlowEpoch
+
+access(all) struct Epoch {
+	access(all) let counter: UInt64
+	access(all) let phase: UInt8
+	access(all) let startView: UInt64
+	access(all) let stakingEndView: UInt64
+	access(all) let endView: UInt64
+	access(all) let dkgPhaseLength: UInt64
+	access(all) let totalRewards: UFix64
+
+	init(counter: UInt64, metadata: FlowEpoch.EpochMetadata, config: FlowEpoch.Config) {
+		self.counter = counter
+		self.phase = FlowEpoch.currentEpochPhase.rawValue
+		self.startView = metadata.startView
+		self.stakingEndView = metadata.stakingEndView
+		self.endView = metadata.endView
+		self.dkgPhaseLength = config.numViewsInDKGPhase
+		self.totalRewards = metadata.totalRewards
+	}
+}
+
+access(all) fun main(): Epoch {
+	let counter = FlowEpoch.currentEpochCounter
+	let metadata = FlowEpoch.getEpochMetadata(counter)
+		?? panic("no metadata for the current epoch ".concat(counter.toString()))
+
+	return Epoch(counter: counter, metadata: metadata, config: FlowEpoch.getConfigMetadata())
+}
+`
+
+// Epoch is the state of the current epoch of a network.
+type Epoch struct {
+	Counter uint64 `cadence:"counter"`
+	Phase   Phase  `cadence:"phase"`
+	// StartView and EndView are the first and last views of the epoch.
+	StartView uint64 `cadence:"startView"`
+	EndView   uint64 `cadence:"endView"`
+	// StakingEndView is the last view of the staking auction phase.
+	StakingEndView uint64 `cadence:"stakingEndView"`
+	// DKGPhaseLength is the number of views of each of the three DKG phases of the setup phase.
+	DKGPhaseLength uint64 `cadence:"dkgPhaseLength"`
+	// TotalRewards is the amount of FLOW paid as rewards at the end of the epoch, such as "1250000.00000000".
+	TotalRewards string `cadence:"totalRewards"`
+}
+
+// SetupStartView returns the first view of the setup phase.
+func (e Epoch) SetupStartView() uint64 {
+	return e.StakingEndView + 1
+}
+
+// DKGEndView returns the last view of the DKG phases, after which the epoch can be committed.
+func (e Epoch) DKGEndView() uint64 {
+	return e.StakingEndView + 3*e.DKGPhaseLength
+}
+
+// Contains returns true if the view is part of the epoch.
+func (e Epoch) Contains(view uint64) bool {
+	return view >= e.StartView && view <= e.EndView
+}
+
+// ViewsRemaining returns the number of views left in the epoch after the view, or 0 if the view is
+// after the end of the epoch.
+func (e Epoch) ViewsRemaining(view uint64) uint64 {
+	if view >= e.EndView {
+		return 0
+	}
+	return e.EndView - view
+}
+
+// GetCurrentEpoch returns the counter, phase and view boundaries of the current epoch of the network.
+func GetCurrentEpoch(ctx context.Context, client access.Client, chain flow.ChainID) (*Epoch, error) {
+	script, err := templates.ResolveImports(chain, []byte(getCurrentEpochScript))
+	if err != nil {
+		return nil, err
+	}
+
+	var epoch Epoch
+	err = access.ExecuteScriptAtLatestBlockInto(ctx, client, script, nil, &epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &epoch, nil
+}
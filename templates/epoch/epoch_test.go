@@ -0,0 +1,183 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package epoch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/mocks"
+	"github.com/onflow/flow-go-sdk/templates/epoch"
+)
+
+const nodeID = "0b2a3299cc857e290b2a3299cc857e290b2a3299cc857e290b2a3299cc857e29"
+
+func TestGetCurrentEpoch(t *testing.T) {
+	rewards, _ := cadence.NewUFix64("1250000.0")
+	fields := []string{"counter", "phase", "startView", "stakingEndView", "endView", "dkgPhaseLength", "totalRewards"}
+	values := []cadence.Value{
+		cadence.NewUInt64(100),
+		cadence.NewUInt8(1),
+		cadence.NewUInt64(1000),
+		cadence.NewUInt64(1500),
+		cadence.NewUInt64(2000),
+		cadence.NewUInt64(100),
+		rewards,
+	}
+	result := newStruct("Epoch", fields, values...)
+
+	client := &mocks.Client{}
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value(nil)).Return(result, nil)
+
+	current, err := epoch.GetCurrentEpoch(context.Background(), client, flow.Mainnet)
+	require.NoError(t, err)
+	assert.Equal(t, &epoch.Epoch{
+		Counter:        100,
+		Phase:          epoch.PhaseSetup,
+		StartView:      1000,
+		EndView:        2000,
+		StakingEndView: 1500,
+		DKGPhaseLength: 100,
+		TotalRewards:   "1250000.00000000",
+	}, current)
+
+	script := client.Calls[0].Arguments.Get(1).([]byte)
+	assert.Contains(t, string(script), "import FlowEpoch from 0x8624b52f9ddcd04a")
+
+	assert.Equal(t, "setup", current.Phase.String())
+	assert.Equal(t, uint64(1501), current.SetupStartView())
+	assert.Equal(t, uint64(1800), current.DKGEndView())
+	assert.True(t, current.Contains(1000))
+	assert.True(t, current.Contains(2000))
+	assert.False(t, current.Contains(2001))
+	assert.Equal(t, uint64(500), current.ViewsRemaining(1500))
+	assert.Equal(t, uint64(0), current.ViewsRemaining(2500))
+
+	_, err = epoch.GetCurrentEpoch(context.Background(), client, flow.ChainID("unknown"))
+	assert.Error(t, err)
+}
+
+func TestDecodeEpochSetupEvent(t *testing.T) {
+	eventType, err := epoch.EpochSetupEventType(flow.Mainnet)
+	require.NoError(t, err)
+	assert.Equal(t, "A.8624b52f9ddcd04a.FlowEpoch.EpochSetup", eventType)
+
+	node := newStruct("FlowIDTableStaking.NodeInfo",
+		[]string{"id", "role", "networkingAddress", "networkingKey", "stakingKey", "initialWeight"},
+		cadence.String(nodeID), cadence.NewUInt8(5), cadence.String("access-001:3569"),
+		cadence.String("0304"), cadence.String("0102"), cadence.NewUInt64(100),
+	)
+	event := newEvent(eventType,
+		[]string{"counter", "nodeInfo", "firstView", "finalView", "randomSource", "DKGPhase1FinalView", "DKGPhase2FinalView", "DKGPhase3FinalView", "targetDuration", "targetEndTime"},
+		cadence.NewUInt64(2), cadence.NewArray([]cadence.Value{node}), cadence.NewUInt64(100), cadence.NewUInt64(200),
+		cadence.String("0506"), cadence.NewUInt64(150), cadence.NewUInt64(160), cadence.NewUInt64(170),
+		cadence.NewUInt64(604800), cadence.NewUInt64(1700000000),
+	)
+
+	setup, err := epoch.DecodeEpochSetupEvent(event)
+	require.NoError(t, err)
+	assert.Equal(t, &flow.EpochSetup{
+		Counter:            2,
+		FirstView:          100,
+		DKGPhase1FinalView: 150,
+		DKGPhase2FinalView: 160,
+		DKGPhase3FinalView: 170,
+		FinalView:          200,
+		Participants: []flow.EpochParticipant{{
+			NodeID:        flow.HexToID(nodeID),
+			Address:       "access-001:3569",
+			Role:          "access",
+			InitialWeight: 100,
+			StakingKey:    []byte{1, 2},
+			NetworkingKey: []byte{3, 4},
+		}},
+		RandomSource:   []byte{5, 6},
+		TargetDuration: 604800,
+		TargetEndTime:  1700000000,
+	}, setup)
+}
+
+func TestDecodeEpochCommitEvent(t *testing.T) {
+	eventType, err := epoch.EpochCommitEventType(flow.Testnet)
+	require.NoError(t, err)
+
+	qc := newStruct("FlowClusterQC.ClusterQC",
+		[]string{"index", "voterIDs"},
+		cadence.NewUInt16(0), cadence.NewArray([]cadence.Value{cadence.String(nodeID)}),
+	)
+	keys := cadence.NewArray([]cadence.Value{cadence.String("abcd"), cadence.String("ef01")})
+
+	t.Run("Group key first", func(t *testing.T) {
+		event := newEvent(eventType, []string{"counter", "clusterQCs", "dkgPubKeys"},
+			cadence.NewUInt64(2), cadence.NewArray([]cadence.Value{qc}), keys,
+		)
+
+		commit, err := epoch.DecodeEpochCommitEvent(event)
+		require.NoError(t, err)
+		assert.Equal(t, &flow.EpochCommit{
+			Counter:            2,
+			ClusterQCs:         []flow.ClusterQC{{VoterIDs: []flow.Identifier{flow.HexToID(nodeID)}}},
+			DKGGroupKey:        "abcd",
+			DKGParticipantKeys: []string{"ef01"},
+		}, commit)
+	})
+
+	t.Run("Separate group key", func(t *testing.T) {
+		event := newEvent(eventType, []string{"counter", "clusterQCs", "dkgPubKeys", "dkgGroupKey"},
+			cadence.NewUInt64(2), cadence.NewArray([]cadence.Value{qc}), keys, cadence.String("1234"),
+		)
+
+		commit, err := epoch.DecodeEpochCommitEvent(event)
+		require.NoError(t, err)
+		assert.Equal(t, "1234", commit.DKGGroupKey)
+		assert.Equal(t, []string{"abcd", "ef01"}, commit.DKGParticipantKeys)
+	})
+}
+
+func newStruct(name string, fields []string, values ...cadence.Value) cadence.Struct {
+	return cadence.NewStruct(values).WithType(&cadence.StructType{
+		Location:            common.StringLocation("test"),
+		QualifiedIdentifier: name,
+		Fields:              newFields(fields, values),
+	})
+}
+
+func newEvent(eventType string, fields []string, values ...cadence.Value) flow.Event {
+	value := cadence.NewEvent(values).WithType(&cadence.EventType{
+		Location:            common.StringLocation("test"),
+		QualifiedIdentifier: eventType,
+		Fields:              newFields(fields, values),
+	})
+	return flow.Event{Type: eventType, Value: value}
+}
+
+func newFields(names []string, values []cadence.Value) []cadence.Field {
+	fields := make([]cadence.Field, len(names))
+	for i, name := range names {
+		fields[i] = cadence.Field{Identifier: name, Type: values[i].Type()}
+	}
+	return fields
+}
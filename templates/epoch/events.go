@@ -0,0 +1,171 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package epoch
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// roles are the names of the node roles, indexed by their FlowIDTableStaking value.
+var roles = []string{"", "collection", "consensus", "execution", "verification", "access"}
+
+// EpochSetupEventType returns the type of the FlowEpoch.EpochSetup events of the network, emitted by
+// the system transaction of the block starting the setup phase.
+func EpochSetupEventType(chain flow.ChainID) (string, error) {
+	return eventType(chain, "EpochSetup")
+}
+
+// EpochCommitEventType returns the type of the FlowEpoch.EpochCommit events of the network, emitted by
+// the system transaction of the block starting the committed phase.
+func EpochCommitEventType(chain flow.ChainID) (string, error) {
+	return eventType(chain, "EpochCommit")
+}
+
+// DecodeEpochSetupEvent decodes a FlowEpoch.EpochSetup event into the EpochSetup service event
+// it is converted to by the protocol.
+func DecodeEpochSetupEvent(event flow.Event) (*flow.EpochSetup, error) {
+	type nodeInfo struct {
+		ID                string `cadence:"id"`
+		Role              uint8  `cadence:"role"`
+		NetworkingAddress string `cadence:"networkingAddress"`
+		NetworkingKey     string `cadence:"networkingKey"`
+		StakingKey        string `cadence:"stakingKey"`
+		InitialWeight     uint64 `cadence:"initialWeight"`
+	}
+
+	decoded, err := sdkcadence.DecodeEvent[struct {
+		Counter            uint64     `cadence:"counter"`
+		NodeInfo           []nodeInfo `cadence:"nodeInfo"`
+		FirstView          uint64     `cadence:"firstView"`
+		FinalView          uint64     `cadence:"finalView"`
+		RandomSource       string     `cadence:"randomSource"`
+		DKGPhase1FinalView uint64     `cadence:"DKGPhase1FinalView"`
+		DKGPhase2FinalView uint64     `cadence:"DKGPhase2FinalView"`
+		DKGPhase3FinalView uint64     `cadence:"DKGPhase3FinalView"`
+		TargetDuration     uint64     `cadence:"targetDuration"`
+		TargetEndTime      uint64     `cadence:"targetEndTime"`
+	}](event)
+	if err != nil {
+		return nil, err
+	}
+
+	randomSource, err := hex.DecodeString(decoded.RandomSource)
+	if err != nil {
+		return nil, fmt.Errorf("invalid random source of epoch %d: %w", decoded.Counter, err)
+	}
+
+	participants := make([]flow.EpochParticipant, len(decoded.NodeInfo))
+	for i, node := range decoded.NodeInfo {
+		if int(node.Role) >= len(roles) || node.Role == 0 {
+			return nil, fmt.Errorf("invalid role %d of node %s", node.Role, node.ID)
+		}
+		stakingKey, err := hex.DecodeString(node.StakingKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid staking key of node %s: %w", node.ID, err)
+		}
+		networkingKey, err := hex.DecodeString(node.NetworkingKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid networking key of node %s: %w", node.ID, err)
+		}
+
+		participants[i] = flow.EpochParticipant{
+			NodeID:        flow.HexToID(node.ID),
+			Address:       node.NetworkingAddress,
+			Role:          roles[node.Role],
+			InitialWeight: node.InitialWeight,
+			StakingKey:    stakingKey,
+			NetworkingKey: networkingKey,
+		}
+	}
+
+	return &flow.EpochSetup{
+		Counter:            decoded.Counter,
+		FirstView:          decoded.FirstView,
+		DKGPhase1FinalView: decoded.DKGPhase1FinalView,
+		DKGPhase2FinalView: decoded.DKGPhase2FinalView,
+		DKGPhase3FinalView: decoded.DKGPhase3FinalView,
+		FinalView:          decoded.FinalView,
+		Participants:       participants,
+		RandomSource:       randomSource,
+		TargetDuration:     decoded.TargetDuration,
+		TargetEndTime:      decoded.TargetEndTime,
+	}, nil
+}
+
+// DecodeEpochCommitEvent decodes a FlowEpoch.EpochCommit event into the EpochCommit service event
+// it is converted to by the protocol.
+//
+// The event holds the individual votes of the cluster QCs, which are aggregated by the protocol,
+// so the signatures of the returned cluster QCs are not set.
+func DecodeEpochCommitEvent(event flow.Event) (*flow.EpochCommit, error) {
+	type clusterQC struct {
+		VoterIDs []string `cadence:"voterIDs"`
+	}
+
+	decoded, err := sdkcadence.DecodeEvent[struct {
+		Counter    uint64      `cadence:"counter"`
+		ClusterQCs []clusterQC `cadence:"clusterQCs"`
+		DKGPubKeys []string    `cadence:"dkgPubKeys"`
+		// DKGGroupKey is only set by FlowEpoch versions emitting the group key separately
+		// from the participant keys.
+		DKGGroupKey *string `cadence:"dkgGroupKey"`
+	}](event)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := &flow.EpochCommit{
+		Counter:            decoded.Counter,
+		ClusterQCs:         make([]flow.ClusterQC, len(decoded.ClusterQCs)),
+		DKGParticipantKeys: decoded.DKGPubKeys,
+	}
+	for i, qc := range decoded.ClusterQCs {
+		voterIDs := make([]flow.Identifier, len(qc.VoterIDs))
+		for j, id := range qc.VoterIDs {
+			voterIDs[j] = flow.HexToID(id)
+		}
+		commit.ClusterQCs[i] = flow.ClusterQC{VoterIDs: voterIDs}
+	}
+
+	switch {
+	case decoded.DKGGroupKey != nil:
+		commit.DKGGroupKey = *decoded.DKGGroupKey
+	case len(decoded.DKGPubKeys) > 0:
+		// earlier versions emit the group key first, followed by the participant keys
+		commit.DKGGroupKey = decoded.DKGPubKeys[0]
+		commit.DKGParticipantKeys = decoded.DKGPubKeys[1:]
+	}
+	commit.DKGGroupKey = strings.TrimPrefix(commit.DKGGroupKey, "0x")
+
+	return commit, nil
+}
+
+func eventType(chain flow.ChainID, name string) (string, error) {
+	contracts, err := templates.CoreContractAddresses(chain)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("A.%s.FlowEpoch.%s", contracts["FlowEpoch"].Hex(), name), nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// TransactionExecuted is the EVM.TransactionExecuted event emitted for each EVM transaction executed
+// on Flow, including the calls of Cadence-owned accounts.
+type TransactionExecuted struct {
+	BlockHeight uint64   `cadence:"blockHeight"`
+	Hash        [32]byte `cadence:"hash"`
+	Index       uint16   `cadence:"index"`
+	// Type is the EVM transaction type, or 0xff for calls of Cadence-owned accounts.
+	Type uint8 `cadence:"type"`
+	// Payload is the RLP-encoded EVM transaction.
+	Payload      []byte `cadence:"payload"`
+	ErrorCode    uint16 `cadence:"errorCode"`
+	ErrorMessage string `cadence:"errorMessage"`
+	GasConsumed  uint64 `cadence:"gasConsumed"`
+	// ContractAddress is the hex-encoded address of the contract deployed by the transaction, if any.
+	ContractAddress string `cadence:"contractAddress"`
+	// Logs are the RLP-encoded logs emitted by the transaction, decoded by DecodeLogs.
+	Logs         []byte `cadence:"logs"`
+	ReturnedData []byte `cadence:"returnedData"`
+}
+
+// A Log is a log emitted by an EVM transaction.
+type Log struct {
+	Address Address
+	Topics  [][32]byte
+	Data    []byte
+}
+
+// TransactionExecutedEventType returns the type of the EVM.TransactionExecuted events of the network.
+func TransactionExecutedEventType(chain flow.ChainID) (string, error) {
+	contracts, err := templates.CoreContractAddresses(chain)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("A.%s.EVM.TransactionExecuted", contracts["EVM"].Hex()), nil
+}
+
+// DecodeTransactionExecuted decodes an EVM.TransactionExecuted event.
+func DecodeTransactionExecuted(event flow.Event) (TransactionExecuted, error) {
+	return sdkcadence.DecodeEvent[TransactionExecuted](event)
+}
+
+// Failed returns true if the EVM transaction failed.
+func (e TransactionExecuted) Failed() bool {
+	return e.ErrorCode != 0
+}
+
+// DecodeLogs decodes the logs emitted by the EVM transaction.
+func (e TransactionExecuted) DecodeLogs() ([]Log, error) {
+	if len(e.Logs) == 0 {
+		return nil, nil
+	}
+
+	var logs []Log
+	if err := rlp.DecodeBytes(e.Logs, &logs); err != nil {
+		return nil, fmt.Errorf("failed to decode EVM logs: %w", err)
+	}
+	return logs, nil
+}
@@ -0,0 +1,66 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package evm provides transaction templates, scripts and event decoding for Cadence-owned accounts,
+// the EVM accounts controlled by a Flow account through the EVM contract.
+//
+// A Cadence-owned account (COA) is a resource stored by the Flow account at /storage/evm, with a
+// public capability published at /public/evm. The templates of this package use Cadence 1.0 and
+// resolve the imports of the EVM and token contracts to their addresses on the target network.
+package evm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// AddressLength is the length of EVM addresses in bytes.
+const AddressLength = 20
+
+// An Address is the address of an EVM account.
+type Address [AddressLength]byte
+
+// ParseAddress parses a hex-encoded EVM address, with or without the 0x prefix.
+func ParseAddress(s string) (Address, error) {
+	var address Address
+
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return address, fmt.Errorf("invalid EVM address %q: %w", s, err)
+	}
+	if len(b) != AddressLength {
+		return address, fmt.Errorf("invalid EVM address %q: expected %d bytes, got %d", s, AddressLength, len(b))
+	}
+
+	copy(address[:], b)
+	return address, nil
+}
+
+// Bytes returns the address as a byte slice.
+func (a Address) Bytes() []byte { return a[:] }
+
+// Hex returns the hex string representation of the address, without the 0x prefix.
+func (a Address) Hex() string {
+	return hex.EncodeToString(a[:])
+}
+
+// String returns the hex string representation of the address, with the 0x prefix.
+func (a Address) String() string {
+	return "0x" + a.Hex()
+}
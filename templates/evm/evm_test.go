@@ -0,0 +1,238 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/mocks"
+	"github.com/onflow/flow-go-sdk/templates/evm"
+)
+
+var evmAddress = evm.Address{0xde, 0xad, 0xbe, 0xef, 19: 0x01}
+
+func TestParseAddress(t *testing.T) {
+	address, err := evm.ParseAddress("0xdeadbeef00000000000000000000000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, evmAddress, address)
+	assert.Equal(t, "0xdeadbeef00000000000000000000000000000001", address.String())
+
+	address, err = evm.ParseAddress(evmAddress.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, evmAddress, address)
+
+	_, err = evm.ParseAddress("0xdeadbeef")
+	assert.Error(t, err)
+
+	_, err = evm.ParseAddress("0xzz")
+	assert.Error(t, err)
+}
+
+func TestTransactions(t *testing.T) {
+	signer := flow.HexToAddress("01")
+
+	t.Run("CreateCOA", func(t *testing.T) {
+		tx, err := evm.CreateCOA(flow.Testnet, signer, "1.5")
+		require.NoError(t, err)
+
+		assert.Contains(t, string(tx.Script), "import EVM from 0x8c5303eaa26202d6")
+		assert.Contains(t, string(tx.Script), "import FlowToken from 0x7e60df042a9c0868")
+		assert.Equal(t, []flow.Address{signer}, tx.Authorizers)
+		assert.Equal(t, mustUFix64(t, "1.5"), mustDecode(t, tx.Arguments[0]))
+	})
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		_, err := evm.DepositFLOW(flow.Mainnet, signer, "-1")
+		assert.Error(t, err)
+
+		_, err = evm.WithdrawFLOW(flow.Mainnet, signer, "abc")
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown chain", func(t *testing.T) {
+		_, err := evm.CreateCOA(flow.ChainID("unknown"), signer, "1.0")
+		assert.Error(t, err)
+	})
+
+	t.Run("Call", func(t *testing.T) {
+		tx, err := evm.Call(flow.Emulator, signer, evmAddress, []byte{0x01, 0x02}, 100_000, big.NewInt(10))
+		require.NoError(t, err)
+
+		assert.Contains(t, string(tx.Script), "import EVM from 0xf8d6e0586b0a20c7")
+		assert.Equal(t, []flow.Address{signer}, tx.Authorizers)
+		require.Len(t, tx.Arguments, 4)
+		assert.Equal(t, cadence.String(evmAddress.Hex()), mustDecode(t, tx.Arguments[0]))
+		assert.Equal(t, cadence.NewArray([]cadence.Value{cadence.NewUInt8(1), cadence.NewUInt8(2)}), mustDecode(t, tx.Arguments[1]))
+		assert.Equal(t, cadence.NewUInt64(100_000), mustDecode(t, tx.Arguments[2]))
+		assert.Equal(t, cadence.NewUInt(10), mustDecode(t, tx.Arguments[3]))
+
+		tx, err = evm.Call(flow.Emulator, signer, evmAddress, nil, 100_000, nil)
+		require.NoError(t, err)
+		assert.Equal(t, cadence.NewUInt(0), mustDecode(t, tx.Arguments[3]))
+
+		_, err = evm.Call(flow.Emulator, signer, evmAddress, nil, 100_000, big.NewInt(-1))
+		assert.Error(t, err)
+	})
+
+	t.Run("Run", func(t *testing.T) {
+		tx, err := evm.Run(flow.Mainnet, []byte{0xf8}, evmAddress)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(tx.Script), "import EVM from 0xe467b9dd11fa00df")
+		assert.Empty(t, tx.Authorizers)
+		assert.Equal(t, cadence.NewArray([]cadence.Value{cadence.NewUInt8(0xf8)}), mustDecode(t, tx.Arguments[0]))
+		assert.Equal(t, cadence.String(evmAddress.Hex()), mustDecode(t, tx.Arguments[1]))
+	})
+}
+
+func TestScripts(t *testing.T) {
+	ctx := context.Background()
+	owner := flow.HexToAddress("01")
+
+	t.Run("GetCOAAddress", func(t *testing.T) {
+		client := &mocks.Client{}
+		client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.Address(owner)}).
+			Return(cadence.NewOptional(cadence.String(evmAddress.Hex())), nil).Once()
+		client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, mock.Anything).
+			Return(cadence.NewOptional(nil), nil).Once()
+
+		address, err := evm.GetCOAAddress(ctx, client, flow.Emulator, owner)
+		require.NoError(t, err)
+		assert.Equal(t, &evmAddress, address)
+
+		address, err = evm.GetCOAAddress(ctx, client, flow.Emulator, flow.HexToAddress("02"))
+		require.NoError(t, err)
+		assert.Nil(t, address)
+
+		script := client.Calls[0].Arguments.Get(1).([]byte)
+		assert.Contains(t, string(script), "import EVM from 0xf8d6e0586b0a20c7")
+	})
+
+	t.Run("GetBalance", func(t *testing.T) {
+		balance, _ := new(big.Int).SetString("1500000000000000000", 10)
+
+		client := &mocks.Client{}
+		client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.String(evmAddress.Hex())}).
+			Return(cadence.NewUIntFromBig(balance))
+
+		result, err := evm.GetBalance(ctx, client, flow.Emulator, evmAddress)
+		require.NoError(t, err)
+		assert.Equal(t, balance, result)
+	})
+}
+
+func TestDecodeTransactionExecuted(t *testing.T) {
+	logs := []evm.Log{{
+		Address: evmAddress,
+		Topics:  [][32]byte{{0x01}, {0x02}},
+		Data:    []byte{0x03},
+	}}
+	encodedLogs, err := rlp.EncodeToBytes(logs)
+	require.NoError(t, err)
+
+	eventType, err := evm.TransactionExecutedEventType(flow.Mainnet)
+	require.NoError(t, err)
+	assert.Equal(t, "A.e467b9dd11fa00df.EVM.TransactionExecuted", eventType)
+
+	hash := make([]cadence.Value, 32)
+	for i := range hash {
+		hash[i] = cadence.NewUInt8(uint8(i))
+	}
+
+	value := cadence.NewEvent([]cadence.Value{
+		cadence.NewUInt64(42),
+		cadence.NewArray(hash),
+		cadence.NewUInt16(1),
+		cadence.NewUInt8(0xff),
+		bytesValue([]byte{0xf8}),
+		cadence.NewUInt16(0),
+		cadence.String(""),
+		cadence.NewUInt64(21_000),
+		cadence.String(""),
+		bytesValue(encodedLogs),
+		bytesValue([]byte{0x2a}),
+	}).WithType(&cadence.EventType{
+		Location:            common.AddressLocation{Address: common.Address(flow.HexToAddress("e467b9dd11fa00df")), Name: "EVM"},
+		QualifiedIdentifier: "EVM.TransactionExecuted",
+		Fields: []cadence.Field{
+			{Identifier: "blockHeight", Type: cadence.UInt64Type{}},
+			{Identifier: "hash", Type: &cadence.ConstantSizedArrayType{Size: 32, ElementType: cadence.UInt8Type{}}},
+			{Identifier: "index", Type: cadence.UInt16Type{}},
+			{Identifier: "type", Type: cadence.UInt8Type{}},
+			{Identifier: "payload", Type: &cadence.VariableSizedArrayType{ElementType: cadence.UInt8Type{}}},
+			{Identifier: "errorCode", Type: cadence.UInt16Type{}},
+			{Identifier: "errorMessage", Type: cadence.StringType{}},
+			{Identifier: "gasConsumed", Type: cadence.UInt64Type{}},
+			{Identifier: "contractAddress", Type: cadence.StringType{}},
+			{Identifier: "logs", Type: &cadence.VariableSizedArrayType{ElementType: cadence.UInt8Type{}}},
+			{Identifier: "returnedData", Type: &cadence.VariableSizedArrayType{ElementType: cadence.UInt8Type{}}},
+		},
+	})
+
+	executed, err := evm.DecodeTransactionExecuted(flow.Event{Type: eventType, Value: value})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(42), executed.BlockHeight)
+	assert.Equal(t, byte(31), executed.Hash[31])
+	assert.Equal(t, uint16(1), executed.Index)
+	assert.Equal(t, uint8(0xff), executed.Type)
+	assert.Equal(t, []byte{0xf8}, executed.Payload)
+	assert.Equal(t, uint64(21_000), executed.GasConsumed)
+	assert.Equal(t, []byte{0x2a}, executed.ReturnedData)
+	assert.False(t, executed.Failed())
+
+	decodedLogs, err := executed.DecodeLogs()
+	require.NoError(t, err)
+	assert.Equal(t, logs, decodedLogs)
+
+	executed.Logs = []byte{0x01, 0x02}
+	_, err = executed.DecodeLogs()
+	assert.Error(t, err)
+}
+
+func bytesValue(b []byte) cadence.Array {
+	values := make([]cadence.Value, len(b))
+	for i, v := range b {
+		values[i] = cadence.NewUInt8(v)
+	}
+	return cadence.NewArray(values)
+}
+
+func mustUFix64(t *testing.T, s string) cadence.UFix64 {
+	value, err := cadence.NewUFix64(s)
+	require.NoError(t, err)
+	return value
+}
+
+func mustDecode(t *testing.T, argument []byte) cadence.Value {
+	value, err := jsoncdc.Decode(nil, argument)
+	require.NoError(t, err)
+	return value
+}
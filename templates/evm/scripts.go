@@ -0,0 +1,90 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const getCOAAddressScript = `
+import EVM
+
+access(all) fun main(address: Address): String? {
+	return getAccount(address).capabilities.borrow<&EVM.CadenceOwnedAccount>(/public/evm)?.address()?.toString()
+}
+`
+
+const getBalanceScript = `
+import EVM
+
+access(all) fun main(address: String): UInt {
+	return EVM.addressFromString(address).balance().attoflow
+}
+`
+
+// GetCOAAddress returns the EVM address of the Cadence-owned account published by the Flow account
+// at /public/evm, or nil if the account has no Cadence-owned account.
+func GetCOAAddress(
+	ctx context.Context,
+	client access.Client,
+	chain flow.ChainID,
+	owner flow.Address,
+) (*Address, error) {
+	script, err := templates.ResolveImports(chain, []byte(getCOAAddressScript))
+	if err != nil {
+		return nil, err
+	}
+
+	var hex *string
+	err = access.ExecuteScriptAtLatestBlockInto(ctx, client, script, []cadence.Value{cadence.Address(owner)}, &hex)
+	if err != nil {
+		return nil, err
+	}
+	if hex == nil {
+		return nil, nil
+	}
+
+	address, err := ParseAddress(*hex)
+	if err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// GetBalance returns the balance of the EVM account in attoflow.
+func GetBalance(ctx context.Context, client access.Client, chain flow.ChainID, address Address) (*big.Int, error) {
+	script, err := templates.ResolveImports(chain, []byte(getBalanceScript))
+	if err != nil {
+		return nil, err
+	}
+
+	var balance big.Int
+	err = access.ExecuteScriptAtLatestBlockInto(ctx, client, script, []cadence.Value{cadence.String(address.Hex())}, &balance)
+	if err != nil {
+		return nil, err
+	}
+	return &balance, nil
+}
@@ -0,0 +1,233 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const createCOATemplate = `
+import FungibleToken
+import FlowToken
+import EVM
+
+transaction(amount: UFix64) {
+	prepare(signer: auth(BorrowValue, SaveValue, IssueStorageCapabilityController, PublishCapability) &Account) {
+		if signer.storage.type(at: /storage/evm) != nil {
+			panic("account already stores a Cadence-owned account at /storage/evm")
+		}
+
+		let coa <- EVM.createCadenceOwnedAccount()
+
+		if amount > 0.0 {
+			let vault = signer.storage.borrow<auth(FungibleToken.Withdraw) &FlowToken.Vault>(from: /storage/flowTokenVault)
+				?? panic("could not borrow the FLOW vault of the signer")
+			coa.deposit(from: <-vault.withdraw(amount: amount) as! @FlowToken.Vault)
+		}
+
+		signer.storage.save(<-coa, to: /storage/evm)
+		let capability = signer.capabilities.storage.issue<&EVM.CadenceOwnedAccount>(/storage/evm)
+		signer.capabilities.publish(capability, at: /public/evm)
+	}
+}
+`
+
+const depositTemplate = `
+import FungibleToken
+import FlowToken
+import EVM
+
+transaction(amount: UFix64) {
+	prepare(signer: auth(BorrowValue) &Account) {
+		let vault = signer.storage.borrow<auth(FungibleToken.Withdraw) &FlowToken.Vault>(from: /storage/flowTokenVault)
+			?? panic("could not borrow the FLOW vault of the signer")
+		let coa = signer.storage.borrow<&EVM.CadenceOwnedAccount>(from: /storage/evm)
+			?? panic("could not borrow the Cadence-owned account of the signer")
+
+		coa.deposit(from: <-vault.withdraw(amount: amount) as! @FlowToken.Vault)
+	}
+}
+`
+
+const withdrawTemplate = `
+import FungibleToken
+import FlowToken
+import EVM
+
+transaction(amount: UFix64) {
+	prepare(signer: auth(BorrowValue) &Account) {
+		let coa = signer.storage.borrow<auth(EVM.Withdraw) &EVM.CadenceOwnedAccount>(from: /storage/evm)
+			?? panic("could not borrow the Cadence-owned account of the signer")
+		let receiver = signer.storage.borrow<&{FungibleToken.Receiver}>(from: /storage/flowTokenVault)
+			?? panic("could not borrow the FLOW vault of the signer")
+
+		let balance = EVM.Balance(attoflow: 0)
+		balance.setFLOW(flow: amount)
+		receiver.deposit(from: <-coa.withdraw(balance: balance))
+	}
+}
+`
+
+const callTemplate = `
+import EVM
+
+transaction(to: String, data: [UInt8], gasLimit: UInt64, value: UInt) {
+	prepare(signer: auth(BorrowValue) &Account) {
+		let coa = signer.storage.borrow<auth(EVM.Call) &EVM.CadenceOwnedAccount>(from: /storage/evm)
+			?? panic("could not borrow the Cadence-owned account of the signer")
+
+		let result = coa.call(
+			to: EVM.addressFromString(to),
+			data: data,
+			gasLimit: gasLimit,
+			value: EVM.Balance(attoflow: value)
+		)
+		assert(
+			result.status == EVM.Status.successful,
+			message: "EVM call failed with error code ".concat(result.errorCode.toString()).concat(": ").concat(result.errorMessage)
+		)
+	}
+}
+`
+
+const runTemplate = `
+import EVM
+
+transaction(tx: [UInt8], coinbase: String) {
+	execute {
+		let result = EVM.run(tx: tx, coinbase: EVM.addressFromString(coinbase))
+		assert(
+			result.status == EVM.Status.successful,
+			message: "EVM transaction failed with error code ".concat(result.errorCode.toString()).concat(": ").concat(result.errorMessage)
+		)
+	}
+}
+`
+
+// CreateCOA generates a transaction creating a Cadence-owned account for the signer, funded with the
+// amount of FLOW withdrawn from the FLOW vault of the signer, such as "1.5".
+//
+// The transaction fails if the signer already stores a Cadence-owned account.
+func CreateCOA(chain flow.ChainID, signer flow.Address, amount string) (*flow.Transaction, error) {
+	return newAmountTransaction(chain, createCOATemplate, signer, amount)
+}
+
+// DepositFLOW generates a transaction moving the amount of FLOW from the FLOW vault of the signer
+// to its Cadence-owned account.
+func DepositFLOW(chain flow.ChainID, signer flow.Address, amount string) (*flow.Transaction, error) {
+	return newAmountTransaction(chain, depositTemplate, signer, amount)
+}
+
+// WithdrawFLOW generates a transaction moving the amount of FLOW from the Cadence-owned account of the
+// signer to its FLOW vault.
+//
+// The EVM only withdraws amounts representable as UFix64, so amounts are given in FLOW and not in attoflow.
+func WithdrawFLOW(chain flow.ChainID, signer flow.Address, amount string) (*flow.Transaction, error) {
+	return newAmountTransaction(chain, withdrawTemplate, signer, amount)
+}
+
+// Call generates a transaction calling the EVM contract at the address from the Cadence-owned account
+// of the signer, with the ABI-encoded call data and the value in attoflow, which can be nil.
+//
+// The transaction fails if the EVM call does not succeed.
+func Call(
+	chain flow.ChainID,
+	signer flow.Address,
+	to Address,
+	data []byte,
+	gasLimit uint64,
+	value *big.Int,
+) (*flow.Transaction, error) {
+	if value == nil {
+		value = new(big.Int)
+	}
+	attoflow, err := cadence.NewUIntFromBig(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EVM call value %s: %w", value, err)
+	}
+
+	tx, err := newTransaction(chain, callTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(to.Hex()))).
+		AddRawArgument(jsoncdc.MustEncode(bytesValue(data))).
+		AddRawArgument(jsoncdc.MustEncode(cadence.NewUInt64(gasLimit))).
+		AddRawArgument(jsoncdc.MustEncode(attoflow)).
+		AddAuthorizer(signer), nil
+}
+
+// Run generates a transaction executing the RLP-encoded signed EVM transaction, paying the EVM gas
+// fees to the coinbase address.
+//
+// The transaction has no authorizers, it only requires a payer and a proposer. It fails if the EVM
+// transaction does not succeed.
+func Run(chain flow.ChainID, signedTx []byte, coinbase Address) (*flow.Transaction, error) {
+	tx, err := newTransaction(chain, runTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(bytesValue(signedTx))).
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(coinbase.Hex()))), nil
+}
+
+func newAmountTransaction(chain flow.ChainID, template string, signer flow.Address, amount string) (*flow.Transaction, error) {
+	value, err := cadence.NewUFix64(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FLOW amount %q: %w", amount, err)
+	}
+
+	tx, err := newTransaction(chain, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(value)).
+		AddAuthorizer(signer), nil
+}
+
+func newTransaction(chain flow.ChainID, template string) (*flow.Transaction, error) {
+	script, err := templates.ResolveImports(chain, []byte(template))
+	if err != nil {
+		return nil, err
+	}
+
+	return flow.NewTransaction().SetScript(script), nil
+}
+
+// bytesValue converts the bytes to a Cadence [UInt8] array, the type of bytes in the EVM contract.
+func bytesValue(b []byte) cadence.Array {
+	values := make([]cadence.Value, len(b))
+	for i, v := range b {
+		values[i] = cadence.NewUInt8(v)
+	}
+	return cadence.NewArray(values)
+}
@@ -0,0 +1,52 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ft
+
+import (
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+)
+
+// TokensDeposited is the TokensDeposited event emitted by token contracts when tokens are deposited
+// into a vault.
+type TokensDeposited struct {
+	// Amount is the deposited amount, such as "10.50000000".
+	Amount string `cadence:"amount"`
+	// To is the owner of the vault, or nil if the vault is not stored in an account.
+	To *flow.Address `cadence:"to"`
+}
+
+// TokensWithdrawn is the TokensWithdrawn event emitted by token contracts when tokens are withdrawn
+// from a vault.
+type TokensWithdrawn struct {
+	// Amount is the withdrawn amount, such as "10.50000000".
+	Amount string `cadence:"amount"`
+	// From is the owner of the vault, or nil if the vault is not stored in an account.
+	From *flow.Address `cadence:"from"`
+}
+
+// DecodeTokensDeposited decodes a TokensDeposited event, such as A.1654653399040a61.FlowToken.TokensDeposited.
+func DecodeTokensDeposited(event flow.Event) (TokensDeposited, error) {
+	return sdkcadence.DecodeEvent[TokensDeposited](event)
+}
+
+// DecodeTokensWithdrawn decodes a TokensWithdrawn event, such as A.1654653399040a61.FlowToken.TokensWithdrawn.
+func DecodeTokensWithdrawn(event flow.Event) (TokensWithdrawn, error) {
+	return sdkcadence.DecodeEvent[TokensWithdrawn](event)
+}
@@ -0,0 +1,120 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ft provides transaction templates, scripts and event decoding for fungible tokens
+// implementing the FungibleToken standard, such as FLOW and USDC.
+//
+// The templates use Cadence 1.0 and work with any token: the token contract and the paths of its
+// vaults are passed as arguments, so a Token only needs to describe where the token is deployed and
+// where its vaults are stored.
+package ft
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// ErrVaultNotFound is returned when querying the balance of an account without a vault for the token.
+var ErrVaultNotFound = errors.New("ft: account has no vault for the token")
+
+// A Token is a fungible token contract and the paths its vaults are stored and published at.
+type Token struct {
+	// ContractName is the name of the token contract, such as "FlowToken".
+	ContractName    string
+	ContractAddress flow.Address
+	// StoragePath is the path vaults are stored at, such as "/storage/flowTokenVault".
+	StoragePath string
+	// ReceiverPath is the public path of the FungibleToken.Receiver capability of vaults.
+	ReceiverPath string
+	// BalancePath is the public path of the FungibleToken.Balance capability of vaults.
+	BalancePath string
+}
+
+// FlowToken returns the FLOW token of the network.
+func FlowToken(chain flow.ChainID) (Token, error) {
+	contracts, err := templates.CoreContractAddresses(chain)
+	if err != nil {
+		return Token{}, err
+	}
+
+	return Token{
+		ContractName:    "FlowToken",
+		ContractAddress: contracts["FlowToken"],
+		StoragePath:     "/storage/flowTokenVault",
+		ReceiverPath:    "/public/flowTokenReceiver",
+		BalancePath:     "/public/flowTokenBalance",
+	}, nil
+}
+
+var usdcAddresses = map[flow.ChainID]flow.Address{
+	flow.Mainnet: flow.HexToAddress("f1ab99c82dee3526"),
+	flow.Testnet: flow.HexToAddress("64adf39cbc354fcb"),
+}
+
+// USDC returns the USDC token of the network, bridged to Flow as the USDCFlow contract.
+//
+// An error is returned for networks USDC is not deployed to, such as the emulator.
+func USDC(chain flow.ChainID) (Token, error) {
+	address, ok := usdcAddresses[chain]
+	if !ok {
+		return Token{}, fmt.Errorf("USDC is not deployed on chain %s", chain)
+	}
+
+	return Token{
+		ContractName:    "USDCFlow",
+		ContractAddress: address,
+		StoragePath:     "/storage/usdcFlowVault",
+		ReceiverPath:    "/public/usdcFlowReceiver",
+		BalancePath:     "/public/usdcFlowMetadata",
+	}, nil
+}
+
+// VaultType returns the type identifier of the vaults of the token, such as "A.1654653399040a61.FlowToken.Vault".
+func (t Token) VaultType() string {
+	return t.typeID("Vault")
+}
+
+// TokensDepositedEventType returns the type of the TokensDeposited events of the token.
+func (t Token) TokensDepositedEventType() string {
+	return t.typeID("TokensDeposited")
+}
+
+// TokensWithdrawnEventType returns the type of the TokensWithdrawn events of the token.
+func (t Token) TokensWithdrawnEventType() string {
+	return t.typeID("TokensWithdrawn")
+}
+
+func (t Token) typeID(name string) string {
+	return fmt.Sprintf("A.%s.%s.%s", t.ContractAddress.Hex(), t.ContractName, name)
+}
+
+// parsePath parses a path such as "/storage/flowTokenVault" into a Cadence path of the domain.
+func parsePath(path string, domain string) (cadence.Path, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "" || parts[1] != domain || parts[2] == "" {
+		return cadence.Path{}, fmt.Errorf("invalid %s path %q", domain, path)
+	}
+
+	return cadence.Path{Domain: domain, Identifier: parts[2]}, nil
+}
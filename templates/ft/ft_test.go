@@ -0,0 +1,168 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ft_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/mocks"
+	"github.com/onflow/flow-go-sdk/templates/ft"
+)
+
+func TestTokens(t *testing.T) {
+	flowToken, err := ft.FlowToken(flow.Mainnet)
+	require.NoError(t, err)
+	assert.Equal(t, "A.1654653399040a61.FlowToken.Vault", flowToken.VaultType())
+	assert.Equal(t, "A.1654653399040a61.FlowToken.TokensDeposited", flowToken.TokensDepositedEventType())
+	assert.Equal(t, "A.1654653399040a61.FlowToken.TokensWithdrawn", flowToken.TokensWithdrawnEventType())
+
+	flowToken, err = ft.FlowToken(flow.Emulator)
+	require.NoError(t, err)
+	assert.Equal(t, flow.HexToAddress("0ae53cb6e3f42a79"), flowToken.ContractAddress)
+
+	usdc, err := ft.USDC(flow.Testnet)
+	require.NoError(t, err)
+	assert.Equal(t, "USDCFlow", usdc.ContractName)
+
+	_, err = ft.USDC(flow.Emulator)
+	assert.Error(t, err)
+}
+
+func TestSetupVault(t *testing.T) {
+	signer := flow.HexToAddress("01")
+	token, err := ft.USDC(flow.Mainnet)
+	require.NoError(t, err)
+
+	tx, err := ft.SetupVault(flow.Mainnet, token, signer)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(tx.Script), "import FungibleToken from 0xf233dcee88fe0abe")
+	assert.Equal(t, []flow.Address{signer}, tx.Authorizers)
+	assert.Equal(t, []cadence.Value{
+		cadence.Address(token.ContractAddress),
+		cadence.String("USDCFlow"),
+		cadence.String("A.f1ab99c82dee3526.USDCFlow.Vault"),
+		cadence.Path{Domain: "storage", Identifier: "usdcFlowVault"},
+		cadence.Path{Domain: "public", Identifier: "usdcFlowReceiver"},
+		cadence.Path{Domain: "public", Identifier: "usdcFlowMetadata"},
+	}, decodeArguments(t, tx))
+
+	token.ReceiverPath = "/storage/usdcFlowReceiver"
+	_, err = ft.SetupVault(flow.Mainnet, token, signer)
+	assert.Error(t, err)
+}
+
+func TestTransfer(t *testing.T) {
+	sender := flow.HexToAddress("01")
+	recipient := flow.HexToAddress("02")
+	token, err := ft.FlowToken(flow.Testnet)
+	require.NoError(t, err)
+
+	tx, err := ft.Transfer(flow.Testnet, token, sender, recipient, "10.5")
+	require.NoError(t, err)
+
+	amount, _ := cadence.NewUFix64("10.5")
+	assert.Contains(t, string(tx.Script), "import FungibleToken from 0x9a0766d93b6608b7")
+	assert.Equal(t, []flow.Address{sender}, tx.Authorizers)
+	assert.Equal(t, []cadence.Value{
+		amount,
+		cadence.Address(recipient),
+		cadence.Path{Domain: "storage", Identifier: "flowTokenVault"},
+		cadence.Path{Domain: "public", Identifier: "flowTokenReceiver"},
+	}, decodeArguments(t, tx))
+
+	_, err = ft.Transfer(flow.Testnet, token, sender, recipient, "ten")
+	assert.Error(t, err)
+
+	token.StoragePath = "flowTokenVault"
+	_, err = ft.Transfer(flow.Testnet, token, sender, recipient, "10.5")
+	assert.Error(t, err)
+}
+
+func TestGetBalance(t *testing.T) {
+	ctx := context.Background()
+	token, err := ft.FlowToken(flow.Emulator)
+	require.NoError(t, err)
+
+	withVault := flow.HexToAddress("01")
+	withoutVault := flow.HexToAddress("02")
+	balancePath := cadence.Path{Domain: "public", Identifier: "flowTokenBalance"}
+	balance, _ := cadence.NewUFix64("10.5")
+
+	client := &mocks.Client{}
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.Address(withVault), balancePath}).
+		Return(cadence.NewOptional(balance), nil)
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.Address(withoutVault), balancePath}).
+		Return(cadence.NewOptional(nil), nil)
+
+	result, err := ft.GetBalance(ctx, client, flow.Emulator, token, withVault)
+	require.NoError(t, err)
+	assert.Equal(t, "10.50000000", result)
+
+	_, err = ft.GetBalance(ctx, client, flow.Emulator, token, withoutVault)
+	assert.ErrorIs(t, err, ft.ErrVaultNotFound)
+}
+
+func TestDecodeEvents(t *testing.T) {
+	token, err := ft.FlowToken(flow.Mainnet)
+	require.NoError(t, err)
+
+	owner := flow.HexToAddress("01")
+	amount, _ := cadence.NewUFix64("1.5")
+
+	deposited, err := ft.DecodeTokensDeposited(newEvent(token.TokensDepositedEventType(), "to", amount, cadence.NewOptional(cadence.Address(owner))))
+	require.NoError(t, err)
+	assert.Equal(t, ft.TokensDeposited{Amount: "1.50000000", To: &owner}, deposited)
+
+	withdrawn, err := ft.DecodeTokensWithdrawn(newEvent(token.TokensWithdrawnEventType(), "from", amount, cadence.NewOptional(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, ft.TokensWithdrawn{Amount: "1.50000000"}, withdrawn)
+}
+
+func newEvent(eventType string, addressField string, amount cadence.Value, address cadence.Value) flow.Event {
+	value := cadence.NewEvent([]cadence.Value{amount, address}).WithType(&cadence.EventType{
+		Location:            common.StringLocation("test"),
+		QualifiedIdentifier: eventType,
+		Fields: []cadence.Field{
+			{Identifier: "amount", Type: cadence.UFix64Type{}},
+			{Identifier: addressField, Type: &cadence.OptionalType{Type: cadence.AddressType{}}},
+		},
+	})
+
+	return flow.Event{Type: eventType, Value: value}
+}
+
+func decodeArguments(t *testing.T, tx *flow.Transaction) []cadence.Value {
+	values := make([]cadence.Value, len(tx.Arguments))
+	for i, argument := range tx.Arguments {
+		value, err := jsoncdc.Decode(nil, argument)
+		require.NoError(t, err)
+		values[i] = value
+	}
+	return values
+}
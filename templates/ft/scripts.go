@@ -0,0 +1,75 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ft
+
+import (
+	"context"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const getBalanceScript = `
+import FungibleToken
+
+access(all) fun main(address: Address, balancePath: PublicPath): UFix64? {
+	return getAccount(address).capabilities.borrow<&{FungibleToken.Balance}>(balancePath)?.balance
+}
+`
+
+// GetBalance returns the balance of the token in the vault of the account, such as "10.50000000".
+//
+// ErrVaultNotFound is returned if the account has no balance capability published for the token.
+func GetBalance(
+	ctx context.Context,
+	client access.Client,
+	chain flow.ChainID,
+	token Token,
+	address flow.Address,
+) (string, error) {
+	balancePath, err := parsePath(token.BalancePath, "public")
+	if err != nil {
+		return "", err
+	}
+
+	script, err := templates.ResolveImports(chain, []byte(getBalanceScript))
+	if err != nil {
+		return "", err
+	}
+
+	var balance *string
+	err = access.ExecuteScriptAtLatestBlockInto(
+		ctx,
+		client,
+		script,
+		[]cadence.Value{cadence.Address(address), balancePath},
+		&balance,
+	)
+	if err != nil {
+		return "", err
+	}
+	if balance == nil {
+		return "", ErrVaultNotFound
+	}
+
+	return *balance, nil
+}
@@ -0,0 +1,149 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ft
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const setupVaultTemplate = `
+import FungibleToken
+
+transaction(contractAddress: Address, contractName: String, vaultType: String, storagePath: StoragePath, receiverPath: PublicPath, balancePath: PublicPath) {
+	prepare(signer: auth(SaveValue, IssueStorageCapabilityController, PublishCapability, UnpublishCapability) &Account) {
+		if signer.storage.type(at: storagePath) != nil {
+			return
+		}
+
+		let token = getAccount(contractAddress).contracts.borrow<&{FungibleToken}>(name: contractName)
+			?? panic("could not borrow the fungible token contract ".concat(contractName))
+		let vault <- token.createEmptyVault(vaultType: CompositeType(vaultType) ?? panic("invalid vault type ".concat(vaultType)))
+		signer.storage.save(<-vault, to: storagePath)
+
+		signer.capabilities.unpublish(receiverPath)
+		signer.capabilities.publish(signer.capabilities.storage.issue<&{FungibleToken.Receiver}>(storagePath), at: receiverPath)
+
+		signer.capabilities.unpublish(balancePath)
+		signer.capabilities.publish(signer.capabilities.storage.issue<&{FungibleToken.Balance}>(storagePath), at: balancePath)
+	}
+}
+`
+
+const transferTemplate = `
+import FungibleToken
+
+transaction(amount: UFix64, recipient: Address, storagePath: StoragePath, receiverPath: PublicPath) {
+	let sentVault: @{FungibleToken.Vault}
+
+	prepare(signer: auth(BorrowValue) &Account) {
+		let vault = signer.storage.borrow<auth(FungibleToken.Withdraw) &{FungibleToken.Vault}>(from: storagePath)
+			?? panic("could not borrow the vault of the signer")
+		self.sentVault <- vault.withdraw(amount: amount)
+	}
+
+	execute {
+		let receiver = getAccount(recipient).capabilities.borrow<&{FungibleToken.Receiver}>(receiverPath)
+			?? panic("could not borrow the receiver of the recipient")
+		receiver.deposit(from: <-self.sentVault)
+	}
+}
+`
+
+// SetupVault generates a transaction storing an empty vault of the token in the account of the signer
+// and publishing its receiver and balance capabilities, so the account can receive the token.
+//
+// The transaction does nothing if the signer already stores a value at the storage path of the token.
+func SetupVault(chain flow.ChainID, token Token, signer flow.Address) (*flow.Transaction, error) {
+	storagePath, err := parsePath(token.StoragePath, "storage")
+	if err != nil {
+		return nil, err
+	}
+	receiverPath, err := parsePath(token.ReceiverPath, "public")
+	if err != nil {
+		return nil, err
+	}
+	balancePath, err := parsePath(token.BalancePath, "public")
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := newTransaction(chain, setupVaultTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(cadence.Address(token.ContractAddress))).
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(token.ContractName))).
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(token.VaultType()))).
+		AddRawArgument(jsoncdc.MustEncode(storagePath)).
+		AddRawArgument(jsoncdc.MustEncode(receiverPath)).
+		AddRawArgument(jsoncdc.MustEncode(balancePath)).
+		AddAuthorizer(signer), nil
+}
+
+// Transfer generates a transaction transferring the amount of the token, such as "10.5", from the
+// vault of the sender to the receiver of the recipient.
+func Transfer(
+	chain flow.ChainID,
+	token Token,
+	sender flow.Address,
+	recipient flow.Address,
+	amount string,
+) (*flow.Transaction, error) {
+	value, err := cadence.NewUFix64(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token amount %q: %w", amount, err)
+	}
+	storagePath, err := parsePath(token.StoragePath, "storage")
+	if err != nil {
+		return nil, err
+	}
+	receiverPath, err := parsePath(token.ReceiverPath, "public")
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := newTransaction(chain, transferTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(value)).
+		AddRawArgument(jsoncdc.MustEncode(cadence.Address(recipient))).
+		AddRawArgument(jsoncdc.MustEncode(storagePath)).
+		AddRawArgument(jsoncdc.MustEncode(receiverPath)).
+		AddAuthorizer(sender), nil
+}
+
+func newTransaction(chain flow.ChainID, template string) (*flow.Transaction, error) {
+	script, err := templates.ResolveImports(chain, []byte(template))
+	if err != nil {
+		return nil, err
+	}
+
+	return flow.NewTransaction().SetScript(script), nil
+}
@@ -0,0 +1,247 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// ContractAddresses maps contract names to the address of the account they are deployed to.
+type ContractAddresses map[string]flow.Address
+
+var coreContractAddresses = map[flow.ChainID]ContractAddresses{
+	flow.Mainnet: {
+		"FungibleToken":              flow.HexToAddress("f233dcee88fe0abe"),
+		"FungibleTokenMetadataViews": flow.HexToAddress("f233dcee88fe0abe"),
+		"FlowToken":                  flow.HexToAddress("1654653399040a61"),
+		"FlowFees":                   flow.HexToAddress("f919ee77447b7497"),
+		"FlowServiceAccount":         flow.HexToAddress("e467b9dd11fa00df"),
+		"FlowStorageFees":            flow.HexToAddress("e467b9dd11fa00df"),
+		"FlowIDTableStaking":         flow.HexToAddress("8624b52f9ddcd04a"),
+		"FlowEpoch":                  flow.HexToAddress("8624b52f9ddcd04a"),
+		"FlowClusterQC":              flow.HexToAddress("8624b52f9ddcd04a"),
+		"FlowDKG":                    flow.HexToAddress("8624b52f9ddcd04a"),
+		"LockedTokens":               flow.HexToAddress("8d0e87b65159ae63"),
+		"FlowStakingCollection":      flow.HexToAddress("8d0e87b65159ae63"),
+		"NonFungibleToken":           flow.HexToAddress("1d7e57aa55817448"),
+		"MetadataViews":              flow.HexToAddress("1d7e57aa55817448"),
+		"EVM":                        flow.HexToAddress("e467b9dd11fa00df"),
+	},
+	flow.Testnet: {
+		"FungibleToken":              flow.HexToAddress("9a0766d93b6608b7"),
+		"FungibleTokenMetadataViews": flow.HexToAddress("9a0766d93b6608b7"),
+		"FlowToken":                  flow.HexToAddress("7e60df042a9c0868"),
+		"FlowFees":                   flow.HexToAddress("912d5440f7e3769e"),
+		"FlowServiceAccount":         flow.HexToAddress("8c5303eaa26202d6"),
+		"FlowStorageFees":            flow.HexToAddress("8c5303eaa26202d6"),
+		"FlowIDTableStaking":         flow.HexToAddress("9eca2b38b18b5dfe"),
+		"FlowEpoch":                  flow.HexToAddress("9eca2b38b18b5dfe"),
+		"FlowClusterQC":              flow.HexToAddress("9eca2b38b18b5dfe"),
+		"FlowDKG":                    flow.HexToAddress("9eca2b38b18b5dfe"),
+		"LockedTokens":               flow.HexToAddress("95e019a17d0e23d7"),
+		"FlowStakingCollection":      flow.HexToAddress("95e019a17d0e23d7"),
+		"NonFungibleToken":           flow.HexToAddress("631e88ae7f1d7c20"),
+		"MetadataViews":              flow.HexToAddress("631e88ae7f1d7c20"),
+		"EVM":                        flow.HexToAddress("8c5303eaa26202d6"),
+	},
+	flow.Emulator: {
+		"FungibleToken":              flow.HexToAddress("ee82856bf20e2aa6"),
+		"FungibleTokenMetadataViews": flow.HexToAddress("ee82856bf20e2aa6"),
+		"FlowToken":                  flow.HexToAddress("0ae53cb6e3f42a79"),
+		"FlowFees":                   flow.HexToAddress("e5a8b7f23e8b548f"),
+		"FlowServiceAccount":         flow.HexToAddress("f8d6e0586b0a20c7"),
+		"FlowStorageFees":            flow.HexToAddress("f8d6e0586b0a20c7"),
+		"FlowIDTableStaking":         flow.HexToAddress("f8d6e0586b0a20c7"),
+		"FlowEpoch":                  flow.HexToAddress("f8d6e0586b0a20c7"),
+		"FlowClusterQC":              flow.HexToAddress("f8d6e0586b0a20c7"),
+		"FlowDKG":                    flow.HexToAddress("f8d6e0586b0a20c7"),
+		"LockedTokens":               flow.HexToAddress("f8d6e0586b0a20c7"),
+		"FlowStakingCollection":      flow.HexToAddress("f8d6e0586b0a20c7"),
+		"NonFungibleToken":           flow.HexToAddress("f8d6e0586b0a20c7"),
+		"MetadataViews":              flow.HexToAddress("f8d6e0586b0a20c7"),
+		"EVM":                        flow.HexToAddress("f8d6e0586b0a20c7"),
+	},
+}
+
+// CoreContractAddresses returns the addresses of the core and standard contracts deployed on the network.
+func CoreContractAddresses(chain flow.ChainID) (ContractAddresses, error) {
+	addresses, ok := coreContractAddresses[chain]
+	if !ok {
+		return nil, fmt.Errorf("no known contract addresses for chain %s", chain)
+	}
+
+	contracts := make(ContractAddresses, len(addresses))
+	for name, address := range addresses {
+		contracts[name] = address
+	}
+	return contracts, nil
+}
+
+// importPattern matches the import declarations of Cadence source code, capturing the imported
+// names and the location they are imported from, if any.
+var importPattern = regexp.MustCompile(`(?m)^([ \t]*)import[ \t]+([A-Za-z_][A-Za-z0-9_]*(?:[ \t]*,[ \t]*[A-Za-z_][A-Za-z0-9_]*)*|"[A-Za-z_][A-Za-z0-9_]*")(?:[ \t]+from[ \t]+(\S+))?[ \t]*$`)
+
+var addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{1,16}$`)
+
+// builtinContracts are the contracts provided by the Cadence runtime, imported without an address.
+var builtinContracts = map[string]bool{
+	"Crypto": true,
+}
+
+// ImportResolver resolves the contract imports of Cadence source code to the contract addresses of a network.
+//
+// The resolver replaces imports without an address, such as:
+//
+//	import FungibleToken
+//	import "FungibleToken"
+//	import FungibleToken from "./FungibleToken.cdc"
+//	import FungibleToken from 0xFUNGIBLETOKENADDRESS
+//
+// with imports from the address the contract is deployed to:
+//
+//	import FungibleToken from 0xf233dcee88fe0abe
+//
+// Imports from a valid address and imports of the built-in contracts are left unchanged.
+type ImportResolver struct {
+	contracts ContractAddresses
+}
+
+// NewImportResolver creates an import resolver for the core and standard contracts of the network.
+func NewImportResolver(chain flow.ChainID) (*ImportResolver, error) {
+	contracts, err := CoreContractAddresses(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportResolver{contracts: contracts}, nil
+}
+
+// NewImportResolverWithContracts creates an import resolver for the given contracts.
+func NewImportResolverWithContracts(contracts ContractAddresses) *ImportResolver {
+	r := &ImportResolver{contracts: make(ContractAddresses, len(contracts))}
+	for name, address := range contracts {
+		r.contracts[name] = address
+	}
+	return r
+}
+
+// WithContract adds a contract to the resolver, replacing the address of a known contract with the same name.
+func (r *ImportResolver) WithContract(name string, address flow.Address) *ImportResolver {
+	r.contracts[name] = address
+	return r
+}
+
+// Resolve replaces the imports of the code with imports from the contract addresses.
+//
+// An error is returned if an import references an unknown contract.
+func (r *ImportResolver) Resolve(code []byte) ([]byte, error) {
+	var resolveErr error
+
+	resolved := importPattern.ReplaceAllStringFunc(string(code), func(declaration string) string {
+		if resolveErr != nil {
+			return declaration
+		}
+
+		match := importPattern.FindStringSubmatch(declaration)
+		indent, names, location := match[1], match[2], match[3]
+
+		if addressPattern.MatchString(location) || location == "" && builtinContracts[names] {
+			return declaration
+		}
+
+		imports, err := r.resolveNames(strings.Trim(names, `"`))
+		if err != nil {
+			resolveErr = err
+			return declaration
+		}
+
+		lines := make([]string, len(imports))
+		for i, imp := range imports {
+			lines[i] = fmt.Sprintf("%simport %s from 0x%s", indent, strings.Join(imp.names, ", "), imp.address.Hex())
+		}
+		return strings.Join(lines, "\n")
+	})
+
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	return []byte(resolved), nil
+}
+
+// MustResolve replaces the imports of the code with imports from the contract addresses,
+// panicking if an import references an unknown contract.
+func (r *ImportResolver) MustResolve(code []byte) []byte {
+	resolved, err := r.Resolve(code)
+	if err != nil {
+		panic(err)
+	}
+	return resolved
+}
+
+type addressImport struct {
+	address flow.Address
+	names   []string
+}
+
+// resolveNames groups the imported contract names by the address they are deployed to.
+func (r *ImportResolver) resolveNames(names string) ([]addressImport, error) {
+	byAddress := make(map[flow.Address]*addressImport)
+	imports := make([]*addressImport, 0)
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+
+		address, ok := r.contracts[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown address for imported contract %s", name)
+		}
+
+		imp, ok := byAddress[address]
+		if !ok {
+			imp = &addressImport{address: address}
+			byAddress[address] = imp
+			imports = append(imports, imp)
+		}
+		imp.names = append(imp.names, name)
+	}
+
+	resolved := make([]addressImport, len(imports))
+	for i, imp := range imports {
+		sort.Strings(imp.names)
+		resolved[i] = *imp
+	}
+	return resolved, nil
+}
+
+// ResolveImports replaces the imports of the code with imports from the addresses of the core and
+// standard contracts of the network.
+func ResolveImports(chain flow.ChainID, code []byte) ([]byte, error) {
+	resolver, err := NewImportResolver(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolver.Resolve(code)
+}
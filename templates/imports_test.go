@@ -0,0 +1,88 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+func TestImportResolver(t *testing.T) {
+	code := `import FungibleToken
+import "FlowToken"
+import NonFungibleToken from "./NonFungibleToken.cdc"
+import FlowFees from 0xFLOWFEESADDRESS
+import MetadataViews from 0x1234
+import Crypto
+
+transaction {}
+`
+
+	t.Run("Mainnet", func(t *testing.T) {
+		resolved, err := templates.ResolveImports(flow.Mainnet, []byte(code))
+		require.NoError(t, err)
+
+		assert.Equal(t, `import FungibleToken from 0xf233dcee88fe0abe
+import FlowToken from 0x1654653399040a61
+import NonFungibleToken from 0x1d7e57aa55817448
+import FlowFees from 0xf919ee77447b7497
+import MetadataViews from 0x1234
+import Crypto
+
+transaction {}
+`, string(resolved))
+	})
+
+	t.Run("Testnet", func(t *testing.T) {
+		resolved, err := templates.ResolveImports(flow.Testnet, []byte(`import FungibleToken`))
+		require.NoError(t, err)
+		assert.Equal(t, "import FungibleToken from 0x9a0766d93b6608b7", string(resolved))
+	})
+
+	t.Run("Multiple names", func(t *testing.T) {
+		resolved, err := templates.ResolveImports(flow.Emulator, []byte(`import FlowToken, FungibleToken, FungibleTokenMetadataViews`))
+		require.NoError(t, err)
+		assert.Equal(t, "import FlowToken from 0x0ae53cb6e3f42a79\nimport FungibleToken, FungibleTokenMetadataViews from 0xee82856bf20e2aa6", string(resolved))
+	})
+
+	t.Run("Custom contracts", func(t *testing.T) {
+		resolver, err := templates.NewImportResolver(flow.Emulator)
+		require.NoError(t, err)
+		resolver.WithContract("Greeting", flow.HexToAddress("01"))
+
+		resolved, err := resolver.Resolve([]byte(`import Greeting`))
+		require.NoError(t, err)
+		assert.Equal(t, "import Greeting from 0x0000000000000001", string(resolved))
+	})
+
+	t.Run("Unknown contract", func(t *testing.T) {
+		_, err := templates.ResolveImports(flow.Mainnet, []byte(`import Unknown`))
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown chain", func(t *testing.T) {
+		_, err := templates.ResolveImports(flow.ChainID("unknown"), []byte(code))
+		assert.Error(t, err)
+	})
+}
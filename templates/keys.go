@@ -0,0 +1,133 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+const rotateAccountKeyTemplate = `
+import Crypto
+
+transaction(key: Crypto.KeyListEntry, oldKeyIndex: Int) {
+	prepare(signer: AuthAccount) {
+		signer.keys.add(publicKey: key.publicKey, hashAlgorithm: key.hashAlgorithm, weight: key.weight)
+
+		if signer.keys.revoke(keyIndex: oldKeyIndex) == nil {
+			panic("account has no key with index ".concat(oldKeyIndex.toString()))
+		}
+	}
+}
+`
+
+// RotateAccountKey generates a transaction that adds a new key to an account and revokes
+// the key with the given index.
+//
+// Both changes are made by the same transaction, so either the key is rotated or the
+// account keys are left unchanged. The transaction fails if the account has no key with the given index.
+func RotateAccountKey(address flow.Address, newKey *flow.AccountKey, oldKeyIndex int) (*flow.Transaction, error) {
+	key, err := AccountKeyToCadenceCryptoKey(newKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create RotateAccountKey transaction: %w", err)
+	}
+
+	return flow.NewTransaction().
+		SetScript([]byte(rotateAccountKeyTemplate)).
+		AddRawArgument(jsoncdc.MustEncode(key)).
+		AddRawArgument(jsoncdc.MustEncode(cadence.NewInt(oldKeyIndex))).
+		AddAuthorizer(address), nil
+}
+
+// VerifyAccountKeyAdded checks that the account has an active key matching the public key,
+// signature algorithm, hash algorithm and weight of the given key, and returns the matching account key.
+//
+// It is used after an AddAccountKey transaction is sealed to confirm the key was added and to find
+// the index assigned to it.
+func VerifyAccountKeyAdded(account *flow.Account, key *flow.AccountKey) (*flow.AccountKey, error) {
+	for _, accountKey := range account.Keys {
+		if !accountKey.Revoked && sameKey(accountKey, key) {
+			return accountKey, nil
+		}
+	}
+
+	return nil, fmt.Errorf("account %s has no active key matching public key %s", account.Address, key.PublicKey)
+}
+
+// VerifyAccountKeyRevoked checks that the account key with the given index is revoked.
+//
+// It is used after a RemoveAccountKey transaction is sealed to confirm the key was revoked.
+func VerifyAccountKeyRevoked(account *flow.Account, keyIndex int) error {
+	for _, accountKey := range account.Keys {
+		if accountKey.Index != keyIndex {
+			continue
+		}
+		if !accountKey.Revoked {
+			return fmt.Errorf("key %d of account %s is not revoked", keyIndex, account.Address)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("account %s has no key with index %d", account.Address, keyIndex)
+}
+
+// VerifyAccountKeys checks that the active keys of the account match the expected keys.
+//
+// Keys are matched by public key, signature algorithm, hash algorithm and weight, regardless of their index.
+// An error is returned if an expected key is missing or if the account has an unexpected active key.
+func VerifyAccountKeys(account *flow.Account, expected []*flow.AccountKey) error {
+	matched := make([]bool, len(expected))
+
+	for _, accountKey := range account.Keys {
+		if accountKey.Revoked {
+			continue
+		}
+
+		found := false
+		for i, key := range expected {
+			if !matched[i] && sameKey(accountKey, key) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("account %s has unexpected active key %d", account.Address, accountKey.Index)
+		}
+	}
+
+	for i, key := range expected {
+		if !matched[i] {
+			return fmt.Errorf("account %s has no active key matching public key %s", account.Address, key.PublicKey)
+		}
+	}
+
+	return nil
+}
+
+func sameKey(a, b *flow.AccountKey) bool {
+	return a.PublicKey.Equals(b.PublicKey) &&
+		a.SigAlgo == b.SigAlgo &&
+		a.HashAlgo == b.HashAlgo &&
+		a.Weight == b.Weight
+}
@@ -0,0 +1,92 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestVerifyAccountKeys(t *testing.T) {
+	keys := test.AccountKeyGenerator()
+	oldKey := keys.New()
+	newKey := keys.New()
+
+	added := *newKey
+	added.Index = 1
+	revoked := *oldKey
+	revoked.Index = 0
+	revoked.Revoked = true
+
+	account := &flow.Account{
+		Address: flow.HexToAddress("01"),
+		Keys:    []*flow.AccountKey{&revoked, &added},
+	}
+
+	t.Run("Key added", func(t *testing.T) {
+		key, err := templates.VerifyAccountKeyAdded(account, newKey)
+		require.NoError(t, err)
+		assert.Equal(t, 1, key.Index)
+
+		_, err = templates.VerifyAccountKeyAdded(account, oldKey)
+		assert.Error(t, err)
+
+		differentWeight := *newKey
+		differentWeight.Weight = 500
+		_, err = templates.VerifyAccountKeyAdded(account, &differentWeight)
+		assert.Error(t, err)
+	})
+
+	t.Run("Key revoked", func(t *testing.T) {
+		assert.NoError(t, templates.VerifyAccountKeyRevoked(account, 0))
+		assert.Error(t, templates.VerifyAccountKeyRevoked(account, 1))
+		assert.Error(t, templates.VerifyAccountKeyRevoked(account, 2))
+	})
+
+	t.Run("Key set", func(t *testing.T) {
+		assert.NoError(t, templates.VerifyAccountKeys(account, []*flow.AccountKey{newKey}))
+		assert.Error(t, templates.VerifyAccountKeys(account, []*flow.AccountKey{newKey, oldKey}))
+		assert.Error(t, templates.VerifyAccountKeys(account, []*flow.AccountKey{}))
+	})
+}
+
+func TestRotateAccountKey(t *testing.T) {
+	address := flow.HexToAddress("01")
+
+	tx, err := templates.RotateAccountKey(address, test.AccountKeyGenerator().New(), 0)
+	require.NoError(t, err)
+
+	_, err = parser.ParseProgram(tx.Script, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []flow.Address{address}, tx.Authorizers)
+	require.Len(t, tx.Arguments, 2)
+
+	oldKeyIndex, err := tx.Argument(1)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewInt(0), oldKeyIndex)
+}
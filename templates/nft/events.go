@@ -0,0 +1,81 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nft
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+	sdkcadence "github.com/onflow/flow-go-sdk/cadence"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// Deposited is the NonFungibleToken.Deposited event emitted when an NFT is deposited into a collection.
+type Deposited struct {
+	// Type is the type identifier of the NFT, such as "A.0b2a3299cc857e29.TopShot.NFT".
+	Type string `cadence:"type"`
+	ID   uint64 `cadence:"id"`
+	UUID uint64 `cadence:"uuid"`
+	// To is the owner of the collection, or nil if the collection is not stored in an account.
+	To             *flow.Address `cadence:"to"`
+	CollectionUUID uint64        `cadence:"collectionUUID"`
+}
+
+// Withdrawn is the NonFungibleToken.Withdrawn event emitted when an NFT is withdrawn from a collection.
+type Withdrawn struct {
+	// Type is the type identifier of the NFT, such as "A.0b2a3299cc857e29.TopShot.NFT".
+	Type string `cadence:"type"`
+	ID   uint64 `cadence:"id"`
+	UUID uint64 `cadence:"uuid"`
+	// From is the owner of the collection, or nil if the collection is not stored in an account.
+	From         *flow.Address `cadence:"from"`
+	ProviderUUID uint64        `cadence:"providerUUID"`
+}
+
+// DepositedEventType returns the type of the NonFungibleToken.Deposited events of the network.
+//
+// The events are emitted for the NFTs of all contracts, filter them with the Type field.
+func DepositedEventType(chain flow.ChainID) (string, error) {
+	return eventType(chain, "Deposited")
+}
+
+// WithdrawnEventType returns the type of the NonFungibleToken.Withdrawn events of the network.
+//
+// The events are emitted for the NFTs of all contracts, filter them with the Type field.
+func WithdrawnEventType(chain flow.ChainID) (string, error) {
+	return eventType(chain, "Withdrawn")
+}
+
+// DecodeDeposited decodes a NonFungibleToken.Deposited event.
+func DecodeDeposited(event flow.Event) (Deposited, error) {
+	return sdkcadence.DecodeEvent[Deposited](event)
+}
+
+// DecodeWithdrawn decodes a NonFungibleToken.Withdrawn event.
+func DecodeWithdrawn(event flow.Event) (Withdrawn, error) {
+	return sdkcadence.DecodeEvent[Withdrawn](event)
+}
+
+func eventType(chain flow.ChainID, name string) (string, error) {
+	contracts, err := templates.CoreContractAddresses(chain)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("A.%s.NonFungibleToken.%s", contracts["NonFungibleToken"].Hex(), name), nil
+}
@@ -0,0 +1,200 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nft
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+// ErrNFTNotFound is returned when resolving the metadata of an NFT not owned by the account.
+var ErrNFTNotFound = errors.New("nft: NFT not found in the collection of the account")
+
+// getMetadataScript resolves the views of the NFT and flattens them into structs holding only
+// primitive values, so files and media are returned as their URIs.
+const getMetadataScript = `
+import NonFungibleToken
+import MetadataViews
+
+access(all) struct Display {
+	access(all) let name: String
+	access(all) let description: String
+	access(all) let thumbnail: String
+
+	init(_ view: MetadataViews.Display) {
+		self.name = view.name
+		self.description = view.description
+		self.thumbnail = view.thumbnail.uri()
+	}
+}
+
+access(all) struct Royalty {
+	access(all) let receiver: Address
+	access(all) let cut: UFix64
+	access(all) let description: String
+
+	init(_ royalty: MetadataViews.Royalty) {
+		self.receiver = royalty.receiver.address
+		self.cut = royalty.cut
+		self.description = royalty.description
+	}
+}
+
+access(all) struct CollectionDisplay {
+	access(all) let name: String
+	access(all) let description: String
+	access(all) let externalURL: String
+	access(all) let squareImage: String
+	access(all) let bannerImage: String
+	access(all) let socials: {String: String}
+
+	init(_ view: MetadataViews.NFTCollectionDisplay) {
+		self.name = view.name
+		self.description = view.description
+		self.externalURL = view.externalURL.url
+		self.squareImage = view.squareImage.file.uri()
+		self.bannerImage = view.bannerImage.file.uri()
+		self.socials = {}
+		for name in view.socials.keys {
+			self.socials[name] = view.socials[name]!.url
+		}
+	}
+}
+
+access(all) struct Metadata {
+	access(all) let display: Display?
+	access(all) let royalties: [Royalty]?
+	access(all) let collectionDisplay: CollectionDisplay?
+
+	init(_ nft: &{NonFungibleToken.NFT}) {
+		if let display = MetadataViews.getDisplay(nft) {
+			self.display = Display(display)
+		} else {
+			self.display = nil
+		}
+
+		if let royalties = MetadataViews.getRoyalties(nft) {
+			var values: [Royalty] = []
+			for royalty in royalties.getRoyalties() {
+				values.append(Royalty(royalty))
+			}
+			self.royalties = values
+		} else {
+			self.royalties = nil
+		}
+
+		if let collectionDisplay = MetadataViews.getNFTCollectionDisplay(nft) {
+			self.collectionDisplay = CollectionDisplay(collectionDisplay)
+		} else {
+			self.collectionDisplay = nil
+		}
+	}
+}
+
+access(all) fun main(address: Address, publicPath: PublicPath, id: UInt64): Metadata? {
+	if let collection = getAccount(address).capabilities.borrow<&{NonFungibleToken.Collection}>(publicPath) {
+		if let nft = collection.borrowNFT(id) {
+			return Metadata(nft)
+		}
+	}
+	return nil
+}
+`
+
+// Display is the MetadataViews.Display view of an NFT, the basic information needed to render it.
+type Display struct {
+	Name        string `cadence:"name"`
+	Description string `cadence:"description"`
+	// Thumbnail is the URI of the thumbnail, such as "https://..." or "ipfs://...".
+	Thumbnail string `cadence:"thumbnail"`
+}
+
+// A Royalty is a royalty of the MetadataViews.Royalties view of an NFT.
+type Royalty struct {
+	Receiver flow.Address `cadence:"receiver"`
+	// Cut is the share of the sale price paid to the receiver, such as "0.05000000".
+	Cut         string `cadence:"cut"`
+	Description string `cadence:"description"`
+}
+
+// CollectionDisplay is the MetadataViews.NFTCollectionDisplay view of an NFT, describing its collection.
+type CollectionDisplay struct {
+	Name        string `cadence:"name"`
+	Description string `cadence:"description"`
+	ExternalURL string `cadence:"externalURL"`
+	// SquareImage and BannerImage are the URIs of the collection images.
+	SquareImage string `cadence:"squareImage"`
+	BannerImage string `cadence:"bannerImage"`
+	// Socials maps social networks, such as "twitter", to the URL of the collection on the network.
+	Socials map[string]string `cadence:"socials"`
+}
+
+// Metadata are the standard views of an NFT. Views not implemented by the NFT are nil.
+type Metadata struct {
+	Display           *Display           `cadence:"display"`
+	Royalties         []Royalty          `cadence:"royalties"`
+	CollectionDisplay *CollectionDisplay `cadence:"collectionDisplay"`
+}
+
+// GetMetadata resolves the Display, Royalties and NFTCollectionDisplay views of the NFT with the ID
+// owned by the account.
+//
+// ErrNFTNotFound is returned if the account has no collection capability published for the contract
+// or does not own the NFT.
+func GetMetadata(
+	ctx context.Context,
+	client access.Client,
+	chain flow.ChainID,
+	collection Collection,
+	owner flow.Address,
+	id uint64,
+) (*Metadata, error) {
+	_, publicPath, err := collection.paths()
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := templates.ResolveImports(chain, []byte(getMetadataScript))
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata *Metadata
+	err = access.ExecuteScriptAtLatestBlockInto(
+		ctx,
+		client,
+		script,
+		[]cadence.Value{cadence.Address(owner), publicPath, cadence.NewUInt64(id)},
+		&metadata,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if metadata == nil {
+		return nil, ErrNFTNotFound
+	}
+
+	return metadata, nil
+}
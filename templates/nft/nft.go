@@ -0,0 +1,86 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package nft provides transaction templates, scripts and event decoding for non-fungible tokens
+// implementing the NonFungibleToken standard.
+//
+// The templates use Cadence 1.0 and work with any NFT contract: the contract and the paths of its
+// collections are passed as arguments, so a Collection only needs to describe where the contract is
+// deployed and where its collections are stored.
+package nft
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// ErrCollectionNotFound is returned when querying the NFTs of an account without a collection for the contract.
+var ErrCollectionNotFound = errors.New("nft: account has no collection for the contract")
+
+// A Collection is an NFT contract and the paths its collections are stored and published at.
+type Collection struct {
+	// ContractName is the name of the NFT contract, such as "TopShot".
+	ContractName    string
+	ContractAddress flow.Address
+	// StoragePath is the path collections are stored at, such as "/storage/MomentCollection".
+	StoragePath string
+	// PublicPath is the public path of the NonFungibleToken.Collection capability of collections.
+	PublicPath string
+}
+
+// NFTType returns the type identifier of the NFTs of the contract, such as "A.0b2a3299cc857e29.TopShot.NFT".
+func (c Collection) NFTType() string {
+	return c.typeID("NFT")
+}
+
+// CollectionType returns the type identifier of the collections of the contract.
+func (c Collection) CollectionType() string {
+	return c.typeID("Collection")
+}
+
+func (c Collection) typeID(name string) string {
+	return fmt.Sprintf("A.%s.%s.%s", c.ContractAddress.Hex(), c.ContractName, name)
+}
+
+// paths returns the storage and public paths of the collections as Cadence paths.
+func (c Collection) paths() (cadence.Path, cadence.Path, error) {
+	storagePath, err := parsePath(c.StoragePath, "storage")
+	if err != nil {
+		return cadence.Path{}, cadence.Path{}, err
+	}
+	publicPath, err := parsePath(c.PublicPath, "public")
+	if err != nil {
+		return cadence.Path{}, cadence.Path{}, err
+	}
+	return storagePath, publicPath, nil
+}
+
+// parsePath parses a path such as "/storage/MomentCollection" into a Cadence path of the domain.
+func parsePath(path string, domain string) (cadence.Path, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "" || parts[1] != domain || parts[2] == "" {
+		return cadence.Path{}, fmt.Errorf("invalid %s path %q", domain, path)
+	}
+
+	return cadence.Path{Domain: domain, Identifier: parts[2]}, nil
+}
@@ -0,0 +1,274 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nft_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/mocks"
+	"github.com/onflow/flow-go-sdk/templates/nft"
+)
+
+var topShot = nft.Collection{
+	ContractName:    "TopShot",
+	ContractAddress: flow.HexToAddress("0b2a3299cc857e29"),
+	StoragePath:     "/storage/MomentCollection",
+	PublicPath:      "/public/MomentCollection",
+}
+
+var (
+	storagePath = cadence.Path{Domain: "storage", Identifier: "MomentCollection"}
+	publicPath  = cadence.Path{Domain: "public", Identifier: "MomentCollection"}
+)
+
+func TestSetupCollection(t *testing.T) {
+	signer := flow.HexToAddress("01")
+
+	tx, err := nft.SetupCollection(flow.Mainnet, topShot, signer)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(tx.Script), "import NonFungibleToken from 0x1d7e57aa55817448")
+	assert.Equal(t, []flow.Address{signer}, tx.Authorizers)
+	assert.Equal(t, []cadence.Value{
+		cadence.Address(topShot.ContractAddress),
+		cadence.String("TopShot"),
+		cadence.String("A.0b2a3299cc857e29.TopShot.NFT"),
+		storagePath,
+		publicPath,
+	}, decodeArguments(t, tx))
+
+	invalid := topShot
+	invalid.PublicPath = "/storage/MomentCollection"
+	_, err = nft.SetupCollection(flow.Mainnet, invalid, signer)
+	assert.Error(t, err)
+}
+
+func TestTransfer(t *testing.T) {
+	sender := flow.HexToAddress("01")
+	recipient := flow.HexToAddress("02")
+
+	tx, err := nft.Transfer(flow.Testnet, topShot, sender, recipient, 42)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(tx.Script), "import NonFungibleToken from 0x631e88ae7f1d7c20")
+	assert.Equal(t, []flow.Address{sender}, tx.Authorizers)
+	assert.Equal(t, []cadence.Value{
+		cadence.NewArray([]cadence.Value{cadence.NewUInt64(42)}),
+		cadence.Address(recipient),
+		storagePath,
+		publicPath,
+	}, decodeArguments(t, tx))
+
+	tx, err = nft.BatchTransfer(flow.Testnet, topShot, sender, recipient, []uint64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{cadence.NewUInt64(1), cadence.NewUInt64(2), cadence.NewUInt64(3)}),
+		decodeArguments(t, tx)[0],
+	)
+
+	_, err = nft.Transfer(flow.ChainID("unknown"), topShot, sender, recipient, 42)
+	assert.Error(t, err)
+}
+
+func TestGetIDs(t *testing.T) {
+	ctx := context.Background()
+	owner := flow.HexToAddress("01")
+	other := flow.HexToAddress("02")
+
+	client := &mocks.Client{}
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.Address(owner), publicPath}).
+		Return(cadence.NewOptional(cadence.NewArray([]cadence.Value{cadence.NewUInt64(3), cadence.NewUInt64(1)})), nil)
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.Address(other), publicPath}).
+		Return(cadence.NewOptional(nil), nil)
+
+	ids, err := nft.GetIDs(ctx, client, flow.Emulator, topShot, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 3}, ids)
+
+	_, err = nft.GetIDs(ctx, client, flow.Emulator, topShot, other)
+	assert.ErrorIs(t, err, nft.ErrCollectionNotFound)
+}
+
+func TestDecodeEvents(t *testing.T) {
+	owner := flow.HexToAddress("01")
+
+	depositedType, err := nft.DepositedEventType(flow.Mainnet)
+	require.NoError(t, err)
+	assert.Equal(t, "A.1d7e57aa55817448.NonFungibleToken.Deposited", depositedType)
+
+	deposited, err := nft.DecodeDeposited(newEvent(depositedType, "to", "collectionUUID", cadence.NewOptional(cadence.Address(owner))))
+	require.NoError(t, err)
+	assert.Equal(t, nft.Deposited{
+		Type:           topShot.NFTType(),
+		ID:             42,
+		UUID:           100,
+		To:             &owner,
+		CollectionUUID: 200,
+	}, deposited)
+
+	withdrawnType, err := nft.WithdrawnEventType(flow.Mainnet)
+	require.NoError(t, err)
+
+	withdrawn, err := nft.DecodeWithdrawn(newEvent(withdrawnType, "from", "providerUUID", cadence.NewOptional(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, nft.Withdrawn{
+		Type:         topShot.NFTType(),
+		ID:           42,
+		UUID:         100,
+		ProviderUUID: 200,
+	}, withdrawn)
+}
+
+func TestGetMetadata(t *testing.T) {
+	ctx := context.Background()
+	owner := flow.HexToAddress("01")
+	royaltyReceiver := flow.HexToAddress("02")
+	cut, _ := cadence.NewUFix64("0.05")
+
+	display := newStruct("Display",
+		[]string{"name", "description", "thumbnail"},
+		cadence.String("Moment"), cadence.String("A moment"), cadence.String("ipfs://thumbnail"),
+	)
+	royalty := newStruct("Royalty",
+		[]string{"receiver", "cut", "description"},
+		cadence.Address(royaltyReceiver), cut, cadence.String("creator"),
+	)
+	metadata := newStruct("Metadata",
+		[]string{"display", "royalties", "collectionDisplay"},
+		cadence.NewOptional(display),
+		cadence.NewOptional(cadence.NewArray([]cadence.Value{royalty})),
+		cadence.NewOptional(nil),
+	)
+
+	client := &mocks.Client{}
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.Address(owner), publicPath, cadence.NewUInt64(42)}).
+		Return(cadence.NewOptional(metadata), nil)
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, mock.Anything).
+		Return(cadence.NewOptional(nil), nil)
+
+	result, err := nft.GetMetadata(ctx, client, flow.Mainnet, topShot, owner, 42)
+	require.NoError(t, err)
+	assert.Equal(t, &nft.Metadata{
+		Display: &nft.Display{
+			Name:        "Moment",
+			Description: "A moment",
+			Thumbnail:   "ipfs://thumbnail",
+		},
+		Royalties: []nft.Royalty{{
+			Receiver:    royaltyReceiver,
+			Cut:         "0.05000000",
+			Description: "creator",
+		}},
+	}, result)
+
+	script := client.Calls[0].Arguments.Get(1).([]byte)
+	assert.Contains(t, string(script), "import MetadataViews from 0x1d7e57aa55817448")
+
+	_, err = nft.GetMetadata(ctx, client, flow.Mainnet, topShot, owner, 43)
+	assert.ErrorIs(t, err, nft.ErrNFTNotFound)
+}
+
+func TestCollectionDisplay(t *testing.T) {
+	collectionDisplay := newStruct("CollectionDisplay",
+		[]string{"name", "description", "externalURL", "squareImage", "bannerImage", "socials"},
+		cadence.String("TopShot"),
+		cadence.String("NBA moments"),
+		cadence.String("https://nbatopshot.com"),
+		cadence.String("https://nbatopshot.com/square.png"),
+		cadence.String("https://nbatopshot.com/banner.png"),
+		cadence.NewDictionary([]cadence.KeyValuePair{{Key: cadence.String("twitter"), Value: cadence.String("https://twitter.com/nbatopshot")}}),
+	)
+	metadata := newStruct("Metadata",
+		[]string{"display", "royalties", "collectionDisplay"},
+		cadence.NewOptional(nil),
+		cadence.NewOptional(nil),
+		cadence.NewOptional(collectionDisplay),
+	)
+
+	client := &mocks.Client{}
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, mock.Anything).
+		Return(cadence.NewOptional(metadata), nil)
+
+	result, err := nft.GetMetadata(context.Background(), client, flow.Mainnet, topShot, flow.HexToAddress("01"), 42)
+	require.NoError(t, err)
+	assert.Nil(t, result.Display)
+	assert.Nil(t, result.Royalties)
+	assert.Equal(t, &nft.CollectionDisplay{
+		Name:        "TopShot",
+		Description: "NBA moments",
+		ExternalURL: "https://nbatopshot.com",
+		SquareImage: "https://nbatopshot.com/square.png",
+		BannerImage: "https://nbatopshot.com/banner.png",
+		Socials:     map[string]string{"twitter": "https://twitter.com/nbatopshot"},
+	}, result.CollectionDisplay)
+}
+
+func newStruct(name string, fields []string, values ...cadence.Value) cadence.Struct {
+	structFields := make([]cadence.Field, len(fields))
+	for i, field := range fields {
+		structFields[i] = cadence.Field{Identifier: field, Type: values[i].Type()}
+	}
+
+	return cadence.NewStruct(values).WithType(&cadence.StructType{
+		Location:            common.ScriptLocation{},
+		QualifiedIdentifier: name,
+		Fields:              structFields,
+	})
+}
+
+func newEvent(eventType string, addressField string, uuidField string, address cadence.Value) flow.Event {
+	value := cadence.NewEvent([]cadence.Value{
+		cadence.String(topShot.NFTType()),
+		cadence.NewUInt64(42),
+		cadence.NewUInt64(100),
+		address,
+		cadence.NewUInt64(200),
+	}).WithType(&cadence.EventType{
+		Location:            common.StringLocation("test"),
+		QualifiedIdentifier: eventType,
+		Fields: []cadence.Field{
+			{Identifier: "type", Type: cadence.StringType{}},
+			{Identifier: "id", Type: cadence.UInt64Type{}},
+			{Identifier: "uuid", Type: cadence.UInt64Type{}},
+			{Identifier: addressField, Type: &cadence.OptionalType{Type: cadence.AddressType{}}},
+			{Identifier: uuidField, Type: cadence.UInt64Type{}},
+		},
+	})
+
+	return flow.Event{Type: eventType, Value: value}
+}
+
+func decodeArguments(t *testing.T, tx *flow.Transaction) []cadence.Value {
+	values := make([]cadence.Value, len(tx.Arguments))
+	for i, argument := range tx.Arguments {
+		value, err := jsoncdc.Decode(nil, argument)
+		require.NoError(t, err)
+		values[i] = value
+	}
+	return values
+}
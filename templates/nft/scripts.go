@@ -0,0 +1,77 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nft
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const getIDsScript = `
+import NonFungibleToken
+
+access(all) fun main(address: Address, publicPath: PublicPath): [UInt64]? {
+	return getAccount(address).capabilities.borrow<&{NonFungibleToken.Collection}>(publicPath)?.getIDs()
+}
+`
+
+// GetIDs returns the IDs of the NFTs owned by the account in its collection, in ascending order.
+//
+// ErrCollectionNotFound is returned if the account has no collection capability published for the contract.
+func GetIDs(
+	ctx context.Context,
+	client access.Client,
+	chain flow.ChainID,
+	collection Collection,
+	address flow.Address,
+) ([]uint64, error) {
+	_, publicPath, err := collection.paths()
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := templates.ResolveImports(chain, []byte(getIDsScript))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids *[]uint64
+	err = access.ExecuteScriptAtLatestBlockInto(
+		ctx,
+		client,
+		script,
+		[]cadence.Value{cadence.Address(address), publicPath},
+		&ids,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ids == nil {
+		return nil, ErrCollectionNotFound
+	}
+
+	sort.Slice(*ids, func(i, j int) bool { return (*ids)[i] < (*ids)[j] })
+	return *ids, nil
+}
@@ -0,0 +1,151 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nft
+
+import (
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const setupCollectionTemplate = `
+import NonFungibleToken
+
+transaction(contractAddress: Address, contractName: String, nftType: String, storagePath: StoragePath, publicPath: PublicPath) {
+	prepare(signer: auth(SaveValue, IssueStorageCapabilityController, PublishCapability, UnpublishCapability) &Account) {
+		if signer.storage.type(at: storagePath) != nil {
+			return
+		}
+
+		let nftContract = getAccount(contractAddress).contracts.borrow<&{NonFungibleToken}>(name: contractName)
+			?? panic("could not borrow the NFT contract ".concat(contractName))
+		let collection <- nftContract.createEmptyCollection(nftType: CompositeType(nftType) ?? panic("invalid NFT type ".concat(nftType)))
+		signer.storage.save(<-collection, to: storagePath)
+
+		signer.capabilities.unpublish(publicPath)
+		signer.capabilities.publish(signer.capabilities.storage.issue<&{NonFungibleToken.Collection}>(storagePath), at: publicPath)
+	}
+}
+`
+
+const transferTemplate = `
+import NonFungibleToken
+
+transaction(ids: [UInt64], recipient: Address, storagePath: StoragePath, publicPath: PublicPath) {
+	let collection: auth(NonFungibleToken.Withdraw) &{NonFungibleToken.Collection}
+	let receiver: &{NonFungibleToken.Receiver}
+
+	prepare(signer: auth(BorrowValue) &Account) {
+		self.collection = signer.storage.borrow<auth(NonFungibleToken.Withdraw) &{NonFungibleToken.Collection}>(from: storagePath)
+			?? panic("could not borrow the collection of the signer")
+		self.receiver = getAccount(recipient).capabilities.borrow<&{NonFungibleToken.Receiver}>(publicPath)
+			?? panic("could not borrow the collection of the recipient")
+	}
+
+	execute {
+		for id in ids {
+			self.receiver.deposit(token: <-self.collection.withdraw(withdrawID: id))
+		}
+	}
+}
+`
+
+// SetupCollection generates a transaction storing an empty collection of the NFT contract in the
+// account of the signer and publishing its collection capability, so the account can receive NFTs.
+//
+// The transaction does nothing if the signer already stores a value at the storage path of the collection.
+func SetupCollection(chain flow.ChainID, collection Collection, signer flow.Address) (*flow.Transaction, error) {
+	storagePath, publicPath, err := collection.paths()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := newTransaction(chain, setupCollectionTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(cadence.Address(collection.ContractAddress))).
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(collection.ContractName))).
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(collection.NFTType()))).
+		AddRawArgument(jsoncdc.MustEncode(storagePath)).
+		AddRawArgument(jsoncdc.MustEncode(publicPath)).
+		AddAuthorizer(signer), nil
+}
+
+// Transfer generates a transaction transferring the NFT with the ID from the collection of the sender
+// to the collection of the recipient.
+func Transfer(
+	chain flow.ChainID,
+	collection Collection,
+	sender flow.Address,
+	recipient flow.Address,
+	id uint64,
+) (*flow.Transaction, error) {
+	return BatchTransfer(chain, collection, sender, recipient, []uint64{id})
+}
+
+// BatchTransfer generates a transaction transferring the NFTs with the IDs from the collection of the
+// sender to the collection of the recipient.
+//
+// The transaction fails, transferring no NFT, if the sender does not own any of the NFTs.
+func BatchTransfer(
+	chain flow.ChainID,
+	collection Collection,
+	sender flow.Address,
+	recipient flow.Address,
+	ids []uint64,
+) (*flow.Transaction, error) {
+	storagePath, publicPath, err := collection.paths()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := newTransaction(chain, transferTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(idsValue(ids))).
+		AddRawArgument(jsoncdc.MustEncode(cadence.Address(recipient))).
+		AddRawArgument(jsoncdc.MustEncode(storagePath)).
+		AddRawArgument(jsoncdc.MustEncode(publicPath)).
+		AddAuthorizer(sender), nil
+}
+
+func newTransaction(chain flow.ChainID, template string) (*flow.Transaction, error) {
+	script, err := templates.ResolveImports(chain, []byte(template))
+	if err != nil {
+		return nil, err
+	}
+
+	return flow.NewTransaction().SetScript(script), nil
+}
+
+func idsValue(ids []uint64) cadence.Array {
+	values := make([]cadence.Value, len(ids))
+	for i, id := range ids {
+		values[i] = cadence.NewUInt64(id)
+	}
+	return cadence.NewArray(values)
+}
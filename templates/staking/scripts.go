@@ -0,0 +1,188 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package staking
+
+import (
+	"context"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const getNodeInfoScript = `
+import FlowIDTableStaking
+
+access(all) fun main(nodeID: String): FlowIDTableStaking.NodeInfo {
+	return FlowIDTableStaking.NodeInfo(nodeID: nodeID)
+}
+`
+
+const getDelegatorInfoScript = `
+import FlowIDTableStaking
+
+access(all) fun main(nodeID: String, delegatorID: UInt32): FlowIDTableStaking.DelegatorInfo {
+	return FlowIDTableStaking.DelegatorInfo(nodeID: nodeID, delegatorID: delegatorID)
+}
+`
+
+const getAllNodeInfoScript = `
+import FlowIDTableStaking
+import FlowStakingCollection
+
+access(all) fun main(address: Address): [FlowIDTableStaking.NodeInfo] {
+	return FlowStakingCollection.getAllNodeInfo(address: address)
+}
+`
+
+const getAllDelegatorInfoScript = `
+import FlowIDTableStaking
+import FlowStakingCollection
+
+access(all) fun main(address: Address): [FlowIDTableStaking.DelegatorInfo] {
+	return FlowStakingCollection.getAllDelegatorInfo(address: address)
+}
+`
+
+// Role is the role of a staked node.
+type Role uint8
+
+const (
+	RoleCollection Role = iota + 1
+	RoleConsensus
+	RoleExecution
+	RoleVerification
+	RoleAccess
+)
+
+// String returns the name of the role.
+func (r Role) String() string {
+	switch r {
+	case RoleCollection:
+		return "collection"
+	case RoleConsensus:
+		return "consensus"
+	case RoleExecution:
+		return "execution"
+	case RoleVerification:
+		return "verification"
+	case RoleAccess:
+		return "access"
+	default:
+		return "unknown"
+	}
+}
+
+// NodeInfo is the FlowIDTableStaking.NodeInfo staking information of a node.
+//
+// Token amounts are decimal strings, such as "1000.00000000".
+type NodeInfo struct {
+	ID                       string   `cadence:"id"`
+	Role                     Role     `cadence:"role"`
+	NetworkingAddress        string   `cadence:"networkingAddress"`
+	NetworkingKey            string   `cadence:"networkingKey"`
+	StakingKey               string   `cadence:"stakingKey"`
+	TokensStaked             string   `cadence:"tokensStaked"`
+	TokensCommitted          string   `cadence:"tokensCommitted"`
+	TokensUnstaking          string   `cadence:"tokensUnstaking"`
+	TokensUnstaked           string   `cadence:"tokensUnstaked"`
+	TokensRewarded           string   `cadence:"tokensRewarded"`
+	TokensRequestedToUnstake string   `cadence:"tokensRequestedToUnstake"`
+	Delegators               []uint32 `cadence:"delegators"`
+	InitialWeight            uint64   `cadence:"initialWeight"`
+}
+
+// DelegatorInfo is the FlowIDTableStaking.DelegatorInfo staking information of a delegator.
+//
+// Token amounts are decimal strings, such as "1000.00000000".
+type DelegatorInfo struct {
+	ID                       uint32 `cadence:"id"`
+	NodeID                   string `cadence:"nodeID"`
+	TokensCommitted          string `cadence:"tokensCommitted"`
+	TokensStaked             string `cadence:"tokensStaked"`
+	TokensUnstaking          string `cadence:"tokensUnstaking"`
+	TokensRewarded           string `cadence:"tokensRewarded"`
+	TokensUnstaked           string `cadence:"tokensUnstaked"`
+	TokensRequestedToUnstake string `cadence:"tokensRequestedToUnstake"`
+}
+
+// GetNodeInfo returns the staking information of the node with the ID.
+func GetNodeInfo(ctx context.Context, client access.Client, chain flow.ChainID, nodeID string) (*NodeInfo, error) {
+	var info NodeInfo
+	err := executeScript(ctx, client, chain, getNodeInfoScript, []cadence.Value{cadence.String(nodeID)}, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// GetDelegatorInfo returns the staking information of the delegator of the node.
+func GetDelegatorInfo(
+	ctx context.Context,
+	client access.Client,
+	chain flow.ChainID,
+	nodeID string,
+	delegatorID uint32,
+) (*DelegatorInfo, error) {
+	var info DelegatorInfo
+	arguments := []cadence.Value{cadence.String(nodeID), cadence.NewUInt32(delegatorID)}
+	err := executeScript(ctx, client, chain, getDelegatorInfoScript, arguments, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// GetNodes returns the staking information of the nodes in the staking collection of the account.
+func GetNodes(ctx context.Context, client access.Client, chain flow.ChainID, address flow.Address) ([]NodeInfo, error) {
+	var nodes []NodeInfo
+	err := executeScript(ctx, client, chain, getAllNodeInfoScript, []cadence.Value{cadence.Address(address)}, &nodes)
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// GetDelegators returns the staking information of the delegators in the staking collection of the account.
+func GetDelegators(ctx context.Context, client access.Client, chain flow.ChainID, address flow.Address) ([]DelegatorInfo, error) {
+	var delegators []DelegatorInfo
+	err := executeScript(ctx, client, chain, getAllDelegatorInfoScript, []cadence.Value{cadence.Address(address)}, &delegators)
+	if err != nil {
+		return nil, err
+	}
+	return delegators, nil
+}
+
+func executeScript(
+	ctx context.Context,
+	client access.Client,
+	chain flow.ChainID,
+	template string,
+	arguments []cadence.Value,
+	dest interface{},
+) error {
+	script, err := templates.ResolveImports(chain, []byte(template))
+	if err != nil {
+		return err
+	}
+
+	return access.ExecuteScriptAtLatestBlockInto(ctx, client, script, arguments, dest)
+}
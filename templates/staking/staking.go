@@ -0,0 +1,213 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package staking provides transaction templates and scripts for staking FLOW with node operators,
+// as a node operator or as a delegator.
+//
+// The transactions manage stakes through the staking collection of the signer, the FlowStakingCollection
+// resource holding the node and delegator records of an account, so the signer must set up its staking
+// collection with SetupStakingCollection first. Operations on a delegator take the ID of the delegator
+// record, and operations on a node take a nil delegator ID.
+//
+// The templates use Cadence 1.0.
+package staking
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const setupStakingCollectionTemplate = `
+import FungibleToken
+import FlowToken
+import FlowStakingCollection
+
+transaction {
+	prepare(signer: auth(BorrowValue, SaveValue, IssueStorageCapabilityController, PublishCapability) &Account) {
+		if signer.storage.type(at: FlowStakingCollection.StakingCollectionStoragePath) != nil {
+			return
+		}
+
+		let vault = signer.capabilities.storage.issue<auth(FungibleToken.Withdraw) &FlowToken.Vault>(/storage/flowTokenVault)
+		signer.storage.save(
+			<-FlowStakingCollection.createStakingCollection(unlockedVault: vault, tokenHolder: nil),
+			to: FlowStakingCollection.StakingCollectionStoragePath
+		)
+
+		signer.capabilities.publish(
+			signer.capabilities.storage.issue<&FlowStakingCollection.StakingCollection>(FlowStakingCollection.StakingCollectionStoragePath),
+			at: FlowStakingCollection.StakingCollectionPublicPath
+		)
+	}
+}
+`
+
+const registerDelegatorTemplate = `
+import FlowStakingCollection
+
+transaction(nodeID: String, amount: UFix64) {
+	prepare(signer: auth(BorrowValue) &Account) {
+		let collection = signer.storage.borrow<auth(FlowStakingCollection.CollectionOwner) &FlowStakingCollection.StakingCollection>(from: FlowStakingCollection.StakingCollectionStoragePath)
+			?? panic("could not borrow the staking collection of the signer")
+
+		collection.registerDelegator(nodeID: nodeID, amount: amount)
+	}
+}
+`
+
+// stakeOperationTemplate is the template of the operations on a stake, filled with the name of the
+// staking collection function.
+const stakeOperationTemplate = `
+import FlowStakingCollection
+
+transaction(nodeID: String, delegatorID: UInt32?, amount: UFix64) {
+	prepare(signer: auth(BorrowValue) &Account) {
+		let collection = signer.storage.borrow<auth(FlowStakingCollection.CollectionOwner) &FlowStakingCollection.StakingCollection>(from: FlowStakingCollection.StakingCollectionStoragePath)
+			?? panic("could not borrow the staking collection of the signer")
+
+		collection.%s(nodeID: nodeID, delegatorID: delegatorID, amount: amount)
+	}
+}
+`
+
+// SetupStakingCollection generates a transaction creating the staking collection of the signer, staking
+// the FLOW of its FLOW vault.
+//
+// The transaction does nothing if the signer already has a staking collection. Accounts with locked
+// tokens must set up their staking collection with the LockedTokens contract instead.
+func SetupStakingCollection(chain flow.ChainID, signer flow.Address) (*flow.Transaction, error) {
+	tx, err := newTransaction(chain, setupStakingCollectionTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.AddAuthorizer(signer), nil
+}
+
+// RegisterDelegator generates a transaction registering a new delegator for the node with the ID,
+// delegating the amount of FLOW, such as "100.0", to the node.
+//
+// The ID of the new delegator record is emitted in the FlowIDTableStaking.NewDelegatorCreated event.
+func RegisterDelegator(chain flow.ChainID, signer flow.Address, nodeID string, amount string) (*flow.Transaction, error) {
+	value, err := cadence.NewUFix64(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FLOW amount %q: %w", amount, err)
+	}
+
+	tx, err := newTransaction(chain, registerDelegatorTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(nodeID))).
+		AddRawArgument(jsoncdc.MustEncode(value)).
+		AddAuthorizer(signer), nil
+}
+
+// StakeNewTokens generates a transaction staking the amount of FLOW from the vault of the signer with
+// the node, or delegating it through the delegator if the delegator ID is not nil.
+func StakeNewTokens(
+	chain flow.ChainID,
+	signer flow.Address,
+	nodeID string,
+	delegatorID *uint32,
+	amount string,
+) (*flow.Transaction, error) {
+	return newStakeOperation(chain, "stakeNewTokens", signer, nodeID, delegatorID, amount)
+}
+
+// RequestUnstaking generates a transaction requesting to unstake the amount of FLOW staked with the node
+// or delegated through the delegator. The tokens are unstaked at the end of the epoch.
+func RequestUnstaking(
+	chain flow.ChainID,
+	signer flow.Address,
+	nodeID string,
+	delegatorID *uint32,
+	amount string,
+) (*flow.Transaction, error) {
+	return newStakeOperation(chain, "requestUnstaking", signer, nodeID, delegatorID, amount)
+}
+
+// WithdrawRewardedTokens generates a transaction withdrawing the amount of FLOW rewarded to the node or
+// the delegator to the vault of the signer.
+func WithdrawRewardedTokens(
+	chain flow.ChainID,
+	signer flow.Address,
+	nodeID string,
+	delegatorID *uint32,
+	amount string,
+) (*flow.Transaction, error) {
+	return newStakeOperation(chain, "withdrawRewardedTokens", signer, nodeID, delegatorID, amount)
+}
+
+// WithdrawUnstakedTokens generates a transaction withdrawing the amount of unstaked FLOW of the node or
+// the delegator to the vault of the signer.
+func WithdrawUnstakedTokens(
+	chain flow.ChainID,
+	signer flow.Address,
+	nodeID string,
+	delegatorID *uint32,
+	amount string,
+) (*flow.Transaction, error) {
+	return newStakeOperation(chain, "withdrawUnstakedTokens", signer, nodeID, delegatorID, amount)
+}
+
+func newStakeOperation(
+	chain flow.ChainID,
+	operation string,
+	signer flow.Address,
+	nodeID string,
+	delegatorID *uint32,
+	amount string,
+) (*flow.Transaction, error) {
+	value, err := cadence.NewUFix64(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FLOW amount %q: %w", amount, err)
+	}
+
+	tx, err := newTransaction(chain, fmt.Sprintf(stakeOperationTemplate, operation))
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := cadence.NewOptional(nil)
+	if delegatorID != nil {
+		delegator = cadence.NewOptional(cadence.NewUInt32(*delegatorID))
+	}
+
+	return tx.
+		AddRawArgument(jsoncdc.MustEncode(cadence.String(nodeID))).
+		AddRawArgument(jsoncdc.MustEncode(delegator)).
+		AddRawArgument(jsoncdc.MustEncode(value)).
+		AddAuthorizer(signer), nil
+}
+
+func newTransaction(chain flow.ChainID, template string) (*flow.Transaction, error) {
+	script, err := templates.ResolveImports(chain, []byte(template))
+	if err != nil {
+		return nil, err
+	}
+
+	return flow.NewTransaction().SetScript(script), nil
+}
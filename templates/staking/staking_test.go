@@ -0,0 +1,184 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package staking_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/mocks"
+	"github.com/onflow/flow-go-sdk/templates/staking"
+)
+
+const nodeID = "e8d1c2b5a6f7"
+
+func TestSetupStakingCollection(t *testing.T) {
+	signer := flow.HexToAddress("01")
+
+	tx, err := staking.SetupStakingCollection(flow.Mainnet, signer)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(tx.Script), "import FlowStakingCollection from 0x8d0e87b65159ae63")
+	assert.Contains(t, string(tx.Script), "import FlowToken from 0x1654653399040a61")
+	assert.Equal(t, []flow.Address{signer}, tx.Authorizers)
+	assert.Empty(t, tx.Arguments)
+}
+
+func TestRegisterDelegator(t *testing.T) {
+	signer := flow.HexToAddress("01")
+
+	tx, err := staking.RegisterDelegator(flow.Testnet, signer, nodeID, "100.0")
+	require.NoError(t, err)
+
+	assert.Contains(t, string(tx.Script), "import FlowStakingCollection from 0x95e019a17d0e23d7")
+	assert.Equal(t, []flow.Address{signer}, tx.Authorizers)
+	assert.Equal(t, []cadence.Value{cadence.String(nodeID), ufix64(t, "100.0")}, decodeArguments(t, tx))
+
+	_, err = staking.RegisterDelegator(flow.Testnet, signer, nodeID, "-100.0")
+	assert.Error(t, err)
+}
+
+func TestStakeOperations(t *testing.T) {
+	signer := flow.HexToAddress("01")
+	delegatorID := uint32(7)
+
+	operations := map[string]func(flow.ChainID, flow.Address, string, *uint32, string) (*flow.Transaction, error){
+		"stakeNewTokens":         staking.StakeNewTokens,
+		"requestUnstaking":       staking.RequestUnstaking,
+		"withdrawRewardedTokens": staking.WithdrawRewardedTokens,
+		"withdrawUnstakedTokens": staking.WithdrawUnstakedTokens,
+	}
+
+	for name, operation := range operations {
+		t.Run(name, func(t *testing.T) {
+			tx, err := operation(flow.Emulator, signer, nodeID, &delegatorID, "1.5")
+			require.NoError(t, err)
+
+			assert.Contains(t, string(tx.Script), "collection."+name+"(nodeID: nodeID, delegatorID: delegatorID, amount: amount)")
+			assert.Contains(t, string(tx.Script), "import FlowStakingCollection from 0xf8d6e0586b0a20c7")
+			assert.Equal(t, []flow.Address{signer}, tx.Authorizers)
+			assert.Equal(t, []cadence.Value{
+				cadence.String(nodeID),
+				cadence.NewOptional(cadence.NewUInt32(7)),
+				ufix64(t, "1.5"),
+			}, decodeArguments(t, tx))
+
+			tx, err = operation(flow.Emulator, signer, nodeID, nil, "1.5")
+			require.NoError(t, err)
+			assert.Equal(t, cadence.NewOptional(nil), decodeArguments(t, tx)[1])
+		})
+	}
+}
+
+func TestGetDelegatorInfo(t *testing.T) {
+	info := newStruct("FlowIDTableStaking.DelegatorInfo",
+		[]string{"id", "nodeID", "tokensCommitted", "tokensStaked", "tokensUnstaking", "tokensRewarded", "tokensUnstaked", "tokensRequestedToUnstake"},
+		cadence.NewUInt32(7), cadence.String(nodeID),
+		ufix64(t, "1.0"), ufix64(t, "100.0"), ufix64(t, "0.0"), ufix64(t, "2.5"), ufix64(t, "0.0"), ufix64(t, "0.0"),
+	)
+
+	client := &mocks.Client{}
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.String(nodeID), cadence.NewUInt32(7)}).
+		Return(info, nil)
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.Address(flow.HexToAddress("01"))}).
+		Return(cadence.NewArray([]cadence.Value{info}), nil)
+
+	expected := staking.DelegatorInfo{
+		ID:                       7,
+		NodeID:                   nodeID,
+		TokensCommitted:          "1.00000000",
+		TokensStaked:             "100.00000000",
+		TokensUnstaking:          "0.00000000",
+		TokensRewarded:           "2.50000000",
+		TokensUnstaked:           "0.00000000",
+		TokensRequestedToUnstake: "0.00000000",
+	}
+
+	delegator, err := staking.GetDelegatorInfo(context.Background(), client, flow.Mainnet, nodeID, 7)
+	require.NoError(t, err)
+	assert.Equal(t, &expected, delegator)
+
+	script := client.Calls[0].Arguments.Get(1).([]byte)
+	assert.Contains(t, string(script), "import FlowIDTableStaking from 0x8624b52f9ddcd04a")
+
+	delegators, err := staking.GetDelegators(context.Background(), client, flow.Mainnet, flow.HexToAddress("01"))
+	require.NoError(t, err)
+	assert.Equal(t, []staking.DelegatorInfo{expected}, delegators)
+}
+
+func TestGetNodeInfo(t *testing.T) {
+	info := newStruct("FlowIDTableStaking.NodeInfo",
+		[]string{"id", "role", "networkingAddress", "tokensStaked", "delegators", "initialWeight"},
+		cadence.String(nodeID), cadence.NewUInt8(4), cadence.String("access.example.com:3569"),
+		ufix64(t, "100.0"), cadence.NewArray([]cadence.Value{cadence.NewUInt32(1)}), cadence.NewUInt64(100),
+	)
+
+	client := &mocks.Client{}
+	client.On("ExecuteScriptAtLatestBlock", mock.Anything, mock.Anything, []cadence.Value{cadence.String(nodeID)}).
+		Return(info, nil)
+
+	node, err := staking.GetNodeInfo(context.Background(), client, flow.Testnet, nodeID)
+	require.NoError(t, err)
+	assert.Equal(t, &staking.NodeInfo{
+		ID:                nodeID,
+		Role:              staking.RoleVerification,
+		NetworkingAddress: "access.example.com:3569",
+		TokensStaked:      "100.00000000",
+		Delegators:        []uint32{1},
+		InitialWeight:     100,
+	}, node)
+	assert.Equal(t, "verification", node.Role.String())
+}
+
+func newStruct(name string, fields []string, values ...cadence.Value) cadence.Struct {
+	structFields := make([]cadence.Field, len(fields))
+	for i, field := range fields {
+		structFields[i] = cadence.Field{Identifier: field, Type: values[i].Type()}
+	}
+
+	return cadence.NewStruct(values).WithType(&cadence.StructType{
+		Location:            common.StringLocation("test"),
+		QualifiedIdentifier: name,
+		Fields:              structFields,
+	})
+}
+
+func ufix64(t *testing.T, s string) cadence.UFix64 {
+	value, err := cadence.NewUFix64(s)
+	require.NoError(t, err)
+	return value
+}
+
+func decodeArguments(t *testing.T, tx *flow.Transaction) []cadence.Value {
+	values := make([]cadence.Value, len(tx.Arguments))
+	for i, argument := range tx.Arguments {
+		value, err := jsoncdc.Decode(nil, argument)
+		require.NoError(t, err)
+		values[i] = value
+	}
+	return values
+}
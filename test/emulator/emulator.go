@@ -0,0 +1,463 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package emulator starts a Flow emulator for end-to-end tests of transactions and scripts.
+//
+// The emulator is started as a separate process, either from the flow-emulator binary or from the
+// emulator Docker image, with a service account key generated for the test:
+//
+//	func TestTransfer(t *testing.T) {
+//		e := emulator.New(t)
+//
+//		alice, err := e.CreateAccount(ctx, "100.0")
+//		...
+//		result, err := e.Execute(ctx, tx, alice)
+//	}
+package emulator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/templates"
+)
+
+const (
+	// DefaultBinary is the emulator binary started by default.
+	DefaultBinary = "flow-emulator"
+	// DefaultImage is the emulator Docker image started with WithDocker.
+	DefaultImage = "gcr.io/flow-container-registry/emulator:latest"
+
+	// containerPort is the gRPC port of the emulator in the Docker image.
+	containerPort = 3569
+)
+
+// ErrNotInstalled is returned when the emulator binary or Docker is not installed.
+var ErrNotInstalled = errors.New("emulator runtime is not installed")
+
+// An Account is an emulator account with a key used to sign transactions.
+type Account struct {
+	Address flow.Address
+	Key     *flow.AccountKey
+	Signer  crypto.Signer
+}
+
+type config struct {
+	binary       string
+	args         []string
+	image        string
+	startTimeout time.Duration
+}
+
+// Option configures how the emulator is started.
+type Option func(c *config)
+
+// WithBinary starts the emulator from the binary, with the arguments preceding the emulator flags,
+// for example WithBinary("flow", "emulator") to start the emulator of the Flow CLI.
+func WithBinary(path string, args ...string) Option {
+	return func(c *config) {
+		c.binary = path
+		c.args = args
+		c.image = ""
+	}
+}
+
+// WithDocker starts the emulator in a Docker container from the image, DefaultImage if empty.
+func WithDocker(image string) Option {
+	return func(c *config) {
+		if image == "" {
+			image = DefaultImage
+		}
+		c.image = image
+	}
+}
+
+// WithStartTimeout sets how long to wait for the emulator to accept requests, 30 seconds by default.
+func WithStartTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.startTimeout = timeout
+	}
+}
+
+// An Emulator is a running Flow emulator with a client connected to it.
+type Emulator struct {
+	// Client is connected to the gRPC API of the emulator.
+	Client *grpc.Client
+	// Host is the address of the gRPC API of the emulator.
+	Host string
+
+	service   Account
+	sequence  *access.SequenceNumberTracker
+	cmd       *exec.Cmd
+	container string
+	exited    chan struct{}
+	stopOnce  sync.Once
+}
+
+// New starts an emulator for the test and stops it when the test completes.
+//
+// The test is skipped if the emulator binary or Docker is not installed, and fails if the emulator
+// can't be started.
+func New(t testing.TB, opts ...Option) *Emulator {
+	t.Helper()
+
+	e, err := Start(context.Background(), opts...)
+	if errors.Is(err, ErrNotInstalled) {
+		t.Skipf("skipping test requiring the emulator: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("failed to start emulator: %s", err)
+	}
+
+	t.Cleanup(func() {
+		_ = e.Stop()
+	})
+
+	return e
+}
+
+// Start starts an emulator and waits until it accepts requests.
+//
+// ErrNotInstalled is returned if the emulator binary or Docker can't be found.
+func Start(ctx context.Context, opts ...Option) (*Emulator, error) {
+	conf := config{
+		binary:       DefaultBinary,
+		startTimeout: 30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&conf)
+	}
+
+	privateKey, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := freePort()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find a free port: %w", err)
+	}
+
+	e := &Emulator{
+		Host:   fmt.Sprintf("127.0.0.1:%d", port),
+		exited: make(chan struct{}),
+	}
+
+	name, args := conf.command(privateKey, port)
+	if conf.image != "" {
+		e.container = containerName(port)
+	}
+
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotInstalled, err)
+	}
+
+	e.cmd = exec.Command(path, args...)
+	if err := e.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start emulator: %w", err)
+	}
+	go func() {
+		_ = e.cmd.Wait()
+		close(e.exited)
+	}()
+
+	if err := e.connect(ctx, conf.startTimeout); err != nil {
+		_ = e.Stop()
+		return nil, err
+	}
+
+	signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+	if err != nil {
+		_ = e.Stop()
+		return nil, err
+	}
+
+	address := flow.ServiceAddress(flow.Emulator)
+	account, err := e.Client.GetAccount(ctx, address)
+	if err != nil {
+		_ = e.Stop()
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+
+	e.service = Account{Address: address, Key: account.Keys[0], Signer: signer}
+	e.sequence = access.NewSequenceNumberTracker(e.Client)
+
+	return e, nil
+}
+
+// command returns the command starting the emulator with the service key, serving the gRPC API on the port.
+func (c config) command(serviceKey crypto.PrivateKey, port int) (string, []string) {
+	key := hex.EncodeToString(serviceKey.Encode())
+
+	if c.image != "" {
+		return "docker", []string{
+			"run", "--rm",
+			"--name", containerName(port),
+			"-p", fmt.Sprintf("127.0.0.1:%d:%d", port, containerPort),
+			"-e", "FLOW_SERVICEPRIVATEKEY=" + key,
+			"-e", "FLOW_SERVICEKEYSIGALGO=" + crypto.ECDSA_P256.String(),
+			"-e", "FLOW_SERVICEKEYHASHALGO=" + crypto.SHA3_256.String(),
+			c.image,
+		}
+	}
+
+	args := append([]string(nil), c.args...)
+	args = append(args,
+		"--port", strconv.Itoa(port),
+		"--rest-port", "0",
+		"--admin-port", "0",
+		"--service-priv-key", key,
+		"--service-sig-algo", crypto.ECDSA_P256.String(),
+		"--service-hash-algo", crypto.SHA3_256.String(),
+	)
+	return c.binary, args
+}
+
+// connect waits until the emulator accepts requests.
+func (e *Emulator) connect(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := grpc.NewClient(e.Host)
+	if err != nil {
+		return fmt.Errorf("failed to create emulator client: %w", err)
+	}
+	e.Client = client
+
+	for {
+		if err := client.Ping(ctx); err == nil {
+			return nil
+		}
+
+		select {
+		case <-e.exited:
+			return fmt.Errorf("emulator exited before accepting requests")
+		case <-ctx.Done():
+			return fmt.Errorf("emulator did not accept requests within %s: %w", timeout, ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// Stop stops the emulator and closes its client.
+func (e *Emulator) Stop() error {
+	var err error
+	e.stopOnce.Do(func() {
+		if e.Client != nil {
+			_ = e.Client.Close()
+		}
+
+		if e.container != "" {
+			_ = exec.Command("docker", "rm", "-f", e.container).Run()
+		} else if e.cmd.Process != nil {
+			err = e.cmd.Process.Kill()
+		}
+
+		<-e.exited
+	})
+	return err
+}
+
+// ServiceAccount returns the service account of the emulator, which pays for the executed transactions.
+func (e *Emulator) ServiceAccount() Account {
+	return e.service
+}
+
+// Execute sends the transaction with the accounts as authorizers and waits until it is sealed.
+//
+// The service account proposes and pays for the transaction, the reference block and the proposal key
+// are set by Execute. An error is returned if the transaction fails.
+func (e *Emulator) Execute(ctx context.Context, tx *flow.Transaction, authorizers ...Account) (*flow.TransactionResult, error) {
+	block, err := e.Client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference block: %w", err)
+	}
+
+	tx.SetReferenceBlockID(block.ID).
+		SetPayer(e.service.Address).
+		SetProposalKey(e.service.Address, e.service.Key.Index, 0)
+	for _, a := range authorizers {
+		tx.AddAuthorizer(a.Address)
+	}
+
+	err = e.sequence.SendTransaction(ctx, tx, func(tx *flow.Transaction) error {
+		for _, a := range authorizers {
+			if a.Address == e.service.Address {
+				continue
+			}
+			if err := tx.SignPayload(a.Address, a.Key.Index, a.Signer); err != nil {
+				return err
+			}
+		}
+		return tx.SignEnvelope(e.service.Address, e.service.Key.Index, e.service.Signer)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	result, err := access.WaitForSeal(ctx, e.Client, tx.ID(), access.WithPollInterval(50*time.Millisecond))
+	if err != nil {
+		return nil, err
+	}
+	e.sequence.CheckResult(tx, result)
+	if result.Error != nil {
+		return result, fmt.Errorf("transaction %s failed: %w", tx.ID(), result.Error)
+	}
+
+	return result, nil
+}
+
+// CreateAccount creates an account with a new key and funds it with the FLOW balance, such as "10.0".
+func (e *Emulator) CreateAccount(ctx context.Context, balance string) (Account, error) {
+	privateKey, err := generateKey()
+	if err != nil {
+		return Account{}, err
+	}
+
+	key := flow.NewAccountKey().
+		FromPrivateKey(privateKey).
+		SetHashAlgo(crypto.SHA3_256).
+		SetWeight(flow.AccountKeyWeightThreshold)
+
+	tx, err := templates.CreateAccount([]*flow.AccountKey{key}, nil, e.service.Address)
+	if err != nil {
+		return Account{}, err
+	}
+
+	// the account creation template already has the service account as authorizer
+	result, err := e.Execute(ctx, tx)
+	if err != nil {
+		return Account{}, fmt.Errorf("failed to create account: %w", err)
+	}
+
+	address, err := templates.ParseAccountCreated(result)
+	if err != nil {
+		return Account{}, err
+	}
+
+	signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+	if err != nil {
+		return Account{}, err
+	}
+
+	key.Index = 0
+	account := Account{Address: address, Key: key, Signer: signer}
+
+	if err := e.FundAccount(ctx, address, balance); err != nil {
+		return Account{}, err
+	}
+
+	return account, nil
+}
+
+const mintTokensTransaction = `
+import FungibleToken
+import FlowToken
+
+transaction(recipient: Address, amount: UFix64) {
+	let tokenAdmin: &FlowToken.Administrator
+	let tokenReceiver: &{FungibleToken.Receiver}
+
+	prepare(signer: AuthAccount) {
+		self.tokenAdmin = signer
+			.borrow<&FlowToken.Administrator>(from: /storage/flowTokenAdmin)
+			?? panic("Signer is not the token admin")
+
+		self.tokenReceiver = getAccount(recipient)
+			.getCapability(/public/flowTokenReceiver)
+			.borrow<&{FungibleToken.Receiver}>()
+			?? panic("Unable to borrow receiver reference")
+	}
+
+	execute {
+		let minter <- self.tokenAdmin.createNewMinter(allowedAmount: amount)
+		let mintedVault <- minter.mintTokens(amount: amount)
+
+		self.tokenReceiver.deposit(from: <-mintedVault)
+
+		destroy minter
+	}
+}
+`
+
+// FundAccount mints the FLOW amount, such as "10.0", to the account.
+func (e *Emulator) FundAccount(ctx context.Context, address flow.Address, amount string) error {
+	value, err := cadence.NewUFix64(amount)
+	if err != nil {
+		return fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+
+	script, err := templates.ResolveImports(flow.Emulator, []byte(mintTokensTransaction))
+	if err != nil {
+		return err
+	}
+
+	tx := flow.NewTransaction().SetScript(script)
+	if err := tx.AddArgument(cadence.NewAddress(address)); err != nil {
+		return err
+	}
+	if err := tx.AddArgument(value); err != nil {
+		return err
+	}
+
+	_, err = e.Execute(ctx, tx, e.service)
+	if err != nil {
+		return fmt.Errorf("failed to fund account %s: %w", address, err)
+	}
+
+	return nil
+}
+
+func generateKey() (crypto.PrivateKey, error) {
+	seed := make([]byte, crypto.MinSeedLength)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+
+	return crypto.GeneratePrivateKey(crypto.ECDSA_P256, seed)
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+func containerName(port int) string {
+	return fmt.Sprintf("flow-go-sdk-emulator-%d", port)
+}
@@ -0,0 +1,71 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+func TestConfig_Command(t *testing.T) {
+	key, err := generateKey()
+	require.NoError(t, err)
+
+	t.Run("Binary", func(t *testing.T) {
+		conf := config{binary: DefaultBinary}
+		for _, opt := range []Option{WithBinary("flow", "emulator")} {
+			opt(&conf)
+		}
+
+		name, args := conf.command(key, 4000)
+		assert.Equal(t, "flow", name)
+		assert.Equal(t, []string{"emulator", "--port", "4000"}, args[:3])
+		assert.Contains(t, args, "--service-priv-key")
+		assert.Contains(t, args, crypto.ECDSA_P256.String())
+	})
+
+	t.Run("Docker", func(t *testing.T) {
+		conf := config{binary: DefaultBinary}
+		WithDocker("")(&conf)
+
+		name, args := conf.command(key, 4000)
+		assert.Equal(t, "docker", name)
+		assert.Contains(t, args, "127.0.0.1:4000:3569")
+		assert.Equal(t, DefaultImage, args[len(args)-1])
+	})
+}
+
+func TestStart_NotInstalled(t *testing.T) {
+	_, err := Start(context.Background(), WithBinary("flow-emulator-not-installed"))
+	assert.True(t, errors.Is(err, ErrNotInstalled))
+}
+
+func TestNew_SkipsWhenNotInstalled(t *testing.T) {
+	skipped := t.Run("Emulator", func(t *testing.T) {
+		New(t, WithBinary("flow-emulator-not-installed"))
+		t.Error("test should be skipped")
+	})
+	assert.True(t, skipped)
+}
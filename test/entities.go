@@ -73,6 +73,15 @@ func (g *AccountKeys) New() *flow.AccountKey {
 }
 
 func (g *AccountKeys) NewWithSigner() (*flow.AccountKey, crypto.Signer) {
+	return g.NewWithAlgorithms(crypto.ECDSA_P256, crypto.SHA3_256)
+}
+
+// NewWithAlgorithms returns a new account key using the signature and hash algorithms,
+// with a signer for the key.
+func (g *AccountKeys) NewWithAlgorithms(
+	sigAlgo crypto.SignatureAlgorithm,
+	hashAlgo crypto.HashAlgorithm,
+) (*flow.AccountKey, crypto.Signer) {
 	defer func() { g.count++ }()
 
 	seed := make([]byte, crypto.MinSeedLength)
@@ -80,7 +89,7 @@ func (g *AccountKeys) NewWithSigner() (*flow.AccountKey, crypto.Signer) {
 		seed[i] = uint8(g.count)
 	}
 
-	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, seed)
+	privateKey, err := crypto.GeneratePrivateKey(sigAlgo, seed)
 
 	if err != nil {
 		panic(err)
@@ -89,14 +98,13 @@ func (g *AccountKeys) NewWithSigner() (*flow.AccountKey, crypto.Signer) {
 	accountKey := flow.AccountKey{
 		Index:          g.count,
 		PublicKey:      privateKey.PublicKey(),
-		SigAlgo:        crypto.ECDSA_P256,
-		HashAlgo:       crypto.SHA3_256,
+		SigAlgo:        sigAlgo,
+		HashAlgo:       hashAlgo,
 		Weight:         flow.AccountKeyWeightThreshold,
 		SequenceNumber: 42,
 	}
 
-	// error here is nil since ECDSA_P256 and SHA3_256 are compatible,
-	// but keeping the error check for sanity
+	// error here is nil for compatible algorithms, but keeping the error check for sanity
 	signer, err := crypto.NewInMemorySigner(privateKey, accountKey.HashAlgo)
 	if err != nil {
 		panic(err)
@@ -105,6 +113,29 @@ func (g *AccountKeys) NewWithSigner() (*flow.AccountKey, crypto.Signer) {
 	return &accountKey, signer
 }
 
+// keyAlgorithms are the compatible signature and hash algorithms supported by account keys.
+var keyAlgorithms = []struct {
+	sigAlgo  crypto.SignatureAlgorithm
+	hashAlgo crypto.HashAlgorithm
+}{
+	{crypto.ECDSA_P256, crypto.SHA2_256},
+	{crypto.ECDSA_P256, crypto.SHA3_256},
+	{crypto.ECDSA_P256, crypto.Keccak256},
+	{crypto.ECDSA_secp256k1, crypto.SHA2_256},
+	{crypto.ECDSA_secp256k1, crypto.SHA3_256},
+	{crypto.ECDSA_secp256k1, crypto.Keccak256},
+}
+
+// NewAllAlgorithms returns a new account key for every combination of signature and hash algorithms
+// supported by account keys.
+func (g *AccountKeys) NewAllAlgorithms() []*flow.AccountKey {
+	keys := make([]*flow.AccountKey, len(keyAlgorithms))
+	for i, algos := range keyAlgorithms {
+		keys[i], _ = g.NewWithAlgorithms(algos.sigAlgo, algos.hashAlgo)
+	}
+	return keys
+}
+
 type Addresses struct {
 	generator *flow.AddressGenerator
 }
@@ -160,18 +191,20 @@ func (g *Blocks) New() *flow.Block {
 }
 
 type BlockHeaders struct {
-	count     int
-	ids       *Identifiers
-	startTime time.Time
+	count      int
+	ids        *Identifiers
+	signatures *Signatures
+	startTime  time.Time
 }
 
 func BlockHeaderGenerator() *BlockHeaders {
 	startTime, _ := time.Parse(time.RFC3339, "2020-06-04T15:43:21+00:00")
 
 	return &BlockHeaders{
-		count:     1,
-		ids:       IdentifierGenerator(),
-		startTime: startTime.UTC(),
+		count:      1,
+		ids:        IdentifierGenerator(),
+		signatures: SignaturesGenerator(),
+		startTime:  startTime.UTC(),
 	}
 }
 
@@ -179,10 +212,31 @@ func (g *BlockHeaders) New() flow.BlockHeader {
 	defer func() { g.count++ }()
 
 	return flow.BlockHeader{
-		ID:        g.ids.New(),
-		ParentID:  g.ids.New(),
-		Height:    uint64(g.count),
-		Timestamp: g.startTime.Add(time.Hour * time.Duration(g.count)),
+		ID:                 g.ids.New(),
+		ParentID:           g.ids.New(),
+		Height:             uint64(g.count),
+		Timestamp:          g.startTime.Add(time.Hour * time.Duration(g.count)),
+		ParentVoterSigData: g.signatures.New()[0],
+	}
+}
+
+type BlockDigests struct {
+	headers *BlockHeaders
+}
+
+func BlockDigestGenerator() *BlockDigests {
+	return &BlockDigests{
+		headers: BlockHeaderGenerator(),
+	}
+}
+
+func (g *BlockDigests) New() *flow.BlockDigest {
+	header := g.headers.New()
+
+	return &flow.BlockDigest{
+		ID:        header.ID,
+		Height:    header.Height,
+		Timestamp: header.Timestamp,
 	}
 }
 
@@ -206,28 +260,34 @@ func (g *Collections) New() *flow.Collection {
 }
 
 type CollectionGuarantees struct {
-	ids *Identifiers
+	ids        *Identifiers
+	signatures *Signatures
 }
 
 type BlockSeals struct {
-	ids *Identifiers
+	ids        *Identifiers
+	signatures *Signatures
 }
 
 func CollectionGuaranteeGenerator() *CollectionGuarantees {
 	return &CollectionGuarantees{
-		ids: IdentifierGenerator(),
+		ids:        IdentifierGenerator(),
+		signatures: SignaturesGenerator(),
 	}
 }
 
 func (g *CollectionGuarantees) New() *flow.CollectionGuarantee {
 	return &flow.CollectionGuarantee{
 		CollectionID: g.ids.New(),
+		SignerIDs:    []flow.Identifier{g.ids.New(), g.ids.New()},
+		Signature:    g.signatures.New()[0],
 	}
 }
 
 func BlockSealGenerator() *BlockSeals {
 	return &BlockSeals{
-		ids: IdentifierGenerator(),
+		ids:        IdentifierGenerator(),
+		signatures: SignaturesGenerator(),
 	}
 }
 
@@ -235,6 +295,54 @@ func (g *BlockSeals) New() *flow.BlockSeal {
 	return &flow.BlockSeal{
 		BlockID:            g.ids.New(),
 		ExecutionReceiptID: g.ids.New(),
+		ResultID:           g.ids.New(),
+		FinalState:         g.ids.New().Bytes(),
+		AggregatedApprovalSigs: []*flow.AggregatedSignature{
+			{
+				VerifierSignatures: g.signatures.New(),
+				SignerIDs:          []flow.Identifier{g.ids.New()},
+			},
+		},
+	}
+}
+
+type ExecutionResults struct {
+	ids *Identifiers
+}
+
+func ExecutionResultGenerator() *ExecutionResults {
+	return &ExecutionResults{
+		ids: IdentifierGenerator(),
+	}
+}
+
+func (g *ExecutionResults) New() *flow.ExecutionResult {
+	blockID := g.ids.New()
+
+	chunks := make([]*flow.Chunk, 2)
+	for i := range chunks {
+		chunks[i] = &flow.Chunk{
+			CollectionIndex:      uint(i),
+			StartState:           flow.StateCommitment(g.ids.New()),
+			EventCollection:      g.ids.New().Bytes(),
+			BlockID:              blockID,
+			TotalComputationUsed: uint64(100 * (i + 1)),
+			NumberOfTransactions: uint16(i + 1),
+			Index:                uint64(i),
+			EndState:             flow.StateCommitment(g.ids.New()),
+		}
+	}
+
+	return &flow.ExecutionResult{
+		PreviousResultID: g.ids.New(),
+		BlockID:          blockID,
+		Chunks:           chunks,
+		ServiceEvents: []*flow.ServiceEvent{
+			{
+				Type:    "flow.EpochSetup",
+				Payload: []byte(`{"counter":1}`),
+			},
+		},
 	}
 }
 
@@ -297,6 +405,59 @@ func (g *Events) New() flow.Event {
 	return event
 }
 
+type BlockEvents struct {
+	headers *BlockHeaders
+	events  *Events
+}
+
+func BlockEventsGenerator() *BlockEvents {
+	return &BlockEvents{
+		headers: BlockHeaderGenerator(),
+		events:  EventGenerator(),
+	}
+}
+
+func (g *BlockEvents) New() flow.BlockEvents {
+	header := g.headers.New()
+
+	return flow.BlockEvents{
+		BlockID:        header.ID,
+		Height:         header.Height,
+		BlockTimestamp: header.Timestamp,
+		Events: []flow.Event{
+			g.events.New(),
+			g.events.New(),
+		},
+	}
+}
+
+type AccountStatuses struct {
+	headers   *BlockHeaders
+	addresses *Addresses
+	events    *Events
+}
+
+func AccountStatusGenerator() *AccountStatuses {
+	return &AccountStatuses{
+		headers:   BlockHeaderGenerator(),
+		addresses: AddressGenerator(),
+		events:    EventGenerator(),
+	}
+}
+
+func (g *AccountStatuses) New() *flow.AccountStatus {
+	header := g.headers.New()
+
+	return &flow.AccountStatus{
+		BlockID:     header.ID,
+		BlockHeight: header.Height,
+		Events: map[flow.Address][]flow.Event{
+			g.addresses.New(): {g.events.New()},
+			g.addresses.New(): {g.events.New(), g.events.New()},
+		},
+	}
+}
+
 type Signatures struct {
 	count int
 }
@@ -434,3 +595,10 @@ func (g *TransactionResults) New() flow.TransactionResult {
 		BlockHeight: blockHeight,
 	}
 }
+
+// NewSuccessful returns the result of a transaction executed without error.
+func (g *TransactionResults) NewSuccessful() flow.TransactionResult {
+	result := g.New()
+	result.Error = nil
+	return result
+}
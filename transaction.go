@@ -20,9 +20,11 @@ package flow
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/onflow/cadence"
@@ -144,6 +146,18 @@ func (t *Transaction) AddArgument(arg cadence.Value) error {
 	return nil
 }
 
+// AddArgumentValue converts a Go value to a Cadence value and adds it as an argument to this transaction.
+//
+// See ToCadenceValue for how Go values are converted.
+func (t *Transaction) AddArgumentValue(v interface{}) error {
+	arg, err := ToCadenceValue(v)
+	if err != nil {
+		return fmt.Errorf("failed to convert argument: %w", err)
+	}
+
+	return t.AddArgument(arg)
+}
+
 // AddRawArgument adds a raw JSON-CDC encoded argument to this transaction.
 func (t *Transaction) AddRawArgument(arg []byte) *Transaction {
 	t.Arguments = append(t.Arguments, arg)
@@ -255,6 +269,23 @@ func (t *Transaction) signerList() []Address {
 	return signers
 }
 
+// weightedSigner returns true if the account is the payer or an authorizer of the transaction,
+// and so must sign with keys reaching the account key weight threshold. An account that is only
+// the proposer just signs with the proposal key.
+func (t *Transaction) weightedSigner(address Address) bool {
+	if address == t.Payer {
+		return true
+	}
+
+	for _, authorizer := range t.Authorizers {
+		if address == authorizer {
+			return true
+		}
+	}
+
+	return false
+}
+
 // signerMap returns a mapping from address to signer index.
 func (t *Transaction) signerMap() map[Address]int {
 	signers := make(map[Address]int)
@@ -291,9 +322,7 @@ func (t *Transaction) refreshSignerIndex() {
 //
 // This function returns an error if the signature cannot be generated.
 func (t *Transaction) SignPayload(address Address, keyIndex int, signer crypto.Signer) error {
-	message := t.PayloadMessage()
-	message = append(TransactionDomainTag[:], message...)
-	sig, err := signer.Sign(message)
+	sig, err := signer.Sign(t.PayloadSignableMessage())
 	if err != nil {
 		// TODO: wrap error
 		return err
@@ -311,9 +340,7 @@ func (t *Transaction) SignPayload(address Address, keyIndex int, signer crypto.S
 //
 // This function returns an error if the signature cannot be generated.
 func (t *Transaction) SignEnvelope(address Address, keyIndex int, signer crypto.Signer) error {
-	message := t.EnvelopeMessage()
-	message = append(TransactionDomainTag[:], message...)
-	sig, err := signer.Sign(message)
+	sig, err := signer.Sign(t.EnvelopeSignableMessage())
 	if err != nil {
 		// TODO: wrap error
 		return err
@@ -344,6 +371,36 @@ func (t *Transaction) AddEnvelopeSignature(address Address, keyIndex int, sig []
 	return t
 }
 
+// AttachSignature adds a signature produced outside of the SDK for the given address and key index.
+//
+// The signature is added to the envelope if the address is the payer, otherwise it is added to the
+// payload. An error is returned if the address is not a signer of the transaction.
+func (t *Transaction) AttachSignature(address Address, keyIndex int, sig []byte) error {
+	if address == t.Payer {
+		t.AddEnvelopeSignature(address, keyIndex, sig)
+		return nil
+	}
+
+	if _, ok := t.signerMap()[address]; !ok {
+		return fmt.Errorf("account %s is not a signer of the transaction", address)
+	}
+
+	t.AddPayloadSignature(address, keyIndex, sig)
+	return nil
+}
+
+// AttachSignatureHex adds a hex encoded signature produced outside of the SDK for the given address and key index.
+//
+// See AttachSignature for how the signature is added.
+func (t *Transaction) AttachSignatureHex(address Address, keyIndex int, sig string) error {
+	b, err := hex.DecodeString(strings.TrimPrefix(sig, "0x"))
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	return t.AttachSignature(address, keyIndex, b)
+}
+
 func (t *Transaction) createSignature(address Address, keyIndex int, sig []byte) TransactionSignature {
 	signerIndex, signerExists := t.signerMap()[address]
 	if !signerExists {
@@ -358,6 +415,33 @@ func (t *Transaction) createSignature(address Address, keyIndex int, sig []byte)
 	}
 }
 
+// PayloadSignableMessage returns the message signed by the proposer and authorizers,
+// which is the payload message prefixed with the transaction domain tag.
+//
+// The message can be exported to sign the transaction offline.
+func (t *Transaction) PayloadSignableMessage() []byte {
+	return DomainTaggedMessage(TransactionDomainTag, t.PayloadMessage())
+}
+
+// PayloadSignableMessageHex returns the hex encoded payload signable message.
+func (t *Transaction) PayloadSignableMessageHex() string {
+	return hex.EncodeToString(t.PayloadSignableMessage())
+}
+
+// EnvelopeSignableMessage returns the message signed by the payer,
+// which is the envelope message prefixed with the transaction domain tag.
+//
+// The envelope message includes the payload signatures, so it must be exported once all
+// the payload signatures have been added.
+func (t *Transaction) EnvelopeSignableMessage() []byte {
+	return DomainTaggedMessage(TransactionDomainTag, t.EnvelopeMessage())
+}
+
+// EnvelopeSignableMessageHex returns the hex encoded envelope signable message.
+func (t *Transaction) EnvelopeSignableMessageHex() string {
+	return hex.EncodeToString(t.EnvelopeSignableMessage())
+}
+
 func (t *Transaction) PayloadMessage() []byte {
 	temp := t.payloadCanonicalForm()
 	return mustRLPEncode(&temp)
@@ -421,7 +505,11 @@ func (t *Transaction) Encode() []byte {
 
 // DecodeTransaction decodes the input bytes into a Transaction struct
 // able to decode outputs from PayloadMessage(), EnvelopeMessage() and Encode()
-// functions
+// functions.
+//
+// Decoding the output of Encode() round-trips the full transaction, including
+// its payload and envelope signatures. An error is returned if a signature
+// references a signer that is not part of the transaction.
 func DecodeTransaction(transactionMessage []byte) (*Transaction, error) {
 	temp, err := decodeTransaction(transactionMessage)
 	if err != nil {
@@ -449,6 +537,9 @@ func DecodeTransaction(transactionMessage []byte) (*Transaction, error) {
 	if len(temp.PayloadSignatures) > 0 {
 		payloadSignatures := make([]TransactionSignature, len(temp.PayloadSignatures))
 		for i, sig := range temp.PayloadSignatures {
+			if sig.SignerIndex >= uint(len(signers)) {
+				return nil, fmt.Errorf("payload signature signer index %d out of range", sig.SignerIndex)
+			}
 			payloadSignatures[i] = transactionSignatureFromCanonicalForm(sig)
 			payloadSignatures[i].Address = signers[payloadSignatures[i].SignerIndex]
 		}
@@ -458,6 +549,9 @@ func DecodeTransaction(transactionMessage []byte) (*Transaction, error) {
 	if len(temp.EnvelopeSignatures) > 0 {
 		envelopeSignatures := make([]TransactionSignature, len(temp.EnvelopeSignatures))
 		for i, sig := range temp.EnvelopeSignatures {
+			if sig.SignerIndex >= uint(len(signers)) {
+				return nil, fmt.Errorf("envelope signature signer index %d out of range", sig.SignerIndex)
+			}
 			envelopeSignatures[i] = transactionSignatureFromCanonicalForm(sig)
 			envelopeSignatures[i].Address = signers[envelopeSignatures[i].SignerIndex]
 		}
@@ -0,0 +1,274 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+)
+
+// TransactionBuilderClient is the part of the access API client used by a transaction builder
+// to resolve the reference block and the signer accounts.
+type TransactionBuilderClient interface {
+	GetLatestBlockHeader(ctx context.Context, isSealed bool) (*BlockHeader, error)
+	GetAccount(ctx context.Context, address Address) (*Account, error)
+}
+
+// TransactionBuilder builds transactions ready to be signed.
+//
+// The builder resolves the reference block and the proposal key sequence number with the access API,
+// and validates that every signer account has the declared signing keys with enough weight:
+//
+//	tx, err := flow.NewTransactionBuilder().
+//		Script(script).
+//		Argument(cadence.NewUInt64(42)).
+//		Proposer(address, 0).
+//		Payer(address).
+//		Authorizer(address).
+//		Build(ctx, flowClient)
+//
+// A builder can be reused to build more transactions, every build resolves the latest state.
+type TransactionBuilder struct {
+	script           []byte
+	arguments        [][]byte
+	gasLimit         uint64
+	referenceBlockID Identifier
+	proposer         Address
+	proposerKeyIndex int
+	payer            Address
+	authorizers      []Address
+	signingKeys      map[Address][]int
+	err              error
+}
+
+// NewTransactionBuilder returns a transaction builder with the default gas limit.
+func NewTransactionBuilder() *TransactionBuilder {
+	return &TransactionBuilder{
+		gasLimit:    DefaultTransactionGasLimit,
+		signingKeys: make(map[Address][]int),
+	}
+}
+
+// Script sets the transaction script.
+func (b *TransactionBuilder) Script(script []byte) *TransactionBuilder {
+	b.script = script
+	return b
+}
+
+// Argument adds an argument to the transaction, encoding errors are returned by Build.
+func (b *TransactionBuilder) Argument(arg cadence.Value) *TransactionBuilder {
+	encodedArg, err := jsoncdc.Encode(arg)
+	if err != nil {
+		b.err = fmt.Errorf("failed to encode argument %d: %w", len(b.arguments), err)
+		return b
+	}
+
+	b.arguments = append(b.arguments, encodedArg)
+	return b
+}
+
+// GasLimit sets the transaction gas limit.
+func (b *TransactionBuilder) GasLimit(limit uint64) *TransactionBuilder {
+	b.gasLimit = limit
+	return b
+}
+
+// ReferenceBlockID sets the transaction reference block, the latest sealed block is used if it isn't set.
+func (b *TransactionBuilder) ReferenceBlockID(blockID Identifier) *TransactionBuilder {
+	b.referenceBlockID = blockID
+	return b
+}
+
+// Proposer sets the proposal key, its sequence number is resolved when building the transaction.
+func (b *TransactionBuilder) Proposer(address Address, keyIndex int) *TransactionBuilder {
+	b.proposer = address
+	b.proposerKeyIndex = keyIndex
+	b.addSigningKeys(address, []int{keyIndex})
+	return b
+}
+
+// Payer sets the payer account and the indices of the keys it signs with.
+//
+// If no key index is provided the payer signs with the proposal key if it is the proposer,
+// or with its first key otherwise.
+func (b *TransactionBuilder) Payer(address Address, keyIndices ...int) *TransactionBuilder {
+	b.payer = address
+	b.addSigningKeys(address, keyIndices)
+	return b
+}
+
+// Authorizer adds an authorizer account and the indices of the keys it signs with.
+//
+// If no key index is provided the authorizer signs with the proposal key if it is the proposer,
+// or with its first key otherwise.
+func (b *TransactionBuilder) Authorizer(address Address, keyIndices ...int) *TransactionBuilder {
+	b.authorizers = append(b.authorizers, address)
+	b.addSigningKeys(address, keyIndices)
+	return b
+}
+
+func (b *TransactionBuilder) addSigningKeys(address Address, keyIndices []int) {
+	for _, index := range keyIndices {
+		if !containsKeyIndex(b.signingKeys[address], index) {
+			b.signingKeys[address] = append(b.signingKeys[address], index)
+		}
+	}
+}
+
+// signingKeyIndices returns the indices of the keys the account signs with.
+func (b *TransactionBuilder) signingKeyIndices(address Address) []int {
+	if keys := b.signingKeys[address]; len(keys) > 0 {
+		return keys
+	}
+	return []int{0}
+}
+
+// Build resolves and validates the transaction, returning it ready to be signed.
+func (b *TransactionBuilder) Build(ctx context.Context, client TransactionBuilderClient) (*Transaction, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if len(b.script) == 0 {
+		return nil, errors.New("transaction script is not set")
+	}
+
+	if b.proposer == EmptyAddress {
+		return nil, errors.New("transaction proposer is not set")
+	}
+
+	if b.payer == EmptyAddress {
+		return nil, errors.New("transaction payer is not set")
+	}
+
+	tx := NewTransaction().
+		SetScript(b.script).
+		SetGasLimit(b.gasLimit).
+		SetReferenceBlockID(b.referenceBlockID)
+
+	tx.Arguments = append([][]byte(nil), b.arguments...)
+
+	if tx.ReferenceBlockID == EmptyID {
+		header, err := client.GetLatestBlockHeader(ctx, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reference block: %w", err)
+		}
+		tx.SetReferenceBlockID(header.ID)
+	}
+
+	tx.SetPayer(b.payer)
+	for _, authorizer := range b.authorizers {
+		tx.AddAuthorizer(authorizer)
+	}
+
+	signers := []Address{b.proposer, b.payer}
+	signers = append(signers, b.authorizers...)
+
+	accounts := make(map[Address]*Account)
+	for _, address := range signers {
+		if _, ok := accounts[address]; ok {
+			continue
+		}
+
+		account, err := client.GetAccount(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signer account %s: %w", address, err)
+		}
+
+		if tx.weightedSigner(address) {
+			err = validateSigningKeys(account, b.signingKeyIndices(address))
+		} else {
+			_, err = signingKey(account, b.proposerKeyIndex)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		accounts[address] = account
+	}
+
+	proposalKey := accountKey(accounts[b.proposer], b.proposerKeyIndex)
+	tx.SetProposalKey(b.proposer, b.proposerKeyIndex, proposalKey.SequenceNumber)
+
+	return tx, nil
+}
+
+// validateSigningKeys checks that the keys exist, are not revoked and have enough weight to sign for the account.
+//
+// Only the payer and the authorizers need enough weight, a proposer that has no other role just signs
+// with the proposal key.
+func validateSigningKeys(account *Account, keyIndices []int) error {
+	weight := 0
+	for _, index := range keyIndices {
+		key, err := signingKey(account, index)
+		if err != nil {
+			return err
+		}
+
+		weight += key.Weight
+	}
+
+	if weight < AccountKeyWeightThreshold {
+		return fmt.Errorf(
+			"keys %v of account %s have a total weight of %d, a weight of %d is required",
+			keyIndices,
+			account.Address,
+			weight,
+			AccountKeyWeightThreshold,
+		)
+	}
+
+	return nil
+}
+
+// signingKey returns the key of the account with the index, checking that it exists and is not revoked.
+func signingKey(account *Account, index int) (*AccountKey, error) {
+	key := accountKey(account, index)
+	if key == nil {
+		return nil, fmt.Errorf("account %s has no key with index %d", account.Address, index)
+	}
+
+	if key.Revoked {
+		return nil, fmt.Errorf("key %d of account %s is revoked", index, account.Address)
+	}
+
+	return key, nil
+}
+
+func accountKey(account *Account, index int) *AccountKey {
+	for _, key := range account.Keys {
+		if key.Index == index {
+			return key
+		}
+	}
+	return nil
+}
+
+func containsKeyIndex(indices []int, index int) bool {
+	for _, i := range indices {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
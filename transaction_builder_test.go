@@ -0,0 +1,211 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+// builderClient is a client stub serving the latest sealed block and the accounts it knows.
+type builderClient struct {
+	header   *flow.BlockHeader
+	accounts map[flow.Address]*flow.Account
+}
+
+func (c *builderClient) GetLatestBlockHeader(context.Context, bool) (*flow.BlockHeader, error) {
+	return c.header, nil
+}
+
+func (c *builderClient) GetAccount(_ context.Context, address flow.Address) (*flow.Account, error) {
+	account, ok := c.accounts[address]
+	if !ok {
+		return nil, errors.New("account not found")
+	}
+	return account, nil
+}
+
+func TestTransactionBuilder(t *testing.T) {
+	ctx := context.Background()
+	addresses := test.AddressGenerator()
+	ids := test.IdentifierGenerator()
+
+	newClient := func(accounts ...*flow.Account) *builderClient {
+		client := &builderClient{
+			header:   &flow.BlockHeader{ID: ids.New()},
+			accounts: make(map[flow.Address]*flow.Account),
+		}
+		for _, account := range accounts {
+			client.accounts[account.Address] = account
+		}
+		return client
+	}
+
+	newAccount := func(keys ...*flow.AccountKey) *flow.Account {
+		return &flow.Account{Address: addresses.New(), Keys: keys}
+	}
+
+	script := []byte("transaction { execute {} }")
+
+	t.Run("Single signer", func(t *testing.T) {
+		account := newAccount(&flow.AccountKey{Index: 0, Weight: 1000, SequenceNumber: 7})
+		client := newClient(account)
+
+		tx, err := flow.NewTransactionBuilder().
+			Script(script).
+			Argument(cadence.NewUInt64(42)).
+			GasLimit(100).
+			Proposer(account.Address, 0).
+			Payer(account.Address).
+			Authorizer(account.Address).
+			Build(ctx, client)
+		require.NoError(t, err)
+
+		assert.Equal(t, script, tx.Script)
+		assert.Len(t, tx.Arguments, 1)
+		assert.Equal(t, uint64(100), tx.GasLimit)
+		assert.Equal(t, client.header.ID, tx.ReferenceBlockID)
+		assert.Equal(t, flow.ProposalKey{Address: account.Address, KeyIndex: 0, SequenceNumber: 7}, tx.ProposalKey)
+		assert.Equal(t, account.Address, tx.Payer)
+		assert.Equal(t, []flow.Address{account.Address}, tx.Authorizers)
+	})
+
+	t.Run("Multiple signers", func(t *testing.T) {
+		proposer := newAccount(
+			&flow.AccountKey{Index: 0, Weight: 1000, Revoked: true},
+			&flow.AccountKey{Index: 1, Weight: 1000, SequenceNumber: 3},
+		)
+		payer := newAccount(
+			&flow.AccountKey{Index: 0, Weight: 500},
+			&flow.AccountKey{Index: 1, Weight: 500},
+		)
+		client := newClient(proposer, payer)
+
+		referenceBlockID := ids.New()
+		tx, err := flow.NewTransactionBuilder().
+			Script(script).
+			ReferenceBlockID(referenceBlockID).
+			Proposer(proposer.Address, 1).
+			Payer(payer.Address, 0, 1).
+			Authorizer(proposer.Address).
+			Build(ctx, client)
+		require.NoError(t, err)
+
+		assert.Equal(t, referenceBlockID, tx.ReferenceBlockID)
+		assert.Equal(t, uint64(3), tx.ProposalKey.SequenceNumber)
+		assert.Equal(t, payer.Address, tx.Payer)
+	})
+
+	t.Run("Insufficient weight", func(t *testing.T) {
+		proposer := newAccount(&flow.AccountKey{Index: 0, Weight: 1000})
+		payer := newAccount(
+			&flow.AccountKey{Index: 0, Weight: 500},
+			&flow.AccountKey{Index: 1, Weight: 500},
+		)
+		client := newClient(proposer, payer)
+
+		_, err := flow.NewTransactionBuilder().
+			Script(script).
+			Proposer(proposer.Address, 0).
+			Payer(payer.Address, 0).
+			Build(ctx, client)
+		assert.ErrorContains(t, err, "total weight of 500")
+	})
+
+	t.Run("Proposer only", func(t *testing.T) {
+		proposer := newAccount(&flow.AccountKey{Index: 0, Weight: 100, SequenceNumber: 5})
+		payer := newAccount(&flow.AccountKey{Index: 0, Weight: 1000})
+		client := newClient(proposer, payer)
+
+		tx, err := flow.NewTransactionBuilder().
+			Script(script).
+			Proposer(proposer.Address, 0).
+			Payer(payer.Address).
+			Authorizer(payer.Address).
+			Build(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), tx.ProposalKey.SequenceNumber)
+
+		proposer.Keys[0].Revoked = true
+
+		_, err = flow.NewTransactionBuilder().
+			Script(script).
+			Proposer(proposer.Address, 0).
+			Payer(payer.Address).
+			Build(ctx, client)
+		assert.ErrorContains(t, err, "revoked")
+	})
+
+	t.Run("Revoked key", func(t *testing.T) {
+		account := newAccount(&flow.AccountKey{Index: 0, Weight: 1000, Revoked: true})
+		client := newClient(account)
+
+		_, err := flow.NewTransactionBuilder().
+			Script(script).
+			Proposer(account.Address, 0).
+			Payer(account.Address).
+			Build(ctx, client)
+		assert.ErrorContains(t, err, "revoked")
+	})
+
+	t.Run("Missing key", func(t *testing.T) {
+		account := newAccount(&flow.AccountKey{Index: 0, Weight: 1000})
+		client := newClient(account)
+
+		_, err := flow.NewTransactionBuilder().
+			Script(script).
+			Proposer(account.Address, 2).
+			Payer(account.Address).
+			Build(ctx, client)
+		assert.ErrorContains(t, err, "no key with index 2")
+	})
+
+	t.Run("Missing roles", func(t *testing.T) {
+		account := newAccount(&flow.AccountKey{Index: 0, Weight: 1000})
+		client := newClient(account)
+
+		_, err := flow.NewTransactionBuilder().Proposer(account.Address, 0).Payer(account.Address).Build(ctx, client)
+		assert.EqualError(t, err, "transaction script is not set")
+
+		_, err = flow.NewTransactionBuilder().Script(script).Payer(account.Address).Build(ctx, client)
+		assert.EqualError(t, err, "transaction proposer is not set")
+
+		_, err = flow.NewTransactionBuilder().Script(script).Proposer(account.Address, 0).Build(ctx, client)
+		assert.EqualError(t, err, "transaction payer is not set")
+	})
+
+	t.Run("Unknown account", func(t *testing.T) {
+		client := newClient()
+
+		_, err := flow.NewTransactionBuilder().
+			Script(script).
+			Proposer(addresses.New(), 0).
+			Payer(addresses.New()).
+			Build(ctx, client)
+		assert.ErrorContains(t, err, "account not found")
+	})
+}
@@ -0,0 +1,210 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TransactionErrorCode is the code of an error returned by the Flow virtual machine.
+type TransactionErrorCode int
+
+// The codes of the transaction errors applications most commonly branch on.
+const (
+	ErrCodeInvalidProposalSeqNumber      TransactionErrorCode = 1007
+	ErrCodeExecution                     TransactionErrorCode = 1100
+	ErrCodeCadenceRuntime                TransactionErrorCode = 1101
+	ErrCodeStorageCapacityExceeded       TransactionErrorCode = 1103
+	ErrCodeTransactionFeeDeductionFailed TransactionErrorCode = 1109
+	ErrCodeComputationLimitExceeded      TransactionErrorCode = 1110
+	ErrCodeMemoryLimitExceeded           TransactionErrorCode = 1111
+	ErrCodeInsufficientPayerBalance      TransactionErrorCode = 1118
+	ErrCodeAccountNotFound               TransactionErrorCode = 1201
+)
+
+// The kinds of Cadence errors raised by failed conditions and panics.
+const (
+	CadenceErrorPreConditionFailed  = "pre-condition failed"
+	CadenceErrorPostConditionFailed = "post-condition failed"
+	CadenceErrorAssertionFailed     = "assertion failed"
+	CadenceErrorPanic               = "panic"
+)
+
+var cadenceErrorKinds = []string{
+	CadenceErrorPreConditionFailed,
+	CadenceErrorPostConditionFailed,
+	CadenceErrorAssertionFailed,
+	CadenceErrorPanic,
+}
+
+var (
+	errorCodeRegexp     = regexp.MustCompile(`\[Error Code: (\d+)\]\s*`)
+	cadenceErrorRegexp  = regexp.MustCompile(`(?m)^error: (.*)$`)
+	errorLocationRegexp = regexp.MustCompile(`-->\s*(\S+):(\d+):(\d+)`)
+)
+
+// ErrorLocation is the location in Cadence code at which a transaction error occurred.
+type ErrorLocation struct {
+	// Address is the address of the account of the contract, or the empty address if the error
+	// occurred in the transaction code.
+	Address Address
+	// Contract is the name of the contract, or empty if the error occurred in the transaction code.
+	Contract string
+	Line     int
+	Column   int
+}
+
+func (l ErrorLocation) String() string {
+	if l.Contract == "" {
+		return fmt.Sprintf("transaction:%d:%d", l.Line, l.Column)
+	}
+
+	return fmt.Sprintf("%s.%s:%d:%d", l.Address.Hex(), l.Contract, l.Line, l.Column)
+}
+
+// TransactionError is the structured form of the error of a failed transaction.
+//
+// The fields are parsed from the error message returned by the access node, so a field is left
+// empty when the message doesn't contain the information.
+type TransactionError struct {
+	// Code is the innermost error code of the error, or 0 if the message has no error code.
+	Code TransactionErrorCode
+	// Kind is the kind of the Cadence error, such as CadenceErrorPreConditionFailed, or empty if
+	// the error isn't a Cadence error of a known kind.
+	Kind string
+	// Message is the message of the error, without the error codes, kind and stack trace.
+	Message string
+	// Location is the location of the error in the Cadence code, or nil if it's unknown.
+	Location *ErrorLocation
+
+	err error
+}
+
+// ParseTransactionError parses the error of a transaction result.
+//
+// ParseTransactionError returns nil if the error is nil.
+func ParseTransactionError(err error) *TransactionError {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	txErr := &TransactionError{err: err}
+
+	if codes := errorCodeRegexp.FindAllStringSubmatchIndex(msg, -1); len(codes) > 0 {
+		last := codes[len(codes)-1]
+		code, _ := strconv.Atoi(msg[last[2]:last[3]])
+		txErr.Code = TransactionErrorCode(code)
+		txErr.Message = firstLine(msg[last[1]:])
+	} else {
+		txErr.Message = firstLine(msg)
+	}
+
+	rest := msg
+	if match := cadenceErrorRegexp.FindStringSubmatchIndex(msg); match != nil {
+		txErr.Kind, txErr.Message = splitCadenceError(msg[match[2]:match[3]])
+		rest = msg[match[1]:]
+	}
+
+	if match := errorLocationRegexp.FindStringSubmatch(rest); match != nil {
+		txErr.Location = parseErrorLocation(match[1], match[2], match[3])
+	}
+
+	return txErr
+}
+
+// ParseError parses the error of the transaction result, see ParseTransactionError.
+func (r TransactionResult) ParseError() *TransactionError {
+	return ParseTransactionError(r.Error)
+}
+
+func (e *TransactionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *TransactionError) Unwrap() error {
+	return e.err
+}
+
+// IsPreConditionFailed returns true if a pre-condition of the transaction or a contract function failed.
+func (e *TransactionError) IsPreConditionFailed() bool {
+	return e.Kind == CadenceErrorPreConditionFailed
+}
+
+// IsPostConditionFailed returns true if a post-condition of the transaction or a contract function failed.
+func (e *TransactionError) IsPostConditionFailed() bool {
+	return e.Kind == CadenceErrorPostConditionFailed
+}
+
+// IsPanic returns true if the transaction or a contract function panicked.
+func (e *TransactionError) IsPanic() bool {
+	return e.Kind == CadenceErrorPanic
+}
+
+// IsInsufficientBalance returns true if the payer can't pay the transaction fees, or if a fungible
+// token vault doesn't have enough balance for a withdrawal.
+func (e *TransactionError) IsInsufficientBalance() bool {
+	switch e.Code {
+	case ErrCodeInsufficientPayerBalance, ErrCodeTransactionFeeDeductionFailed:
+		return true
+	}
+
+	msg := strings.ToLower(e.Message)
+	return strings.Contains(msg, "insufficient balance") ||
+		e.IsPreConditionFailed() && strings.Contains(msg, "amount withdrawn must be less than or equal than the balance")
+}
+
+func splitCadenceError(line string) (kind string, message string) {
+	for _, kind := range cadenceErrorKinds {
+		if line == kind {
+			return kind, ""
+		}
+		if strings.HasPrefix(line, kind+": ") {
+			return kind, strings.TrimPrefix(line, kind+": ")
+		}
+	}
+
+	return "", line
+}
+
+func parseErrorLocation(location, line, column string) *ErrorLocation {
+	l := &ErrorLocation{}
+	l.Line, _ = strconv.Atoi(line)
+	l.Column, _ = strconv.Atoi(column)
+
+	// contract locations are formatted as [A.]address.Contract, transaction locations as the
+	// transaction ID
+	location = strings.TrimPrefix(location, "A.")
+	if i := strings.LastIndex(location, "."); i >= 0 {
+		l.Address = HexToAddress(location[:i])
+		l.Contract = location[i+1:]
+	}
+
+	return l
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,110 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+const withdrawErrorMessage = `[Error Code: 1101] error caused by: 1 error occurred:
+	* transaction execute failed: [Error Code: 1101] cadence runtime error: Execution failed:
+error: pre-condition failed: Amount withdrawn must be less than or equal than the balance of the Vault
+   --> 7e60df042a9c0868.FlowToken:102:12
+    |
+102 |             pre {
+103 |                 self.balance >= amount:
+    |             ^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^
+`
+
+const panicErrorMessage = `[Error Code: 1101] error caused by: 1 error occurred:
+	* transaction execute failed: [Error Code: 1101] cadence runtime error: Execution failed:
+error: panic: Could not borrow reference to the owner's Vault!
+ --> 2c4b8e5f4d8c1b7b9e8e3fa9b6fb8d25c8f9d6b1d4f2b0d9b5e0b6b7b8d7f5c3:9:12
+  |
+9 |             ?? panic("Could not borrow reference to the owner's Vault!")
+  |             ^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^^
+`
+
+func TestParseTransactionError(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		assert.Nil(t, flow.ParseTransactionError(nil))
+	})
+
+	t.Run("Pre-condition", func(t *testing.T) {
+		err := errors.New(withdrawErrorMessage)
+		txErr := flow.ParseTransactionError(err)
+
+		assert.Equal(t, flow.ErrCodeCadenceRuntime, txErr.Code)
+		assert.Equal(t, flow.CadenceErrorPreConditionFailed, txErr.Kind)
+		assert.Equal(t, "Amount withdrawn must be less than or equal than the balance of the Vault", txErr.Message)
+		require.NotNil(t, txErr.Location)
+		assert.Equal(t, flow.HexToAddress("7e60df042a9c0868"), txErr.Location.Address)
+		assert.Equal(t, "FlowToken", txErr.Location.Contract)
+		assert.Equal(t, 102, txErr.Location.Line)
+		assert.Equal(t, 12, txErr.Location.Column)
+		assert.Equal(t, "7e60df042a9c0868.FlowToken:102:12", txErr.Location.String())
+
+		assert.True(t, txErr.IsPreConditionFailed())
+		assert.True(t, txErr.IsInsufficientBalance())
+		assert.False(t, txErr.IsPanic())
+		assert.ErrorIs(t, txErr, err)
+		assert.Equal(t, withdrawErrorMessage, txErr.Error())
+	})
+
+	t.Run("Panic in transaction", func(t *testing.T) {
+		txErr := flow.TransactionResult{Error: errors.New(panicErrorMessage)}.ParseError()
+
+		assert.Equal(t, flow.CadenceErrorPanic, txErr.Kind)
+		assert.Equal(t, "Could not borrow reference to the owner's Vault!", txErr.Message)
+		require.NotNil(t, txErr.Location)
+		assert.Equal(t, flow.EmptyAddress, txErr.Location.Address)
+		assert.Empty(t, txErr.Location.Contract)
+		assert.Equal(t, "transaction:9:12", txErr.Location.String())
+
+		assert.True(t, txErr.IsPanic())
+		assert.False(t, txErr.IsInsufficientBalance())
+	})
+
+	t.Run("Error code without Cadence error", func(t *testing.T) {
+		txErr := flow.ParseTransactionError(fmt.Errorf(
+			"[Error Code: 1118] payer 0x01cf0e2f2f715450 has insufficient balance to pay transaction fee",
+		))
+
+		assert.Equal(t, flow.ErrCodeInsufficientPayerBalance, txErr.Code)
+		assert.Empty(t, txErr.Kind)
+		assert.Equal(t, "payer 0x01cf0e2f2f715450 has insufficient balance to pay transaction fee", txErr.Message)
+		assert.Nil(t, txErr.Location)
+		assert.True(t, txErr.IsInsufficientBalance())
+	})
+
+	t.Run("Unstructured", func(t *testing.T) {
+		txErr := flow.ParseTransactionError(errors.New("transaction execution failed"))
+
+		assert.Zero(t, txErr.Code)
+		assert.Equal(t, "transaction execution failed", txErr.Message)
+		assert.Nil(t, txErr.Location)
+	})
+}
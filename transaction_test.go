@@ -692,3 +692,69 @@ func TestTransaction_RLPMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeTransaction(t *testing.T) {
+	t.Run("Round trip with signatures", func(t *testing.T) {
+		tx := test.TransactionGenerator().New()
+
+		decoded, err := flow.DecodeTransaction(tx.Encode())
+		require.NoError(t, err)
+
+		assert.Equal(t, tx.ID(), decoded.ID())
+		assert.Equal(t, tx.PayloadSignatures, decoded.PayloadSignatures)
+		assert.Equal(t, tx.EnvelopeSignatures, decoded.EnvelopeSignatures)
+	})
+
+	t.Run("Unknown signer", func(t *testing.T) {
+		tx := test.TransactionGenerator().NewUnsigned()
+		tx.AddPayloadSignature(flow.HexToAddress("ff"), 0, []byte{1})
+
+		_, err := flow.DecodeTransaction(tx.Encode())
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid encoding", func(t *testing.T) {
+		_, err := flow.DecodeTransaction([]byte{1, 2, 3})
+		assert.Error(t, err)
+	})
+}
+
+func TestTransaction_OfflineSigning(t *testing.T) {
+	newTransaction := func() *flow.Transaction {
+		return test.TransactionGenerator().NewUnsigned()
+	}
+
+	signed := newTransaction()
+	proposer := signed.ProposalKey.Address
+	payer := signed.Payer
+
+	require.NoError(t, signed.SignPayload(proposer, 0, test.MockSigner([]byte{1})))
+	require.NoError(t, signed.SignEnvelope(payer, 0, test.MockSigner([]byte{2})))
+
+	tx := newTransaction()
+
+	payloadMessage := tx.PayloadSignableMessage()
+	assert.Equal(t, flow.TransactionDomainTag[:], payloadMessage[:len(flow.TransactionDomainTag)])
+	assert.Equal(t, hex.EncodeToString(payloadMessage), tx.PayloadSignableMessageHex())
+
+	require.NoError(t, tx.AttachSignatureHex(proposer, 0, "0x01"))
+	assert.Len(t, tx.PayloadSignatures, 1)
+
+	assert.Equal(t, signed.EnvelopeSignableMessage(), tx.EnvelopeSignableMessage())
+	assert.Equal(t, hex.EncodeToString(tx.EnvelopeSignableMessage()), tx.EnvelopeSignableMessageHex())
+
+	require.NoError(t, tx.AttachSignature(payer, 0, []byte{2}))
+	assert.Len(t, tx.EnvelopeSignatures, 1)
+
+	assert.Equal(t, signed.ID(), tx.ID())
+
+	t.Run("Unknown signer", func(t *testing.T) {
+		err := newTransaction().AttachSignature(flow.HexToAddress("ff"), 0, []byte{1})
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid hex", func(t *testing.T) {
+		err := newTransaction().AttachSignatureHex(proposer, 0, "zz")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,184 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// An AccountLookup returns the account at an address, it is used to verify signatures
+// against the current keys of the accounts.
+type AccountLookup func(address Address) (*Account, error)
+
+// AccountsLookup returns an account lookup serving the given accounts.
+func AccountsLookup(accounts ...*Account) AccountLookup {
+	accountsByAddress := make(map[Address]*Account, len(accounts))
+	for _, account := range accounts {
+		accountsByAddress[account.Address] = account
+	}
+
+	return func(address Address) (*Account, error) {
+		account, ok := accountsByAddress[address]
+		if !ok {
+			return nil, fmt.Errorf("account %s not found", address)
+		}
+		return account, nil
+	}
+}
+
+// An InvalidTransactionSignature is a transaction signature that can't be accepted.
+type InvalidTransactionSignature struct {
+	Signature TransactionSignature
+	// Envelope is true for envelope signatures and false for payload signatures.
+	Envelope bool
+	// Reason describes why the signature is invalid.
+	Reason string
+}
+
+// A MissingTransactionSigner is a transaction signer whose valid signatures are not sufficient.
+type MissingTransactionSigner struct {
+	Address Address
+	// Weight is the total weight of the keys with a valid signature.
+	Weight int
+	// ProposalKey is true if the account is the proposer and the proposal key has no valid signature.
+	ProposalKey bool
+}
+
+// TransactionSignaturesVerification is the result of the verification of the signatures of a transaction.
+type TransactionSignaturesVerification struct {
+	Invalid []InvalidTransactionSignature
+	Missing []MissingTransactionSigner
+}
+
+// Valid returns true if all the signatures are valid and no signature is missing.
+func (v *TransactionSignaturesVerification) Valid() bool {
+	return len(v.Invalid) == 0 && len(v.Missing) == 0
+}
+
+// VerifyTransactionSignatures checks the payload and envelope signatures of the transaction against the keys
+// of the signer accounts, returned by the account lookup.
+//
+// The authorizers must sign the payload, unless they are the payer, and the payer must sign the envelope,
+// each with keys reaching the account key weight threshold. The proposal key must also sign the payload or
+// the envelope, which is the only signature required from a proposer that is neither payer nor authorizer. Signatures that don't verify, are produced by a revoked or unknown key, are duplicated
+// or are produced by an account that is not a signer are reported as invalid, and signers that don't reach the
+// weight threshold are reported as missing.
+//
+// An error is only returned if an account can't be looked up.
+func VerifyTransactionSignatures(tx *Transaction, lookup AccountLookup) (*TransactionSignaturesVerification, error) {
+	accounts := make(map[Address]*Account)
+	for _, address := range tx.signerList() {
+		account, err := lookup(address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get signer account %s: %w", address, err)
+		}
+		accounts[address] = account
+	}
+
+	result := &TransactionSignaturesVerification{}
+
+	// weights of the keys with valid signatures, the payer weight only comes from the envelope signatures
+	weights := make(map[Address]int)
+	proposalKeySigned := false
+
+	verify := func(signatures []TransactionSignature, message []byte, envelope bool) {
+		signed := make(map[Address]map[int]bool)
+
+		for _, sig := range signatures {
+			invalid := func(reason string) {
+				result.Invalid = append(result.Invalid, InvalidTransactionSignature{
+					Signature: sig,
+					Envelope:  envelope,
+					Reason:    reason,
+				})
+			}
+
+			account, ok := accounts[sig.Address]
+			if !ok {
+				invalid("account is not a signer of the transaction")
+				continue
+			}
+
+			if envelope != (sig.Address == tx.Payer) {
+				if envelope {
+					invalid("only the payer signs the envelope")
+				} else {
+					invalid("the payer signs the envelope")
+				}
+				continue
+			}
+
+			if signed[sig.Address][sig.KeyIndex] {
+				invalid("duplicate signature for the account key")
+				continue
+			}
+
+			key := accountKey(account, sig.KeyIndex)
+			if key == nil {
+				invalid(fmt.Sprintf("account has no key with index %d", sig.KeyIndex))
+				continue
+			}
+			if key.Revoked {
+				invalid("account key is revoked")
+				continue
+			}
+
+			hasher, err := crypto.NewHasher(key.HashAlgo)
+			if err != nil {
+				invalid(fmt.Sprintf("unsupported hash algorithm %s", key.HashAlgo))
+				continue
+			}
+
+			valid, err := key.PublicKey.Verify(sig.Signature, message, hasher)
+			if err != nil || !valid {
+				invalid("signature is not valid")
+				continue
+			}
+
+			if signed[sig.Address] == nil {
+				signed[sig.Address] = make(map[int]bool)
+			}
+			signed[sig.Address][sig.KeyIndex] = true
+			weights[sig.Address] += key.Weight
+
+			if sig.Address == tx.ProposalKey.Address && sig.KeyIndex == tx.ProposalKey.KeyIndex {
+				proposalKeySigned = true
+			}
+		}
+	}
+
+	verify(tx.PayloadSignatures, tx.PayloadSignableMessage(), false)
+	verify(tx.EnvelopeSignatures, tx.EnvelopeSignableMessage(), true)
+
+	for _, address := range tx.signerList() {
+		missingProposalKey := address == tx.ProposalKey.Address && !proposalKeySigned
+		missingWeight := tx.weightedSigner(address) && weights[address] < AccountKeyWeightThreshold
+		if missingWeight || missingProposalKey {
+			result.Missing = append(result.Missing, MissingTransactionSigner{
+				Address:     address,
+				Weight:      weights[address],
+				ProposalKey: missingProposalKey,
+			})
+		}
+	}
+
+	return result, nil
+}
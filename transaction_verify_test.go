@@ -0,0 +1,146 @@
+/*
+ * Flow Go SDK
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package flow_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/test"
+)
+
+func TestVerifyTransactionSignatures(t *testing.T) {
+	addresses := test.AddressGenerator()
+	keys := test.AccountKeyGenerator()
+
+	type signer struct {
+		account *flow.Account
+		signer  crypto.Signer
+	}
+
+	newSigner := func() signer {
+		key, s := keys.NewWithSigner()
+		key.Index = 0
+		key.Weight = flow.AccountKeyWeightThreshold
+		return signer{account: &flow.Account{Address: addresses.New(), Keys: []*flow.AccountKey{key}}, signer: s}
+	}
+
+	newTransaction := func(proposer, payer signer) *flow.Transaction {
+		return flow.NewTransaction().
+			SetScript([]byte("transaction { prepare(acct: AuthAccount) {} }")).
+			SetProposalKey(proposer.account.Address, 0, 0).
+			SetPayer(payer.account.Address).
+			AddAuthorizer(proposer.account.Address)
+	}
+
+	proposer := newSigner()
+	payer := newSigner()
+	lookup := flow.AccountsLookup(proposer.account, payer.account)
+
+	t.Run("Valid", func(t *testing.T) {
+		tx := newTransaction(proposer, payer)
+		require.NoError(t, tx.SignPayload(proposer.account.Address, 0, proposer.signer))
+		require.NoError(t, tx.SignEnvelope(payer.account.Address, 0, payer.signer))
+
+		result, err := flow.VerifyTransactionSignatures(tx, lookup)
+		require.NoError(t, err)
+		assert.True(t, result.Valid())
+	})
+
+	t.Run("Missing payer", func(t *testing.T) {
+		tx := newTransaction(proposer, payer)
+		require.NoError(t, tx.SignPayload(proposer.account.Address, 0, proposer.signer))
+
+		result, err := flow.VerifyTransactionSignatures(tx, lookup)
+		require.NoError(t, err)
+		assert.False(t, result.Valid())
+		assert.Empty(t, result.Invalid)
+		assert.Equal(t, []flow.MissingTransactionSigner{{Address: payer.account.Address}}, result.Missing)
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		tx := newTransaction(proposer, payer)
+		tx.AddPayloadSignature(proposer.account.Address, 0, make([]byte, 64))
+		require.NoError(t, tx.SignEnvelope(payer.account.Address, 0, payer.signer))
+
+		result, err := flow.VerifyTransactionSignatures(tx, lookup)
+		require.NoError(t, err)
+		require.Len(t, result.Invalid, 1)
+		assert.False(t, result.Invalid[0].Envelope)
+		assert.Equal(t, proposer.account.Address, result.Invalid[0].Signature.Address)
+		assert.Equal(t, []flow.MissingTransactionSigner{
+			{Address: proposer.account.Address, ProposalKey: true},
+		}, result.Missing)
+	})
+
+	t.Run("Signature from another account", func(t *testing.T) {
+		other := newSigner()
+
+		tx := newTransaction(proposer, payer)
+		require.NoError(t, tx.SignPayload(proposer.account.Address, 0, proposer.signer))
+		require.NoError(t, tx.SignPayload(other.account.Address, 0, other.signer))
+		require.NoError(t, tx.SignEnvelope(payer.account.Address, 0, payer.signer))
+
+		result, err := flow.VerifyTransactionSignatures(tx, lookup)
+		require.NoError(t, err)
+		require.Len(t, result.Invalid, 1)
+		assert.Equal(t, other.account.Address, result.Invalid[0].Signature.Address)
+		assert.Empty(t, result.Missing)
+	})
+
+	t.Run("Proposer only needs the proposal key", func(t *testing.T) {
+		key, s := keys.NewWithSigner()
+		key.Index = 0
+		key.Weight = 1
+		lowWeight := signer{account: &flow.Account{Address: addresses.New(), Keys: []*flow.AccountKey{key}}, signer: s}
+
+		tx := flow.NewTransaction().
+			SetScript([]byte("transaction { prepare(acct: AuthAccount) {} }")).
+			SetProposalKey(lowWeight.account.Address, 0, 0).
+			SetPayer(payer.account.Address).
+			AddAuthorizer(payer.account.Address)
+		require.NoError(t, tx.SignPayload(lowWeight.account.Address, 0, lowWeight.signer))
+		require.NoError(t, tx.SignEnvelope(payer.account.Address, 0, payer.signer))
+
+		result, err := flow.VerifyTransactionSignatures(tx, flow.AccountsLookup(lowWeight.account, payer.account))
+		require.NoError(t, err)
+		assert.True(t, result.Valid())
+
+		tx.PayloadSignatures = nil
+		tx.EnvelopeSignatures = nil
+		require.NoError(t, tx.SignEnvelope(payer.account.Address, 0, payer.signer))
+
+		result, err = flow.VerifyTransactionSignatures(tx, flow.AccountsLookup(lowWeight.account, payer.account))
+		require.NoError(t, err)
+		assert.Equal(t, []flow.MissingTransactionSigner{
+			{Address: lowWeight.account.Address, ProposalKey: true},
+		}, result.Missing)
+	})
+
+	t.Run("Unknown account", func(t *testing.T) {
+		tx := newTransaction(proposer, newSigner())
+
+		_, err := flow.VerifyTransactionSignatures(tx, lookup)
+		assert.Error(t, err)
+	})
+}